@@ -0,0 +1,119 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+
+	"github.com/percona/everest/pkg/accounts"
+)
+
+// CreateAPIKey issues a new API key for the specified account.
+func (e *EverestServer) CreateAPIKey(ctx echo.Context, name string) error {
+	var params CreateAPIKeyParams
+	if err := ctx.Bind(&params); err != nil {
+		return err
+	}
+	if pointer.GetInt64(params.ExpiresIn) < 0 {
+		return writeError(ctx, http.StatusBadRequest, "expiresIn cannot be negative")
+	}
+
+	token, key, err := e.sessionMgr.CreateAPIKey(
+		ctx.Request().Context(),
+		name,
+		pointer.GetString(params.Description),
+		pointer.GetInt64(params.ExpiresIn),
+	)
+	if err != nil {
+		return apiKeyErrToHTTPRes(ctx, err)
+	}
+
+	result, err := apiKeyToAPI(*key)
+	if err != nil {
+		return err
+	}
+	result.Key = &token
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// ListAPIKeys lists the API keys issued for the specified account.
+func (e *EverestServer) ListAPIKeys(ctx echo.Context, name string) error {
+	keys, err := e.sessionMgr.ListAPIKeys(ctx.Request().Context(), name)
+	if err != nil {
+		return apiKeyErrToHTTPRes(ctx, err)
+	}
+
+	result := make(APIKeyList, 0, len(keys))
+	for _, k := range keys {
+		key, err := apiKeyToAPI(k)
+		if err != nil {
+			return err
+		}
+		result = append(result, key)
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// DeleteAPIKey revokes the specified API key.
+func (e *EverestServer) DeleteAPIKey(ctx echo.Context, name, id string) error {
+	if err := e.sessionMgr.RevokeAPIKey(ctx.Request().Context(), name, id); err != nil {
+		return apiKeyErrToHTTPRes(ctx, err)
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func apiKeyToAPI(k accounts.APIKey) (APIKey, error) {
+	issuedAt, err := time.Parse(time.RFC3339, k.IssuedAt)
+	if err != nil {
+		return APIKey{}, errors.Join(err, errors.New("failed to parse API key issue time"))
+	}
+	result := APIKey{
+		Id:       k.ID,
+		IssuedAt: issuedAt,
+	}
+	if k.Description != "" {
+		result.Description = pointer.ToString(k.Description)
+	}
+	if k.ExpiresAt != "" {
+		expiresAt, err := time.Parse(time.RFC3339, k.ExpiresAt)
+		if err != nil {
+			return APIKey{}, errors.Join(err, errors.New("failed to parse API key expiry time"))
+		}
+		result.ExpiresAt = &expiresAt
+	}
+	return result, nil
+}
+
+func apiKeyErrToHTTPRes(ctx echo.Context, err error) error {
+	if errors.Is(err, accounts.ErrAccountNotFound) {
+		return writeError(ctx, http.StatusNotFound, "Account not found")
+	}
+	if errors.Is(err, accounts.ErrAPIKeyNotFound) {
+		return writeError(ctx, http.StatusNotFound, "API key not found")
+	}
+	if errors.Is(err, accounts.ErrAccountDisabled) {
+		return writeError(ctx, http.StatusBadRequest, "User account is disabled")
+	}
+	if errors.Is(err, accounts.ErrInsufficientCapabilities) {
+		return writeError(ctx, http.StatusBadRequest, "User account lacks the apiKey capability")
+	}
+	return err
+}
@@ -0,0 +1,106 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/percona/everest/pkg/audit"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+// auditMiddleware returns a middleware that records the mutating requests in the audit log.
+func (e *EverestServer) auditMiddleware(basePath string) (echo.MiddlewareFunc, error) {
+	resolve, err := rbac.NewRequestResolver(basePath)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not create RBAC request resolver"))
+	}
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			if req.Method == http.MethodGet || req.Method == http.MethodHead || req.Method == http.MethodOptions {
+				return next(c)
+			}
+
+			var body []byte
+			if req.Body != nil {
+				var err error
+				if body, err = io.ReadAll(req.Body); err != nil {
+					return err
+				}
+				req.Body = io.NopCloser(bytes.NewReader(body))
+				req.GetBody = func() (io.ReadCloser, error) {
+					return io.NopCloser(bytes.NewReader(body)), nil
+				}
+			}
+
+			start := time.Now()
+			handlerErr := next(c)
+			// The digest is computed over the redacted body, so that it
+			// cannot be used for guessing the secrets of the request.
+			redacted := audit.Redact(body)
+			event := audit.Event{
+				Time:          start.UTC(),
+				Method:        req.Method,
+				Path:          req.URL.Path,
+				RequestDigest: audit.Digest(redacted),
+				Request:       redacted,
+				Status:        responseStatus(c, handlerErr),
+				LatencyMs:     time.Since(start).Milliseconds(),
+			}
+			event.Outcome = audit.OutcomeSuccess
+			if event.Status >= http.StatusBadRequest {
+				event.Outcome = audit.OutcomeFailure
+			}
+			if user, err := rbac.GetUser(c); err == nil {
+				event.User = user.Name
+			}
+			if resource, action, object, err := resolve(c); err == nil {
+				event.Resource = resource
+				event.Action = action
+				event.Object = object
+			}
+			e.auditLog.Log(event)
+			return handlerErr
+		}
+	}, nil
+}
+
+// responseStatus returns the HTTP status code of the response to a request handled with the given error.
+// It follows the mapping done by the error handler chain.
+func responseStatus(c echo.Context, err error) int {
+	if err == nil {
+		return c.Response().Status
+	}
+	if k8serrors.IsNotFound(err) {
+		return http.StatusNotFound
+	}
+	if errors.Is(err, errInsufficientPermissions) {
+		return http.StatusForbidden
+	}
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.Code
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,111 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/pkg/audit"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+func TestAuditMiddleware(t *testing.T) {
+	t.Parallel()
+	buf := &bytes.Buffer{}
+	e := &EverestServer{
+		auditLog: audit.New(zap.NewNop().Sugar(), audit.NewWriterSink(buf)),
+	}
+	mw, err := e.auditMiddleware("/v1")
+	require.NoError(t, err)
+
+	var handlerBody string
+	handler := mw(func(c echo.Context) error {
+		// The body is still readable by the handler.
+		b, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return err
+		}
+		handlerBody = string(b)
+		return echo.NewHTTPError(http.StatusBadRequest)
+	})
+
+	body := `{"name":"s3","accessKey":"AKIA","secretKey":"secret"}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/namespaces/ns/backup-storages", strings.NewReader(body))
+	c := echo.New().NewContext(req, httptest.NewRecorder())
+	c.SetPath("/v1/namespaces/:namespace/backup-storages")
+	c.SetParamNames("namespace")
+	c.SetParamValues("ns")
+	rbac.SetUser(c, rbac.User{Name: "alice"})
+
+	err = handler(c)
+	require.Error(t, err)
+	assert.Equal(t, body, handlerBody)
+	require.NoError(t, e.auditLog.Close(context.Background()))
+
+	events, err := audit.ReadLast(buf, 0, audit.Filter{})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	event := events[0]
+	assert.Equal(t, "alice", event.User)
+	assert.Equal(t, "backup-storages", event.Resource)
+	assert.Equal(t, "create", event.Action)
+	assert.Equal(t, "ns/", event.Object)
+	assert.Equal(t, http.StatusBadRequest, event.Status)
+	assert.Equal(t, audit.OutcomeFailure, event.Outcome)
+	assert.Equal(t, audit.Digest(event.Request), event.RequestDigest)
+	assert.JSONEq(t, `{"name":"s3","accessKey":"[REDACTED]","secretKey":"[REDACTED]"}`, string(event.Request))
+}
+
+func TestAuditDigestIgnoresSecrets(t *testing.T) {
+	t.Parallel()
+	buf := &bytes.Buffer{}
+	e := &EverestServer{
+		auditLog: audit.New(zap.NewNop().Sugar(), audit.NewWriterSink(buf)),
+	}
+	mw, err := e.auditMiddleware("/v1")
+	require.NoError(t, err)
+	handler := mw(func(c echo.Context) error {
+		return c.NoContent(http.StatusUnauthorized)
+	})
+
+	bodies := []string{
+		`{"username":"admin","password":"password1"}`,
+		`{"username":"admin","password":"password2"}`,
+	}
+	for _, body := range bodies {
+		req := httptest.NewRequest(http.MethodPost, "/v1/session", strings.NewReader(body))
+		require.NoError(t, handler(echo.New().NewContext(req, httptest.NewRecorder())))
+	}
+	require.NoError(t, e.auditLog.Close(context.Background()))
+
+	events, err := audit.ReadLast(buf, 0, audit.Filter{})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.NotEmpty(t, events[0].RequestDigest)
+	assert.Equal(t, events[0].RequestDigest, events[1].RequestDigest)
+	assert.NotEqual(t, audit.Digest([]byte(bodies[0])), events[0].RequestDigest)
+}
@@ -31,7 +31,7 @@ import (
 )
 
 // enforceBackupStorageRBAC checks if the user has permissions to read the backup storage.
-func (e *EverestServer) enforceBackupStorageRBAC(user string, bs everestv1alpha1.BackupStorage) error {
+func (e *EverestServer) enforceBackupStorageRBAC(user rbac.User, bs everestv1alpha1.BackupStorage) error {
 	// Check if the user has permissions for this Backup Storage?
 	if err := e.enforce(user, rbac.ResourceBackupStorages, rbac.ActionRead, rbac.ObjectName(bs.GetNamespace(), bs.GetName())); err != nil {
 		if !errors.Is(err, errInsufficientPermissions) {
@@ -44,30 +44,40 @@ func (e *EverestServer) enforceBackupStorageRBAC(user string, bs everestv1alpha1
 }
 
 // ListBackupStorages lists backup storages.
-func (e *EverestServer) ListBackupStorages(ctx echo.Context, namespace string) error {
+func (e *EverestServer) ListBackupStorages(ctx echo.Context, namespace string, params ListBackupStoragesParams) error {
 	user, err := rbac.GetUser(ctx)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed to get user from context" + err.Error()),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed to get user from context"+err.Error())
 	}
 
-	backupList, err := e.kubeClient.ListBackupStorages(ctx.Request().Context(), namespace)
+	list := listParams{
+		limit:         params.Limit,
+		continueToken: params.Continue,
+		labelSelector: params.LabelSelector,
+		sortBy:        params.SortBy,
+		sortOrder:     params.SortOrder,
+	}
+	opts, err := list.listOptions()
+	if err != nil {
+		return writeErrorFrom(ctx, http.StatusBadRequest, err)
+	}
+	backupList, err := e.kubeClient.ListBackupStorages(ctx.Request().Context(), namespace, opts)
 	if err != nil {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Could not list backup storages"),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Could not list backup storages")
 	}
 
-	result := make([]BackupStorage, 0, len(backupList.Items))
-	for _, s := range backupList.Items {
-		if err := e.enforceBackupStorageRBAC(user, s); errors.Is(err, errInsufficientPermissions) {
-			continue
-		} else if err != nil {
-			return err
-		}
+	storages, err := filterAllowed(backupList.Items, func(s everestv1alpha1.BackupStorage) error {
+		return e.enforceBackupStorageRBAC(user, s)
+	})
+	if err != nil {
+		return err
+	}
+	sortObjects(storages, list)
+	setContinueToken(ctx, backupList.ListMeta)
 
+	result := make([]BackupStorage, 0, len(storages))
+	for _, s := range storages {
 		result = append(result, BackupStorage{
 			Type:      BackupStorageType(s.Spec.Type),
 			Name:      s.GetName(),
@@ -89,30 +99,24 @@ func (e *EverestServer) ListBackupStorages(ctx echo.Context, namespace string) e
 // CreateBackupStorage creates a new backup storage object.
 func (e *EverestServer) CreateBackupStorage(ctx echo.Context, namespace string) error { //nolint:funlen
 	c := ctx.Request().Context()
-	existingStorages, err := e.kubeClient.ListBackupStorages(c, namespace)
+	existingStorages, err := e.kubeClient.ListBackupStorages(c, namespace, metav1.ListOptions{})
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed getting existing backup storages"),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed getting existing backup storages")
 	}
 
 	params, err := validateCreateBackupStorageRequest(ctx, e.l, existingStorages)
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		return writeErrorFrom(ctx, http.StatusBadRequest, err)
 	}
 	s, err := e.kubeClient.GetBackupStorage(c, namespace, params.Name)
 	if err != nil && !k8serrors.IsNotFound(err) {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed getting a backup storage from the Kubernetes cluster"),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed getting a backup storage from the Kubernetes cluster")
 	}
 	// TODO: Change the design of operator's structs so they return nil struct so
 	// if s != nil passes
 	if s != nil && s.Name != "" {
-		return ctx.JSON(http.StatusConflict, Error{
-			Message: pointer.ToString(fmt.Sprintf("Backup storage %s already exists in namespace %s", params.Name, namespace)),
-		})
+		return writeError(ctx, http.StatusConflict, fmt.Sprintf("Backup storage %s already exists in namespace %s", params.Name, namespace))
 	}
 
 	secret := &corev1.Secret{
@@ -130,15 +134,11 @@ func (e *EverestServer) CreateBackupStorage(ctx echo.Context, namespace string)
 			_, err = e.kubeClient.UpdateSecret(c, secret)
 			if err != nil {
 				e.l.Error(err)
-				return ctx.JSON(http.StatusInternalServerError, Error{
-					Message: pointer.ToString(fmt.Sprintf("Failed updating the secret %s for backup storage", params.Name)),
-				})
+				return writeError(ctx, http.StatusInternalServerError, fmt.Sprintf("Failed updating the secret %s for backup storage", params.Name))
 			}
 		} else {
 			e.l.Error(err)
-			return ctx.JSON(http.StatusInternalServerError, Error{
-				Message: pointer.ToString("Failed creating a secret for the backup storage"),
-			})
+			return writeError(ctx, http.StatusInternalServerError, "Failed creating a secret for the backup storage")
 		}
 	}
 	bs := &everestv1alpha1.BackupStorage{
@@ -168,13 +168,9 @@ func (e *EverestServer) CreateBackupStorage(ctx echo.Context, namespace string)
 		// TODO: Move this logic to the operator
 		dErr := e.kubeClient.DeleteSecret(c, namespace, params.Name)
 		if dErr != nil {
-			return ctx.JSON(http.StatusInternalServerError, Error{
-				Message: pointer.ToString("Failed cleaning up secret for a backup storage"),
-			})
+			return writeError(ctx, http.StatusInternalServerError, "Failed cleaning up secret for a backup storage")
 		}
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed creating backup storage"),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed creating backup storage")
 	}
 	result := BackupStorage{
 		Type:              BackupStorageType(params.Type),
@@ -197,37 +193,27 @@ func (e *EverestServer) DeleteBackupStorage(ctx echo.Context, namespace, name st
 	used, err := e.kubeClient.IsBackupStorageUsed(ctx.Request().Context(), namespace, name)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
-			return ctx.JSON(http.StatusNotFound, Error{
-				Message: pointer.ToString("Backup storage is not found"),
-			})
+			return writeError(ctx, http.StatusNotFound, "Backup storage is not found")
 		}
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed to check the backup storage is used"),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed to check the backup storage is used")
 	}
 	if used {
-		return ctx.JSON(http.StatusBadRequest, Error{
-			Message: pointer.ToString(fmt.Sprintf("Backup storage %s is in use", name)),
-		})
+		return writeError(ctx, http.StatusBadRequest, fmt.Sprintf("Backup storage %s is in use", name))
 	}
 	if err := e.kubeClient.DeleteBackupStorage(ctx.Request().Context(), namespace, name); err != nil {
 		if k8serrors.IsNotFound(err) {
 			return ctx.NoContent(http.StatusNoContent)
 		}
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed to delete a backup storage"),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed to delete a backup storage")
 	}
 	if err := e.kubeClient.DeleteSecret(ctx.Request().Context(), namespace, name); err != nil {
 		if k8serrors.IsNotFound(err) {
 			return ctx.NoContent(http.StatusNoContent)
 		}
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed to delete a secret for backup storage"),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed to delete a secret for backup storage")
 	}
 
 	return ctx.NoContent(http.StatusNoContent)
@@ -245,14 +231,10 @@ func (e *EverestServer) GetBackupStorage(ctx echo.Context, namespace, name strin
 	s, err := e.kubeClient.GetBackupStorage(ctx.Request().Context(), namespace, name)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
-			return ctx.JSON(http.StatusNotFound, Error{
-				Message: pointer.ToString("Backup storage is not found"),
-			})
+			return writeError(ctx, http.StatusNotFound, "Backup storage is not found")
 		}
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed getting backup storage"),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed getting backup storage")
 	}
 	return ctx.JSON(http.StatusOK, BackupStorage{
 		Type:              BackupStorageType(s.Spec.Type),
@@ -274,32 +256,24 @@ func (e *EverestServer) UpdateBackupStorage(ctx echo.Context, namespace, name st
 	bs, err := e.kubeClient.GetBackupStorage(c, namespace, name)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
-			return ctx.JSON(http.StatusNotFound, Error{
-				Message: pointer.ToString("Backup storage is not found"),
-			})
+			return writeError(ctx, http.StatusNotFound, "Backup storage is not found")
 		}
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed getting backup storage"),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed getting backup storage")
 	}
 
 	secret, err := e.kubeClient.GetSecret(c, namespace, name)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
-			return ctx.JSON(http.StatusNotFound, Error{
-				Message: pointer.ToString("Secret is not found"),
-			})
+			return writeError(ctx, http.StatusNotFound, "Secret is not found")
 		}
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed getting secret"),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed getting secret")
 	}
 
 	params, err := e.validateUpdateBackupStorageRequest(ctx, bs, secret, e.l)
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		return writeErrorFrom(ctx, http.StatusBadRequest, err)
 	}
 	if params.AccessKey != nil && params.SecretKey != nil {
 		_, err = e.kubeClient.UpdateSecret(c, &corev1.Secret{
@@ -312,9 +286,7 @@ func (e *EverestServer) UpdateBackupStorage(ctx echo.Context, namespace, name st
 		})
 		if err != nil {
 			e.l.Error(err)
-			return ctx.JSON(http.StatusInternalServerError, Error{
-				Message: pointer.ToString(fmt.Sprintf("Failed updating the secret %s", name)),
-			})
+			return writeError(ctx, http.StatusInternalServerError, fmt.Sprintf("Failed updating the secret %s", name))
 		}
 	}
 	if params.BucketName != nil {
@@ -342,9 +314,7 @@ func (e *EverestServer) UpdateBackupStorage(ctx echo.Context, namespace, name st
 	err = e.kubeClient.UpdateBackupStorage(c, bs)
 	if err != nil {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed updating backup storage"),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed updating backup storage")
 	}
 	result := BackupStorage{
 		Type:              BackupStorageType(bs.Spec.Type),
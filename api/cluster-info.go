@@ -4,7 +4,6 @@ import (
 	"net/http"
 	"reflect"
 
-	"github.com/AlekSi/pointer"
 	"github.com/labstack/echo/v4"
 	storagev1 "k8s.io/api/storage/v1"
 )
@@ -18,12 +17,12 @@ func (e *EverestServer) GetKubernetesClusterInfo(ctx echo.Context) error {
 	clusterType, err := e.kubeClient.GetClusterType(ctx.Request().Context())
 	if err != nil {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Failed getting Kubernetes cluster provider")})
+		return writeError(ctx, http.StatusInternalServerError, "Failed getting Kubernetes cluster provider")
 	}
 	storagesList, err := e.kubeClient.GetStorageClasses(ctx.Request().Context())
 	if err != nil {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Failed getting storage classes")})
+		return writeError(ctx, http.StatusInternalServerError, "Failed getting storage classes")
 	}
 	classNames := storageClasses(storagesList)
 
@@ -18,6 +18,7 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
@@ -31,9 +32,8 @@ import (
 	goversion "github.com/hashicorp/go-version"
 	"github.com/labstack/echo/v4"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	"github.com/percona/everest/pkg/common"
@@ -58,13 +58,11 @@ func (e *EverestServer) CreateDatabaseCluster(ctx echo.Context, namespace string
 	dbc := &DatabaseCluster{}
 	if err := e.getBodyFromContext(ctx, dbc); err != nil {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusBadRequest, Error{
-			Message: pointer.ToString("Could not get DatabaseCluster from the request body"),
-		})
+		return writeError(ctx, http.StatusBadRequest, "Could not get DatabaseCluster from the request body")
 	}
 
 	if err := e.validateDatabaseClusterCR(ctx, namespace, dbc); err != nil {
-		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		return writeErrorFrom(ctx, http.StatusBadRequest, err)
 	}
 
 	if err := e.validateDatabaseClusterOnCreate(ctx, namespace, dbc); err != nil {
@@ -90,7 +88,7 @@ func (e *EverestServer) CreateDatabaseCluster(ctx echo.Context, namespace string
 // enforceDBClusterRBAC checks if the user has permission to:
 // - read the backup-storage and monitoring-instances associated with the provided DB cluster
 // - access the database engine associated with the DB cluster.
-func (e *EverestServer) enforceDBClusterRBAC(user string, db *everestv1alpha1.DatabaseCluster) error {
+func (e *EverestServer) enforceDBClusterRBAC(user rbac.User, db *everestv1alpha1.DatabaseCluster) error {
 	// Check if the user has permissions for this DB cluster?
 	if err := e.enforce(user, rbac.ResourceDatabaseClusters, rbac.ActionRead, rbac.ObjectName(db.GetNamespace(), db.GetName())); err != nil {
 		if !errors.Is(err, errInsufficientPermissions) {
@@ -133,7 +131,7 @@ func (e *EverestServer) enforceDBClusterRBAC(user string, db *everestv1alpha1.Da
 	return nil
 }
 
-func (e *EverestServer) enforceDBClusterEngineRBAC(user string, db *everestv1alpha1.DatabaseCluster) error {
+func (e *EverestServer) enforceDBClusterEngineRBAC(user rbac.User, db *everestv1alpha1.DatabaseCluster) error {
 	engineName, ok := operatorEngine[db.Spec.Engine.Type]
 	if !ok {
 		return errors.New("unsupported database engine")
@@ -147,33 +145,113 @@ func (e *EverestServer) enforceDBClusterEngineRBAC(user string, db *everestv1alp
 	return nil
 }
 
-// ListDatabaseClusters lists the created database clusters on the specified kubernetes cluster.
-func (e *EverestServer) ListDatabaseClusters(ctx echo.Context, namespace string) error {
-	user, err := rbac.GetUser(ctx)
+// enforceDBClusterUpdateRBAC checks if the user has permission for each of the sensitive
+// changes that the update makes to the DB cluster, e.g. scaling it or exposing it.
+func (e *EverestServer) enforceDBClusterUpdateRBAC(
+	user rbac.User,
+	dbc *DatabaseCluster,
+	oldDB *everestv1alpha1.DatabaseCluster,
+) error {
+	actions, err := dbClusterUpdateActions(dbc, oldDB)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed to get user from context" + err.Error()),
-		})
+		return err
 	}
-	rbacFilter := transformK8sList(func(l *unstructured.UnstructuredList) error {
-		allowed := []unstructured.Unstructured{}
-		for _, obj := range l.Items {
-			db := &everestv1alpha1.DatabaseCluster{}
-			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, db); err != nil {
-				e.l.Error(errors.Join(err, errors.New("failed to convert unstructured to DatabaseCluster")))
-				return err
-			}
-			if err := e.enforceDBClusterRBAC(user, db); errors.Is(err, errInsufficientPermissions) {
-				continue
-			} else if err != nil {
-				return err
+	for _, action := range actions {
+		if err := e.enforce(user, rbac.ResourceDatabaseClusters, action, rbac.ObjectName(oldDB.GetNamespace(), oldDB.GetName())); err != nil {
+			if !errors.Is(err, errInsufficientPermissions) {
+				e.l.Error(errors.Join(err, errors.New("failed to check db-cluster update permissions")))
 			}
-			allowed = append(allowed, obj)
+			return err
 		}
-		l.Items = allowed
-		return nil
+	}
+	return nil
+}
+
+// dbClusterUpdateActions returns the sub-actions of the update action that are
+// required for changing the spec of oldDB to the spec of dbc.
+func dbClusterUpdateActions(dbc *DatabaseCluster, oldDB *everestv1alpha1.DatabaseCluster) ([]string, error) {
+	// Compare the specs as operator types, so that resource quantities
+	// are compared by their value instead of their representation.
+	data, err := json.Marshal(dbc)
+	if err != nil {
+		return nil, err
+	}
+	newDB := &everestv1alpha1.DatabaseCluster{}
+	if err := json.Unmarshal(data, newDB); err != nil {
+		return nil, err
+	}
+	newSpec, oldSpec := newDB.Spec, oldDB.Spec
+
+	actions := []string{}
+	if !dbClusterSizeEqual(newSpec, oldSpec) {
+		actions = append(actions, rbac.ActionUpdateScale)
+	}
+	if !dbClusterExposeEqual(newSpec.Proxy.Expose, oldSpec.Proxy.Expose) {
+		actions = append(actions, rbac.ActionUpdateExpose)
+	}
+	if newSpec.Engine.Version != "" && newSpec.Engine.Version != oldSpec.Engine.Version {
+		actions = append(actions, rbac.ActionUpdateVersion)
+	}
+	if !equality.Semantic.DeepEqual(newSpec.Backup, oldSpec.Backup) {
+		actions = append(actions, rbac.ActionUpdateBackup)
+	}
+	if newSpec.AllowUnsafeConfiguration != oldSpec.AllowUnsafeConfiguration {
+		actions = append(actions, rbac.ActionUpdateUnsafe)
+	}
+	return actions, nil
+}
+
+// dbClusterSizeEqual returns true if both specs have the same replicas and resources.
+func dbClusterSizeEqual(a, b everestv1alpha1.DatabaseClusterSpec) bool {
+	aSharding, bSharding := pointer.Get(a.Sharding), pointer.Get(b.Sharding)
+	return a.Engine.Replicas == b.Engine.Replicas &&
+		equality.Semantic.DeepEqual(a.Engine.Resources, b.Engine.Resources) &&
+		a.Engine.Storage.Size.Cmp(b.Engine.Storage.Size) == 0 &&
+		pointer.Get(a.Proxy.Replicas) == pointer.Get(b.Proxy.Replicas) &&
+		equality.Semantic.DeepEqual(a.Proxy.Resources, b.Proxy.Resources) &&
+		aSharding.Shards == bSharding.Shards &&
+		aSharding.ConfigServer.Replicas == bSharding.ConfigServer.Replicas
+}
+
+// dbClusterExposeEqual returns true if both settings expose the DB cluster in the same way.
+func dbClusterExposeEqual(a, b everestv1alpha1.Expose) bool {
+	// The operator exposes DB clusters internally unless told otherwise.
+	withDefaults := func(e everestv1alpha1.Expose) everestv1alpha1.Expose {
+		if e.Type == "" {
+			e.Type = everestv1alpha1.ExposeTypeInternal
+		}
+		return e
+	}
+	return equality.Semantic.DeepEqual(withDefaults(a), withDefaults(b))
+}
+
+// ListDatabaseClusters lists the created database clusters on the specified kubernetes cluster.
+func (e *EverestServer) ListDatabaseClusters(ctx echo.Context, namespace string, params ListDatabaseClustersParams) error {
+	list := listParams{
+		limit:         params.Limit,
+		continueToken: params.Continue,
+		labelSelector: params.LabelSelector,
+		sortBy:        params.SortBy,
+		sortOrder:     params.SortOrder,
+	}
+	query, err := list.proxyQuery()
+	if err != nil {
+		return writeErrorFrom(ctx, http.StatusBadRequest, err)
+	}
+	ctx.Request().URL.RawQuery = query.Encode()
+
+	user, err := rbac.GetUser(ctx)
+	if err != nil {
+		return writeError(ctx, http.StatusInternalServerError, "Failed to get user from context"+err.Error())
+	}
+	rbacFilter := rbacListFilter(func(db *everestv1alpha1.DatabaseCluster) error {
+		return e.enforceDBClusterRBAC(user, db)
+	})
+	fieldFilter := listFieldFilter(map[string]*string{
+		"spec.engine.type": params.EngineType,
+		"status.status":    params.Status,
 	})
-	return e.proxyKubernetes(ctx, namespace, databaseClusterKind, "", rbacFilter)
+	return e.proxyKubernetes(ctx, namespace, databaseClusterKind, "", rbacFilter, fieldFilter, listSorter(list))
 }
 
 // DeleteDatabaseCluster deletes a database cluster on the specified kubernetes cluster.
@@ -193,14 +271,14 @@ func (e *EverestServer) DeleteDatabaseCluster(
 	user, err := rbac.GetUser(ctx)
 	if err != nil {
 		err = errors.Join(err, errors.New("cannot get user from request context"))
-		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		return writeErrorFrom(ctx, http.StatusBadRequest, err)
 	}
 
 	if err := e.enforceDBClusterEngineRBAC(user, db); err != nil {
 		if errors.Is(err, errInsufficientPermissions) {
 			return err
 		}
-		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		return writeErrorFrom(ctx, http.StatusBadRequest, err)
 	}
 
 	backups, err := e.kubeClient.ListDatabaseClusterBackups(reqCtx, namespace, metav1.ListOptions{})
@@ -238,9 +316,7 @@ func (e *EverestServer) DeleteDatabaseCluster(
 func (e *EverestServer) GetDatabaseCluster(ctx echo.Context, namespace, name string) error {
 	user, err := rbac.GetUser(ctx)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed to get user from context" + err.Error()),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed to get user from context"+err.Error())
 	}
 
 	// Check all indirect permissions related to the DB cluster.
@@ -264,7 +340,7 @@ func (e *EverestServer) GetDatabaseClusterComponents(ctx echo.Context, namespace
 	})
 	if err != nil {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("Could not get pods")})
+		return writeError(ctx, http.StatusBadRequest, "Could not get pods")
 	}
 
 	res := make([]DatabaseClusterComponent, 0, len(pods.Items))
@@ -333,17 +409,15 @@ func (e *EverestServer) UpdateDatabaseCluster(ctx echo.Context, namespace, name
 	dbc := &DatabaseCluster{}
 	if err := e.getBodyFromContext(ctx, dbc); err != nil {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusBadRequest, Error{
-			Message: pointer.ToString("Could not get DatabaseCluster from the request body"),
-		})
+		return writeError(ctx, http.StatusBadRequest, "Could not get DatabaseCluster from the request body")
 	}
 
 	if err := validateMetadata(dbc.Metadata); err != nil {
-		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		return writeErrorFrom(ctx, http.StatusBadRequest, err)
 	}
 
 	if err := e.validateDatabaseClusterCR(ctx, namespace, dbc); err != nil {
-		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		return writeErrorFrom(ctx, http.StatusBadRequest, err)
 	}
 
 	oldDB, err := e.kubeClient.GetDatabaseCluster(ctx.Request().Context(), namespace, name)
@@ -354,21 +428,21 @@ func (e *EverestServer) UpdateDatabaseCluster(ctx echo.Context, namespace, name
 	user, err := rbac.GetUser(ctx)
 	if err != nil {
 		err = errors.Join(err, errors.New("cannot get user from request context"))
-		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		return writeErrorFrom(ctx, http.StatusBadRequest, err)
 	}
 
 	if err := e.validateDatabaseClusterOnUpdate(user, dbc, oldDB); err != nil {
 		if errors.Is(err, errInsufficientPermissions) {
 			return err
 		}
-		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		return writeErrorFrom(ctx, http.StatusBadRequest, err)
 	}
 
 	if err := e.enforceDBClusterEngineRBAC(user, oldDB); err != nil {
 		if errors.Is(err, errInsufficientPermissions) {
 			return err
 		}
-		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		return writeErrorFrom(ctx, http.StatusBadRequest, err)
 	}
 
 	return e.proxyKubernetes(ctx, namespace, databaseClusterKind, name)
@@ -379,12 +453,12 @@ func (e *EverestServer) GetDatabaseClusterCredentials(ctx echo.Context, namespac
 	databaseCluster, err := e.kubeClient.GetDatabaseCluster(ctx.Request().Context(), namespace, name)
 	if err != nil {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString(err.Error())})
+		return writeErrorFrom(ctx, http.StatusInternalServerError, err)
 	}
 	secret, err := e.kubeClient.GetSecret(ctx.Request().Context(), namespace, databaseCluster.Spec.Engine.UserSecretsName)
 	if err != nil {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString(err.Error())})
+		return writeErrorFrom(ctx, http.StatusInternalServerError, err)
 	}
 	c := ctx.Request().Context()
 	response := &DatabaseClusterCredential{}
@@ -401,7 +475,7 @@ func (e *EverestServer) GetDatabaseClusterCredentials(ctx echo.Context, namespac
 		response.Password = pointer.ToString(string(secret.Data["password"]))
 		response.ConnectionUrl = e.connectionURL(c, databaseCluster, *response.Username, *response.Password)
 	default:
-		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("Unsupported database engine")})
+		return writeError(ctx, http.StatusBadRequest, "Unsupported database engine")
 	}
 	return ctx.JSON(http.StatusOK, response)
 }
@@ -465,7 +539,7 @@ func (e *EverestServer) GetDatabaseClusterPitr(ctx echo.Context, namespace, name
 	databaseCluster, err := e.kubeClient.GetDatabaseCluster(ctx.Request().Context(), namespace, name)
 	if err != nil {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString(err.Error())})
+		return writeErrorFrom(ctx, http.StatusInternalServerError, err)
 	}
 
 	response := &DatabaseClusterPitr{}
@@ -483,7 +557,7 @@ func (e *EverestServer) GetDatabaseClusterPitr(ctx echo.Context, namespace, name
 	backups, err := e.kubeClient.ListDatabaseClusterBackups(ctx.Request().Context(), namespace, options)
 	if err != nil {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString(err.Error())})
+		return writeErrorFrom(ctx, http.StatusInternalServerError, err)
 	}
 	if len(backups.Items) == 0 {
 		return ctx.JSON(http.StatusOK, response)
@@ -21,7 +21,6 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"net/url"
 	"slices"
 	"strings"
 	"time"
@@ -30,8 +29,6 @@ import (
 	"github.com/cenkalti/backoff/v4"
 	"github.com/labstack/echo/v4"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
@@ -48,7 +45,7 @@ const (
 //nolint:gochecknoglobals
 var everestAPIConstantBackoff = backoff.WithMaxRetries(backoff.NewConstantBackOff(time.Second), maxRetries)
 
-func (e *EverestServer) enforceDBBackupsRBAC(user string, bkp *everestv1alpha1.DatabaseClusterBackup) error {
+func (e *EverestServer) enforceDBBackupsRBAC(user rbac.User, bkp *everestv1alpha1.DatabaseClusterBackup) error {
 	if err := e.enforce(user, rbac.ResourceBackupStorages, rbac.ActionRead, rbac.ObjectName(bkp.GetNamespace(), bkp.Spec.BackupStorageName)); err != nil {
 		if !errors.Is(err, errInsufficientPermissions) {
 			e.l.Error(errors.Join(err, errors.New("failed to check backup-storage permissions")))
@@ -59,13 +56,28 @@ func (e *EverestServer) enforceDBBackupsRBAC(user string, bkp *everestv1alpha1.D
 }
 
 // ListDatabaseClusterBackups returns list of the created database cluster backups on the specified kubernetes cluster.
-func (e *EverestServer) ListDatabaseClusterBackups(ctx echo.Context, namespace, name string) error {
+func (e *EverestServer) ListDatabaseClusterBackups(
+	ctx echo.Context,
+	namespace, name string,
+	params ListDatabaseClusterBackupsParams,
+) error {
 	req := ctx.Request()
 	if err := validateRFC1035(name, "name"); err != nil {
-		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		return writeErrorFrom(ctx, http.StatusBadRequest, err)
+	}
+	list := listParams{
+		limit:         params.Limit,
+		continueToken: params.Continue,
+		labelSelector: params.LabelSelector,
+		sortBy:        params.SortBy,
+		sortOrder:     params.SortOrder,
+	}
+	// The backups are selected by the label of their backup storage so that the pages are filtered by Kubernetes.
+	// The operator sets the label once it reconciles a backup, so the storage is checked again below.
+	val, err := list.proxyQuery(fmt.Sprintf("clusterName=%s", name), backupStorageSelector(params.BackupStorage))
+	if err != nil {
+		return writeErrorFrom(ctx, http.StatusBadRequest, err)
 	}
-	val := url.Values{}
-	val.Add("labelSelector", fmt.Sprintf("clusterName=%s", name))
 	req.URL.RawQuery = val.Encode()
 	path := req.URL.Path
 	// trim backups
@@ -77,29 +89,16 @@ func (e *EverestServer) ListDatabaseClusterBackups(ctx echo.Context, namespace,
 
 	user, err := rbac.GetUser(ctx)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed to get user from context" + err.Error()),
-		})
-	}
-	rbacFilter := transformK8sList(func(l *unstructured.UnstructuredList) error {
-		allowed := []unstructured.Unstructured{}
-		for _, obj := range l.Items {
-			dbbackup := &everestv1alpha1.DatabaseClusterBackup{}
-			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, dbbackup); err != nil {
-				e.l.Error(errors.Join(err, errors.New("failed to convert unstructured to DatabaseClusterBackup")))
-				return err
-			}
-			if err := e.enforceDBBackupsRBAC(user, dbbackup); errors.Is(err, errInsufficientPermissions) {
-				continue
-			} else if err != nil {
-				return err
-			}
-			allowed = append(allowed, obj)
-		}
-		l.Items = allowed
-		return nil
+		return writeError(ctx, http.StatusInternalServerError, "Failed to get user from context"+err.Error())
+	}
+	rbacFilter := rbacListFilter(func(bkp *everestv1alpha1.DatabaseClusterBackup) error {
+		return e.enforceDBBackupsRBAC(user, bkp)
+	})
+	fieldFilter := listFieldFilter(map[string]*string{
+		"status.state":           params.Status,
+		"spec.backupStorageName": params.BackupStorage,
 	})
-	return e.proxyKubernetes(ctx, namespace, databaseClusterBackupKind, "", rbacFilter)
+	return e.proxyKubernetes(ctx, namespace, databaseClusterBackupKind, "", rbacFilter, fieldFilter, listSorter(list))
 }
 
 // CreateDatabaseClusterBackup creates a database cluster backup on the specified kubernetes cluster.
@@ -107,15 +106,11 @@ func (e *EverestServer) CreateDatabaseClusterBackup(ctx echo.Context, namespace
 	dbb := &DatabaseClusterBackup{}
 	if err := e.getBodyFromContext(ctx, dbb); err != nil {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusBadRequest, Error{
-			Message: pointer.ToString("Could not get DatabaseClusterBackup from the request body"),
-		})
+		return writeError(ctx, http.StatusBadRequest, "Could not get DatabaseClusterBackup from the request body")
 	}
 	user, err := rbac.GetUser(ctx)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed to get user from context" + err.Error()),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed to get user from context"+err.Error())
 	}
 	// User should be able to read the specified backup storage.
 	bsName := pointer.Get(dbb.Spec).BackupStorageName
@@ -126,16 +121,14 @@ func (e *EverestServer) CreateDatabaseClusterBackup(ctx echo.Context, namespace
 	// TODO: Improve returns status code in EVEREST-616
 	if err := e.validateDatabaseClusterBackup(ctx.Request().Context(), namespace, dbb); err != nil {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		return writeErrorFrom(ctx, http.StatusBadRequest, err)
 	}
 
 	// Do not allow a new backup to be created if there's another backup running already.
 	if ok, err := e.ensureNoBackupsRunningForCluster(ctx.Request().Context(), dbb.Spec.DbClusterName, namespace); err != nil {
 		return err
 	} else if !ok {
-		return ctx.JSON(http.StatusPreconditionFailed,
-			Error{Message: pointer.ToString("Cannot create a new backup when another backup is already running")},
-		)
+		return writeError(ctx, http.StatusPreconditionFailed, "Cannot create a new backup when another backup is already running")
 	}
 	return e.proxyKubernetes(ctx, namespace, databaseClusterBackupKind, "")
 }
@@ -190,9 +183,7 @@ func (e *EverestServer) DeleteDatabaseClusterBackup(
 func (e *EverestServer) GetDatabaseClusterBackup(ctx echo.Context, namespace, name string) error {
 	user, err := rbac.GetUser(ctx)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed to get user from context" + err.Error()),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed to get user from context"+err.Error())
 	}
 
 	// Ensure that the user has access to the backup storage used for this backup.
@@ -20,13 +20,10 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"net/url"
 	"strings"
 
 	"github.com/AlekSi/pointer"
 	"github.com/labstack/echo/v4"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	"github.com/percona/everest/pkg/rbac"
@@ -37,13 +34,26 @@ const (
 )
 
 // ListDatabaseClusterRestores List of the created database cluster restores on the specified kubernetes cluster.
-func (e *EverestServer) ListDatabaseClusterRestores(ctx echo.Context, namespace, name string) error {
+func (e *EverestServer) ListDatabaseClusterRestores(
+	ctx echo.Context,
+	namespace, name string,
+	params ListDatabaseClusterRestoresParams,
+) error {
 	req := ctx.Request()
 	if err := validateRFC1035(name, "name"); err != nil {
-		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		return writeErrorFrom(ctx, http.StatusBadRequest, err)
+	}
+	list := listParams{
+		limit:         params.Limit,
+		continueToken: params.Continue,
+		labelSelector: params.LabelSelector,
+		sortBy:        params.SortBy,
+		sortOrder:     params.SortOrder,
+	}
+	val, err := list.proxyQuery(fmt.Sprintf("clusterName=%s", name))
+	if err != nil {
+		return writeErrorFrom(ctx, http.StatusBadRequest, err)
 	}
-	val := url.Values{}
-	val.Add("labelSelector", fmt.Sprintf("clusterName=%s", name))
 	req.URL.RawQuery = val.Encode()
 	path := req.URL.Path
 	// trim restores
@@ -55,30 +65,16 @@ func (e *EverestServer) ListDatabaseClusterRestores(ctx echo.Context, namespace,
 
 	user, err := rbac.GetUser(ctx)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed to get user from context" + err.Error()),
-		})
-	}
-	rbacFilter := transformK8sList(func(l *unstructured.UnstructuredList) error {
-		allowed := []unstructured.Unstructured{}
-		for _, obj := range l.Items {
-			restore := &everestv1alpha1.DatabaseClusterRestore{}
-			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, restore); err != nil {
-				e.l.Error(errors.Join(err, errors.New("failed to convert unstructured to DatabaseClusterRestore")))
-				return err
-			}
-			if err := e.enforceDBClusterListRestoreRBAC(user, restore, rbac.ActionRead); errors.Is(err, errInsufficientPermissions) {
-				continue
-			} else if err != nil {
-				return err
-			}
-			allowed = append(allowed, obj)
-		}
-		l.Items = allowed
-		return nil
+		return writeError(ctx, http.StatusInternalServerError, "Failed to get user from context"+err.Error())
+	}
+	rbacFilter := rbacListFilter(func(restore *everestv1alpha1.DatabaseClusterRestore) error {
+		return e.enforceDBClusterListRestoreRBAC(user, restore, rbac.ActionRead)
+	})
+	fieldFilter := listFieldFilter(map[string]*string{
+		"status.state": params.Status,
 	})
 
-	return e.proxyKubernetes(ctx, namespace, databaseClusterRestoreKind, "", rbacFilter)
+	return e.proxyKubernetes(ctx, namespace, databaseClusterRestoreKind, "", rbacFilter, fieldFilter, listSorter(list))
 }
 
 // CreateDatabaseClusterRestore Create a database cluster restore on the specified kubernetes cluster.
@@ -86,32 +82,24 @@ func (e *EverestServer) CreateDatabaseClusterRestore(ctx echo.Context, namespace
 	user, err := rbac.GetUser(ctx)
 	if err != nil {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed to get user from context: " + err.Error()),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed to get user from context: "+err.Error())
 	}
 
 	restore := &DatabaseClusterRestore{}
 	if err := e.getBodyFromContext(ctx, restore); err != nil {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusBadRequest, Error{
-			Message: pointer.ToString("Could not get DatabaseClusterRestore from the request body"),
-		})
+		return writeError(ctx, http.StatusBadRequest, "Could not get DatabaseClusterRestore from the request body")
 	}
 
 	if err := validateDatabaseClusterRestore(ctx.Request().Context(), namespace, restore, e.kubeClient); err != nil {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusBadRequest, Error{
-			Message: pointer.ToString(err.Error()),
-		})
+		return writeErrorFrom(ctx, http.StatusBadRequest, err)
 	}
 
 	dbCluster, err := e.kubeClient.GetDatabaseCluster(ctx.Request().Context(), namespace, restore.Spec.DbClusterName)
 	if err != nil {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString(err.Error()),
-		})
+		return writeErrorFrom(ctx, http.StatusInternalServerError, err)
 	}
 
 	srcBkp := pointer.Get(pointer.Get(restore.Spec).DataSource.DbClusterBackupName)
@@ -121,15 +109,13 @@ func (e *EverestServer) CreateDatabaseClusterRestore(ctx echo.Context, namespace
 
 	if dbCluster.Status.Status == everestv1alpha1.AppStateRestoring {
 		e.l.Error("failed creating restore because another one is in progress")
-		return ctx.JSON(http.StatusBadRequest, Error{
-			Message: pointer.ToString("Another restore process for this DB cluster is currently in progress. Wait for its completion before initiating another."),
-		})
+		return writeError(ctx, http.StatusBadRequest, "Another restore process for this DB cluster is currently in progress. Wait for its completion before initiating another.")
 	}
 
 	return e.proxyKubernetes(ctx, namespace, databaseClusterRestoreKind, "")
 }
 
-func (e *EverestServer) enforceDBRestoreRBAC(user, namespace, srcBackupName, dbClusterName string) error {
+func (e *EverestServer) enforceDBRestoreRBAC(user rbac.User, namespace, srcBackupName, dbClusterName string) error {
 	if err := e.enforce(user, rbac.ResourceDatabaseClusterCredentials, rbac.ActionRead, rbac.ObjectName(namespace, dbClusterName)); err != nil {
 		return err
 	}
@@ -147,9 +133,7 @@ func (e *EverestServer) enforceDBRestoreRBAC(user, namespace, srcBackupName, dbC
 func (e *EverestServer) DeleteDatabaseClusterRestore(ctx echo.Context, namespace, name string) error {
 	user, err := rbac.GetUser(ctx)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed to get user from context" + err.Error()),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed to get user from context"+err.Error())
 	}
 
 	rs, err := e.kubeClient.GetDatabaseClusterRestore(ctx.Request().Context(), namespace, name)
@@ -168,9 +152,7 @@ func (e *EverestServer) DeleteDatabaseClusterRestore(ctx echo.Context, namespace
 func (e *EverestServer) GetDatabaseClusterRestore(ctx echo.Context, namespace, name string) error {
 	user, err := rbac.GetUser(ctx)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed to get user from context" + err.Error()),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed to get user from context"+err.Error())
 	}
 
 	rs, err := e.kubeClient.GetDatabaseClusterRestore(ctx.Request().Context(), namespace, name)
@@ -189,9 +171,7 @@ func (e *EverestServer) GetDatabaseClusterRestore(ctx echo.Context, namespace, n
 func (e *EverestServer) UpdateDatabaseClusterRestore(ctx echo.Context, namespace, name string) error {
 	user, err := rbac.GetUser(ctx)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed to get user from context" + err.Error()),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed to get user from context"+err.Error())
 	}
 
 	rs, err := e.kubeClient.GetDatabaseClusterRestore(ctx.Request().Context(), namespace, name)
@@ -206,24 +186,20 @@ func (e *EverestServer) UpdateDatabaseClusterRestore(ctx echo.Context, namespace
 	restore := &DatabaseClusterRestore{}
 	if err := e.getBodyFromContext(ctx, restore); err != nil {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusBadRequest, Error{
-			Message: pointer.ToString("Could not get DatabaseClusterRestore from the request body"),
-		})
+		return writeError(ctx, http.StatusBadRequest, "Could not get DatabaseClusterRestore from the request body")
 	}
 	if err := validateMetadata(restore.Metadata); err != nil {
-		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		return writeErrorFrom(ctx, http.StatusBadRequest, err)
 	}
 	if err := validateDatabaseClusterRestore(ctx.Request().Context(), namespace, restore, e.kubeClient); err != nil {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusBadRequest, Error{
-			Message: pointer.ToString(err.Error()),
-		})
+		return writeErrorFrom(ctx, http.StatusBadRequest, err)
 	}
 
 	return e.proxyKubernetes(ctx, namespace, databaseClusterRestoreKind, name)
 }
 
-func (e *EverestServer) enforceDBClusterListRestoreRBAC(user string, restore *everestv1alpha1.DatabaseClusterRestore, action string) error {
+func (e *EverestServer) enforceDBClusterListRestoreRBAC(user rbac.User, restore *everestv1alpha1.DatabaseClusterRestore, action string) error {
 	err := e.enforce(user, rbac.ResourceDatabaseClusterRestores, action, rbac.ObjectName(restore.GetNamespace(), restore.Spec.DBClusterName))
 	if err != nil {
 		if !errors.Is(err, errInsufficientPermissions) {
@@ -2,17 +2,21 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	"github.com/percona/everest/pkg/kubernetes"
 	"github.com/percona/everest/pkg/kubernetes/client"
+	"github.com/percona/everest/pkg/rbac"
 )
 
 func TestLatestRestorableDate(t *testing.T) {
@@ -254,3 +258,86 @@ func TestConnectionURL(t *testing.T) {
 		})
 	}
 }
+
+func TestDBClusterUpdateActions(t *testing.T) {
+	t.Parallel()
+	old := &everestv1alpha1.DatabaseCluster{
+		Spec: everestv1alpha1.DatabaseClusterSpec{
+			Engine: everestv1alpha1.Engine{
+				Type:     everestv1alpha1.DatabaseEnginePXC,
+				Replicas: 3,
+				Version:  "8.0.36-28.1",
+				Storage: everestv1alpha1.Storage{
+					Size: resource.MustParse("10Gi"),
+				},
+				Resources: everestv1alpha1.Resources{
+					CPU:    resource.MustParse("1"),
+					Memory: resource.MustParse("1G"),
+				},
+			},
+			Proxy: everestv1alpha1.Proxy{
+				Expose: everestv1alpha1.Expose{
+					Type: everestv1alpha1.ExposeTypeInternal,
+				},
+			},
+		},
+	}
+	base := `"engine": {"type": "pxc", "replicas": 3, "version": "8.0.36-28.1", "storage": {"size": "10Gi"}, "resources": {"cpu": "1000m", "memory": "1G"}}`
+	cases := []struct {
+		desc     string
+		updated  string
+		expected []string
+	}{
+		{
+			desc:     "no changes",
+			updated:  `{"spec": {` + base + `}}`,
+			expected: []string{},
+		},
+		{
+			desc:     "scaled",
+			updated:  `{"spec": {"engine": {"type": "pxc", "replicas": 5, "storage": {"size": "10Gi"}, "resources": {"cpu": "1", "memory": "1G"}}}}`,
+			expected: []string{rbac.ActionUpdateScale},
+		},
+		{
+			desc:     "resized",
+			updated:  `{"spec": {"engine": {"type": "pxc", "replicas": 3, "storage": {"size": "20Gi"}, "resources": {"cpu": "1", "memory": "2G"}}}}`,
+			expected: []string{rbac.ActionUpdateScale},
+		},
+		{
+			desc:     "exposed",
+			updated:  `{"spec": {` + base + `, "proxy": {"expose": {"type": "external"}}}}`,
+			expected: []string{rbac.ActionUpdateExpose},
+		},
+		{
+			desc:     "version upgraded",
+			updated:  `{"spec": {"engine": {"type": "pxc", "replicas": 3, "version": "8.0.37-29.1", "storage": {"size": "10Gi"}, "resources": {"cpu": "1", "memory": "1G"}}}}`,
+			expected: []string{rbac.ActionUpdateVersion},
+		},
+		{
+			desc:     "backups enabled",
+			updated:  `{"spec": {` + base + `, "backup": {"enabled": true}}}`,
+			expected: []string{rbac.ActionUpdateBackup},
+		},
+		{
+			desc:     "unsafe configuration allowed",
+			updated:  `{"spec": {` + base + `, "allowUnsafeConfiguration": true}}`,
+			expected: []string{rbac.ActionUpdateUnsafe},
+		},
+		{
+			desc:     "scaled and exposed",
+			updated:  `{"spec": {"engine": {"type": "pxc", "replicas": 5, "storage": {"size": "10Gi"}, "resources": {"cpu": "1", "memory": "1G"}}, "proxy": {"expose": {"type": "external"}}}}`,
+			expected: []string{rbac.ActionUpdateScale, rbac.ActionUpdateExpose},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			updated := &DatabaseCluster{}
+			require.NoError(t, json.Unmarshal([]byte(tc.updated), updated))
+
+			actions, err := dbClusterUpdateActions(updated, old)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actions)
+		})
+	}
+}
@@ -27,7 +27,6 @@ import (
 	goversion "github.com/hashicorp/go-version"
 	"github.com/labstack/echo/v4"
 	"golang.org/x/net/context"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	"github.com/percona/everest/pkg/rbac"
@@ -52,25 +51,16 @@ type operatorUpgradePreflight struct {
 func (e *EverestServer) ListDatabaseEngines(ctx echo.Context, namespace string) error {
 	user, err := rbac.GetUser(ctx)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed to get user from context" + err.Error()),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed to get user from context"+err.Error())
 	}
-	rbacListFilter := transformK8sList(func(l *unstructured.UnstructuredList) error {
-		allowed := []unstructured.Unstructured{}
-		for _, obj := range l.Items {
-			if err := e.enforce(user, rbac.ResourceDatabaseEngines, rbac.ActionRead, rbac.ObjectName(namespace, obj.GetName())); errors.Is(err, errInsufficientPermissions) {
-				continue
-			} else if err != nil {
-				e.l.Error(errors.Join(err, errors.New("failed to check database-engine permissions")))
-				return err
-			}
-			allowed = append(allowed, obj)
+	rbacFilter := rbacListFilter(func(dbe *everestv1alpha1.DatabaseEngine) error {
+		err := e.enforce(user, rbac.ResourceDatabaseEngines, rbac.ActionRead, rbac.ObjectName(namespace, dbe.GetName()))
+		if err != nil && !errors.Is(err, errInsufficientPermissions) {
+			e.l.Error(errors.Join(err, errors.New("failed to check database-engine permissions")))
 		}
-		l.Items = allowed
-		return nil
+		return err
 	})
-	return e.proxyKubernetes(ctx, namespace, databaseEngineKind, "", rbacListFilter)
+	return e.proxyKubernetes(ctx, namespace, databaseEngineKind, "", rbacFilter)
 }
 
 // GetDatabaseEngine Get the specified database engine on the specified namespace.
@@ -83,13 +73,11 @@ func (e *EverestServer) UpdateDatabaseEngine(ctx echo.Context, namespace, name s
 	dbe := &DatabaseEngine{}
 	if err := e.getBodyFromContext(ctx, dbe); err != nil {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusBadRequest, Error{
-			Message: pointer.ToString("Could not get DatabaseEngine from the request body"),
-		})
+		return writeError(ctx, http.StatusBadRequest, "Could not get DatabaseEngine from the request body")
 	}
 
 	if err := validateMetadata(dbe.Metadata); err != nil {
-		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		return writeErrorFrom(ctx, http.StatusBadRequest, err)
 	}
 	return e.proxyKubernetes(ctx, namespace, databaseEngineKind, name)
 }
@@ -102,9 +90,7 @@ func (e *EverestServer) GetUpgradePlan(
 	ctx := c.Request().Context()
 	user, err := rbac.GetUser(c)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed to get user from context:" + err.Error()),
-		})
+		return writeError(c, http.StatusInternalServerError, "Failed to get user from context:"+err.Error())
 	}
 	result, err := e.getUpgradePlan(ctx, namespace)
 	if err != nil {
@@ -160,9 +146,7 @@ func (e *EverestServer) ApproveUpgradePlan(c echo.Context, namespace string) err
 	ctx := c.Request().Context()
 	user, err := rbac.GetUser(c)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed to get user from context:" + err.Error()),
-		})
+		return writeError(c, http.StatusInternalServerError, "Failed to get user from context:"+err.Error())
 	}
 
 	up, err := e.getUpgradePlan(ctx, namespace)
@@ -197,9 +181,7 @@ func (e *EverestServer) ApproveUpgradePlan(c echo.Context, namespace string) err
 		if err := e.setLockDBEnginesForUpgrade(ctx, namespace, up, false); err != nil {
 			return errors.Join(err, errors.New("failed to release lock"))
 		}
-		return c.JSON(http.StatusPreconditionFailed, Error{
-			Message: pointer.ToString("One or more database clusters are not ready for upgrade"),
-		})
+		return writeError(c, http.StatusPreconditionFailed, "One or more database clusters are not ready for upgrade")
 	}
 
 	// start upgrade process.
@@ -0,0 +1,243 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/labstack/echo/v4"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// problemContentType is the media type of the error responses, see RFC 7807.
+	problemContentType = "application/problem+json"
+	// problemType is the type of all the error responses, which are identified by their code instead.
+	problemType = "about:blank"
+)
+
+// apiError is an error that is returned to the client with a specific code,
+// along with the path of the request field that caused it.
+type apiError struct {
+	code  ErrorCode
+	field string
+	err   error
+}
+
+func (e *apiError) Error() string {
+	return e.err.Error()
+}
+
+func (e *apiError) Unwrap() error {
+	return e.err
+}
+
+// newFieldError returns an error caused by the invalid request field at the given path, e.g. metadata.name.
+func newFieldError(field string, err error) error {
+	return &apiError{code: ValidationFailed, field: field, err: err}
+}
+
+// newProblem returns the error response with the given status, code and detail.
+func newProblem(status int, code ErrorCode, detail string) Error {
+	return Error{
+		Type:   pointer.ToString(problemType),
+		Title:  pointer.ToString(http.StatusText(status)),
+		Status: pointer.ToInt(status),
+		Code:   pointer.To(code),
+		Detail: pointer.ToString(detail),
+		// Kept for the clients that do not know about the problem details yet.
+		Message: pointer.ToString(detail),
+	}
+}
+
+// problemFromError returns the error response for err with the given status.
+// The code, the field and the Kubernetes reason are taken from err if it carries them,
+// otherwise the code is derived from the status.
+func problemFromError(status int, err error) Error {
+	p := newProblem(status, codeForStatus(status), err.Error())
+	setErrorDetails(&p, err)
+	return p
+}
+
+// setErrorDetails sets the code, the field and the Kubernetes reason of the error response from err, if it carries them.
+func setErrorDetails(p *Error, err error) {
+	var apiErr *apiError
+	var k8sErr k8serrors.APIStatus
+	var reqErr *openapi3filter.RequestError
+	switch {
+	case errors.As(err, &apiErr):
+		p.Code = pointer.To(apiErr.code)
+		if apiErr.field != "" {
+			p.Field = pointer.ToString(apiErr.field)
+		}
+	case errors.As(err, &k8sErr):
+		setKubernetesStatus(p, k8sErr.Status())
+	case errors.As(err, &reqErr):
+		p.Code = pointer.To(ValidationFailed)
+		if field := requestErrorField(reqErr); field != "" {
+			p.Field = pointer.ToString(field)
+		}
+	}
+}
+
+// setKubernetesStatus sets the code, the reason and the field of the error response from a Kubernetes status.
+func setKubernetesStatus(p *Error, status metav1.Status) {
+	if status.Reason != "" {
+		p.Reason = pointer.ToString(string(status.Reason))
+	}
+	if code, ok := codeForReason(status.Reason); ok {
+		p.Code = pointer.To(code)
+	}
+	if status.Details != nil {
+		for _, cause := range status.Details.Causes {
+			if cause.Field != "" {
+				p.Field = pointer.ToString(cause.Field)
+				break
+			}
+		}
+	}
+}
+
+// requestErrorField returns the path of the request field an OpenAPI request validation error is about.
+func requestErrorField(err *openapi3filter.RequestError) string {
+	if err.Parameter != nil {
+		return err.Parameter.Name
+	}
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err.Err, &schemaErr) {
+		return strings.Join(schemaErr.JSONPointer(), ".")
+	}
+	return ""
+}
+
+// codeForStatus returns the code of the errors with the given HTTP status that do not carry a more specific one.
+func codeForStatus(status int) ErrorCode {
+	switch status {
+	case http.StatusUnauthorized:
+		return Unauthorized
+	case http.StatusForbidden:
+		return Forbidden
+	case http.StatusNotFound:
+		return NotFound
+	case http.StatusConflict:
+		return Conflict
+	case http.StatusUnprocessableEntity:
+		return Invalid
+	case http.StatusTooManyRequests:
+		return TooManyRequests
+	case http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return Unavailable
+	}
+	if status >= http.StatusInternalServerError {
+		return InternalError
+	}
+	return BadRequest
+}
+
+// codeForReason returns the code of the errors with the given Kubernetes reason.
+func codeForReason(reason metav1.StatusReason) (ErrorCode, bool) {
+	switch reason { //nolint:exhaustive
+	case metav1.StatusReasonBadRequest:
+		return BadRequest, true
+	case metav1.StatusReasonUnauthorized:
+		return Unauthorized, true
+	case metav1.StatusReasonForbidden:
+		return Forbidden, true
+	case metav1.StatusReasonNotFound, metav1.StatusReasonGone:
+		return NotFound, true
+	case metav1.StatusReasonAlreadyExists:
+		return AlreadyExists, true
+	case metav1.StatusReasonConflict:
+		return Conflict, true
+	case metav1.StatusReasonInvalid:
+		return Invalid, true
+	case metav1.StatusReasonTooManyRequests:
+		return TooManyRequests, true
+	case metav1.StatusReasonServiceUnavailable, metav1.StatusReasonTimeout, metav1.StatusReasonServerTimeout:
+		return Unavailable, true
+	}
+	return "", false
+}
+
+// writeProblem writes the error response.
+func writeProblem(c echo.Context, p Error) error {
+	p.Instance = pointer.ToString(c.Request().URL.Path)
+	b, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	return c.Blob(pointer.GetInt(p.Status), problemContentType, b)
+}
+
+// writeError writes the error response with the given status and detail.
+func writeError(c echo.Context, status int, detail string) error {
+	return writeProblem(c, newProblem(status, codeForStatus(status), detail))
+}
+
+// writeErrorFrom writes the error response for err with the given status.
+func writeErrorFrom(c echo.Context, status int, err error) error {
+	return writeProblem(c, problemFromError(status, err))
+}
+
+// problemErrorHandler writes the errors returned by the handlers as error responses.
+// The details of the internal errors are not disclosed to the client.
+func problemErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	var p Error
+	var he *echo.HTTPError
+	var apiErr *apiError
+	var k8sErr k8serrors.APIStatus
+	switch {
+	case errors.As(err, &he):
+		detail := http.StatusText(he.Code)
+		if msg, ok := he.Message.(string); ok && msg != "" {
+			detail = msg
+		}
+		p = newProblem(he.Code, codeForStatus(he.Code), detail)
+		if he.Internal != nil {
+			setErrorDetails(&p, he.Internal)
+		}
+	case errors.As(err, &apiErr):
+		p = problemFromError(http.StatusBadRequest, err)
+	case errors.As(err, &k8sErr):
+		status := int(k8sErr.Status().Code)
+		if status == 0 {
+			status = http.StatusInternalServerError
+		}
+		p = problemFromError(status, err)
+	default:
+		p = newProblem(http.StatusInternalServerError, InternalError, http.StatusText(http.StatusInternalServerError))
+	}
+
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(pointer.GetInt(p.Status))
+	} else {
+		err = writeProblem(c, p)
+	}
+	if err != nil {
+		c.Logger().Error(err)
+	}
+}
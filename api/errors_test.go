@@ -0,0 +1,163 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestProblemFromError(t *testing.T) {
+	t.Parallel()
+	gr := schema.GroupResource{Group: "everest.percona.com", Resource: "databaseclusters"}
+
+	testCases := []struct {
+		name   string
+		status int
+		err    error
+		code   ErrorCode
+		field  string
+		reason string
+	}{
+		{
+			name:   "field error",
+			status: http.StatusBadRequest,
+			err:    ErrNameTooLong("metadata.name"),
+			code:   ValidationFailed,
+			field:  "metadata.name",
+		},
+		{
+			name:   "wrapped field error",
+			status: http.StatusBadRequest,
+			err:    errors.Join(errEvenEngineReplicas, errors.New("failed validating")),
+			code:   ValidationFailed,
+			field:  "spec.engine.replicas",
+		},
+		{
+			name:   "kubernetes conflict",
+			status: http.StatusConflict,
+			err:    k8serrors.NewConflict(gr, "db", errors.New("the object has been modified")),
+			code:   Conflict,
+			reason: "Conflict",
+		},
+		{
+			name:   "kubernetes already exists",
+			status: http.StatusConflict,
+			err:    k8serrors.NewAlreadyExists(gr, "db"),
+			code:   AlreadyExists,
+			reason: "AlreadyExists",
+		},
+		{
+			name:   "kubernetes invalid",
+			status: http.StatusUnprocessableEntity,
+			err: k8serrors.NewInvalid(schema.GroupKind{Group: gr.Group, Kind: "DatabaseCluster"}, "db", field.ErrorList{
+				field.Invalid(field.NewPath("spec", "engine", "replicas"), 2, "must be odd"),
+			}),
+			code:   Invalid,
+			field:  "spec.engine.replicas",
+			reason: "Invalid",
+		},
+		{
+			name:   "plain error",
+			status: http.StatusNotFound,
+			err:    errors.New("not found"),
+			code:   NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			p := problemFromError(tc.status, tc.err)
+			assert.Equal(t, tc.status, pointer.GetInt(p.Status))
+			assert.Equal(t, tc.code, pointer.Get(p.Code))
+			assert.Equal(t, tc.field, pointer.GetString(p.Field))
+			assert.Equal(t, tc.reason, pointer.GetString(p.Reason))
+			assert.Equal(t, tc.err.Error(), pointer.GetString(p.Detail))
+			assert.Equal(t, tc.err.Error(), pointer.GetString(p.Message))
+		})
+	}
+}
+
+func TestProblemErrorHandler(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		err    error
+		status int
+		code   ErrorCode
+		detail string
+	}{
+		{
+			name:   "http error",
+			err:    echo.NewHTTPError(http.StatusForbidden, "Forbidden by policy"),
+			status: http.StatusForbidden,
+			code:   Forbidden,
+			detail: "Forbidden by policy",
+		},
+		{
+			name:   "field error",
+			err:    errDBCNameEmpty,
+			status: http.StatusBadRequest,
+			code:   ValidationFailed,
+			detail: errDBCNameEmpty.Error(),
+		},
+		{
+			name:   "kubernetes not found",
+			err:    k8serrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "creds"),
+			status: http.StatusNotFound,
+			code:   NotFound,
+			detail: `secrets "creds" not found`,
+		},
+		{
+			name:   "internal error",
+			err:    errors.New("connection refused"),
+			status: http.StatusInternalServerError,
+			code:   InternalError,
+			detail: http.StatusText(http.StatusInternalServerError),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/v1/namespaces", nil), rec)
+
+			problemErrorHandler(tc.err, c)
+
+			assert.Equal(t, tc.status, rec.Code)
+			assert.Equal(t, problemContentType, rec.Header().Get(echo.HeaderContentType))
+			p := Error{}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+			assert.Equal(t, tc.code, pointer.Get(p.Code))
+			assert.Equal(t, tc.detail, pointer.GetString(p.Detail))
+			assert.Equal(t, "/v1/namespaces", pointer.GetString(p.Instance))
+		})
+	}
+}
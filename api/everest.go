@@ -20,14 +20,16 @@ package api
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io/fs"
 	"net/http"
 	"slices"
+	"sync/atomic"
 
 	"github.com/casbin/casbin/v2"
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/golang-jwt/jwt/v5"
 	casbinmiddleware "github.com/labstack/echo-contrib/casbin"
@@ -36,19 +38,22 @@ import (
 	echomiddleware "github.com/labstack/echo/v4/middleware"
 	middleware "github.com/oapi-codegen/echo-middleware"
 	"go.uber.org/zap"
-	"golang.org/x/time/rate"
-	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
 	"github.com/percona/everest/cmd/config"
+	"github.com/percona/everest/pkg/accounts"
+	"github.com/percona/everest/pkg/accounts/ldap"
+	"github.com/percona/everest/pkg/audit"
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/metrics"
 	"github.com/percona/everest/pkg/oidc"
+	"github.com/percona/everest/pkg/ratelimit"
 	"github.com/percona/everest/pkg/rbac"
 	"github.com/percona/everest/pkg/session"
-	"github.com/percona/everest/public"
+	"github.com/percona/everest/pkg/tlsconfig"
 )
 
 // EverestServer represents the server struct.
@@ -58,8 +63,24 @@ type EverestServer struct {
 	echo          *echo.Echo
 	kubeClient    *kubernetes.Kubernetes
 	sessionMgr    *session.Manager
-	attemptsStore *RateLimiterMemoryStore
+	ipRateLimiter *ratelimit.Limiter
+	rateLimiter   *ratelimit.Limiter
+	loginThrottle ratelimit.Throttle
 	rbacEnforcer  casbin.IEnforcer
+	rbacStore     *rbac.PolicyStore
+	// auditLog records the mutating requests. Holds nil if the audit log is disabled.
+	auditLog *audit.Logger
+	// oidcVerifier verifies the tokens issued by the trusted OIDC providers.
+	// Holds nil if OIDC is not configured.
+	oidcVerifier atomic.Pointer[oidc.Verifier]
+	// cancelOIDCVerifier stops the current OIDC verifier.
+	cancelOIDCVerifier context.CancelFunc
+	// oidcConfigRaw holds the OIDC settings the current OIDC verifier was created from.
+	oidcConfigRaw string
+	// tlsConfig is the TLS configuration of the API server. Holds nil if TLS is disabled.
+	tlsConfig *tls.Config
+	// redirectServer redirects the HTTP requests to HTTPS. Holds nil if the redirect is disabled.
+	redirectServer *http.Server
 }
 
 // NewEverestServer creates and configures everest API.
@@ -69,12 +90,42 @@ func NewEverestServer(ctx context.Context, c *config.EverestConfig, l *zap.Sugar
 		return nil, errors.Join(err, errors.New("failed creating Kubernetes client"))
 	}
 
+	swagger, err := getSwagger(c)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to get swagger"))
+	}
+	basePath, err := swagger.Servers.BasePath()
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not get base path"))
+	}
+
+	operations := newAPIOperations(swagger, basePath)
 	echoServer := echo.New()
-	echoServer.Use(echomiddleware.RateLimiter(echomiddleware.NewRateLimiterMemoryStore(rate.Limit(c.APIRequestsRateLimit))))
-	middleware, store := sessionRateLimiter(c.CreateSessionRateLimit)
-	echoServer.Use(middleware)
+	echoServer.Use(newMetricsMiddleware(operations))
+	echoServer.Use(newTracingMiddleware(operations))
+	echoServer.IPExtractor, err = ratelimit.IPExtractor(c.RateLimit)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("invalid rate limit configuration"))
+	}
+	ipRateLimiter, rateLimiter, loginThrottle, err := newRateLimiter(ctx, c, kubeClient, basePath, l)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to create rate limiter"))
+	}
+	denylist := session.NewDenylistStore(kubeClient)
+	if err := denylist.Watch(ctx, kubeClient.Config(), l); err != nil {
+		return nil, errors.Join(err, errors.New("failed to watch session denylist"))
+	}
+	accountManager, err := newAccountManager(c, kubeClient, denylist)
+	if err != nil {
+		return nil, err
+	}
 	sessMgr, err := session.New(
-		session.WithAccountManager(kubeClient.Accounts()),
+		session.WithAccountManager(accountManager),
+		session.WithDenylist(denylist),
+		session.WithLockoutPolicy(accounts.LockoutPolicy{
+			Threshold: c.AccountLockoutThreshold,
+			Duration:  c.AccountLockoutDuration,
+		}),
 	)
 	if err != nil {
 		return nil, errors.Join(err, errors.New("failed to create session manager"))
@@ -85,46 +136,78 @@ func NewEverestServer(ctx context.Context, c *config.EverestConfig, l *zap.Sugar
 		echo:          echoServer,
 		kubeClient:    kubeClient,
 		sessionMgr:    sessMgr,
-		attemptsStore: store,
+		ipRateLimiter: ipRateLimiter,
+		rateLimiter:   rateLimiter,
+		loginThrottle: loginThrottle,
+		rbacStore:     rbac.NewPolicyStore(kubeClient, c.RBACHistorySize),
 	}
 	e.echo.HTTPErrorHandler = e.errorHandlerChain()
 
+	if c.TLS.Enabled {
+		e.tlsConfig, err = tlsconfig.New(ctx, c.TLS, l)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("invalid TLS configuration"))
+		}
+		if c.TLS.RedirectHTTP {
+			e.redirectServer = &http.Server{
+				Addr:              fmt.Sprintf("0.0.0.0:%d", c.HTTPPort),
+				Handler:           httpsRedirectHandler(c.TLS.Port),
+				ReadHeaderTimeout: redirectReadHeaderTimeout,
+			}
+		}
+	}
+
+	e.auditLog, err = audit.NewFromConfig(c.Audit, l)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to create audit log"))
+	}
+
 	if err := e.initHTTPServer(ctx); err != nil {
 		return e, err
 	}
 	return e, err
 }
 
+// newAccountManager returns the accounts backend selected in the configuration.
+//
+//nolint:ireturn
+func newAccountManager(
+	c *config.EverestConfig,
+	kubeClient *kubernetes.Kubernetes,
+	denylist *session.DenylistStore,
+) (accounts.Interface, error) {
+	switch c.AccountsBackend {
+	case config.AccountsBackendLDAP:
+		m, err := ldap.New(c.LDAP, ldap.WithDenylist(denylist))
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed to create LDAP accounts backend"))
+		}
+		return m, nil
+	default:
+		return kubeClient.Accounts(), nil
+	}
+}
+
 // initHTTPServer configures http server for the current EverestServer instance.
 //
 //nolint:funlen
 func (e *EverestServer) initHTTPServer(ctx context.Context) error {
-	swagger, err := GetSwagger()
+	swagger, err := getSwagger(e.config)
 	if err != nil {
 		return err
 	}
-	fsys, err := fs.Sub(public.Static, "dist")
-	if err != nil {
-		return errors.Join(err, errors.New("error reading filesystem"))
-	}
-	staticFilesHandler := http.FileServer(http.FS(fsys))
-	indexFS := echo.MustSubFS(public.Index, "dist")
-	// FIXME: Ideally it should be redirected to /everest/ and FE app should be served using this endpoint.
-	//
-	// We tried to do this with Fabio and FE app requires the following changes to be implemented:
-	// 1. Add basePath configuration for react router
-	// 2. Add apiUrl configuration for FE app
-	//
-	// Once it'll be implemented we can serve FE app on /everest/ location
-	e.echo.FileFS("/*", "index.html", indexFS)
-	e.echo.GET("/favicon.ico", echo.WrapHandler(staticFilesHandler))
-	e.echo.GET("/assets-manifest.json", echo.WrapHandler(staticFilesHandler))
-	e.echo.GET("/static/*", echo.WrapHandler(staticFilesHandler))
+	if err := e.installUI(); err != nil {
+		return err
+	}
+	// The metrics are served outside of the API group, so that they can be scraped without authentication.
+	e.echo.GET("/metrics", echo.WrapHandler(metrics.Handler()))
+	e.installHealthChecks()
 	e.echo.Use(echomiddleware.LoggerWithConfig(echomiddleware.LoggerConfig{
 		Format:           echomiddleware.DefaultLoggerConfig.Format,
 		CustomTimeFormat: echomiddleware.DefaultLoggerConfig.CustomTimeFormat,
 		Skipper: func(c echo.Context) bool {
-			return c.Request().RequestURI == "/healthz"
+			path := c.Request().URL.Path
+			return isHealthCheck(e.config.BasePath, path) || path == "/metrics"
 		},
 	}))
 	e.echo.Pre(echomiddleware.RemoveTrailingSlash())
@@ -135,6 +218,9 @@ func (e *EverestServer) initHTTPServer(ctx context.Context) error {
 	}
 
 	apiGroup := e.echo.Group(basePath)
+	// Limit the requests by client address before authentication, so that the requests
+	// with a missing or invalid token are limited as well.
+	apiGroup.Use(e.ipRateLimiter.Middleware())
 
 	// Use our validation middleware to check all requests against the OpenAPI schema.
 	apiGroup.Use(middleware.OapiRequestValidatorWithOptions(swagger, &middleware.Options{
@@ -145,12 +231,21 @@ func (e *EverestServer) initHTTPServer(ctx context.Context) error {
 			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
 		},
 	}))
+	// Requests made with a verified client certificate do not need a token.
+	apiGroup.Use(e.setClientCertUser)
 	// Setup and use JWT middleware.
 	jwtMW, err := e.jwtMiddleWare(ctx)
 	if err != nil {
 		return err
 	}
 	apiGroup.Use(jwtMW)
+	apiGroup.Use(e.setOIDCUser)
+
+	// Limit the requests after authentication as well, if they are limited by user.
+	if e.rateLimiter != nil {
+		apiGroup.Use(e.rateLimiter.Middleware())
+	}
+	apiGroup.Use(e.loginThrottleMiddleware(basePath))
 
 	// Setup and use RBAC (casbin) middleware.
 	rbacMW, err := e.rbacMiddleware(ctx, basePath)
@@ -159,53 +254,57 @@ func (e *EverestServer) initHTTPServer(ctx context.Context) error {
 	}
 	apiGroup.Use(rbacMW)
 
+	// Record the mutating requests that passed the RBAC checks.
+	if e.auditLog != nil {
+		auditMW, err := e.auditMiddleware(basePath)
+		if err != nil {
+			return err
+		}
+		apiGroup.Use(auditMW)
+	}
+
 	apiGroup.Use(e.checkOperatorUpgradeState)
 	RegisterHandlers(apiGroup, e)
 
 	return nil
 }
 
-func (e *EverestServer) oidcKeyFn(ctx context.Context) (jwt.Keyfunc, error) {
-	settings, err := e.kubeClient.GetEverestSettings(ctx)
-	if err = client.IgnoreNotFound(err); err != nil {
+// parseToken parses and validates a token issued either by Everest or by one of the trusted OIDC providers.
+func (e *EverestServer) parseToken(_ echo.Context, auth string) (interface{}, error) {
+	unverified := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(auth, unverified); err != nil {
 		return nil, err
 	}
-	if settings.OIDCConfigRaw == "" {
-		return nil, nil //nolint:nilnil
-	}
-	oidcConfig, err := settings.OIDCConfig()
+	issuer, err := unverified.GetIssuer()
 	if err != nil {
-		return nil, errors.Join(err, errors.New("cannot parse OIDC raw config"))
+		return nil, errors.Join(err, errors.New("failed to get issuer from claims"))
 	}
-	return oidc.NewKeyFunc(ctx, oidcConfig.IssuerURL)
-}
 
-func (e *EverestServer) newJWTKeyFunc(ctx context.Context) (jwt.Keyfunc, error) {
-	oidcKeyFn, err := e.oidcKeyFn(ctx)
-	if err != nil {
-		return nil, errors.Join(err, errors.New("failed to get OIDC key function"))
+	if issuer == session.SessionManagerClaimsIssuer {
+		return jwt.ParseWithClaims(auth, jwt.MapClaims{}, e.everestKeyFunc,
+			jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}),
+		)
 	}
+	// Any other token must be issued by one of the trusted OIDC providers.
+	if verifier := e.oidcVerifier.Load(); verifier != nil {
+		return verifier.Parse(auth)
+	}
+	return nil, errors.New("no key found for token")
+}
 
-	return func(token *jwt.Token) (interface{}, error) {
-		claims, ok := token.Claims.(jwt.MapClaims)
-		if !ok {
-			return nil, errors.New("failed to get claims from token")
-		}
-		issuer, err := claims.GetIssuer()
-		if err != nil {
-			return "", errors.Join(err, errors.New("failed to get issuer from claims"))
-		}
-
-		if issuer == session.SessionManagerClaimsIssuer {
-			return e.sessionMgr.KeyFunc()(token)
-		}
-		// XXX: currently we use OIDC only, but once we have multiple protocols supported,
-		// we should have a way to select which KeyFunc to use.
-		if oidcKeyFn != nil {
-			return oidcKeyFn(token)
-		}
-		return nil, errors.New("no key found for token")
-	}, nil
+// everestKeyFunc returns the key for verifying the tokens issued by Everest.
+func (e *EverestServer) everestKeyFunc(token *jwt.Token) (interface{}, error) {
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, errors.New("failed to get claims from token")
+	}
+	if !session.IsAccessToken(claims) {
+		return nil, errNotAccessToken
+	}
+	if e.sessionMgr.IsRevoked(claims) {
+		return nil, errTokenRevoked
+	}
+	return e.sessionMgr.KeyFunc()(token)
 }
 
 func (e *EverestServer) rbacMiddleware(ctx context.Context, basePath string) (echo.MiddlewareFunc, error) {
@@ -221,37 +320,53 @@ func (e *EverestServer) rbacMiddleware(ctx context.Context, basePath string) (ec
 	}
 	return casbinmiddleware.MiddlewareWithConfig(casbinmiddleware.Config{
 		Skipper:        skipper,
-		UserGetter:     rbac.GetUser,
+		UserGetter:     rbac.GetUsername,
 		EnforceHandler: rbac.NewEnforceHandler(e.l, basePath, enforcer),
 	}), nil
 }
 
 func (e *EverestServer) jwtMiddleWare(ctx context.Context) (echo.MiddlewareFunc, error) {
-	keyFunc, err := e.newJWTKeyFunc(ctx)
-	if err != nil {
+	if err := e.loadOIDCVerifier(ctx); err != nil {
+		return nil, errors.Join(err, errors.New("failed to create OIDC verifier"))
+	}
+	if err := e.refreshOIDCVerifierInBackground(ctx); err != nil {
 		return nil, err
 	}
 
-	skipper, err := newSkipperFunc()
+	swagger, err := getSwagger(e.config)
 	if err != nil {
 		return nil, err
 	}
+	skipper := newSkipperFunc(swagger)
 
 	tokenLookup := "header:Authorization:Bearer "
 	tokenLookup = tokenLookup + ",cookie:" + common.EverestTokenCookie
 	return echojwt.WithConfig(echojwt.Config{
-		Skipper:     skipper,
-		TokenLookup: tokenLookup,
-		KeyFunc:     keyFunc,
+		Skipper: func(c echo.Context) bool {
+			if skipper(c) {
+				return true
+			}
+			_, ok := e.clientCertUser(c)
+			return ok
+		},
+		TokenLookup:    tokenLookup,
+		ParseTokenFunc: e.parseToken,
 	}), nil
 }
 
-func newSkipperFunc() (echomiddleware.Skipper, error) {
+// getSwagger returns the API spec with its servers moved under the base path Everest is served at.
+func getSwagger(c *config.EverestConfig) (*openapi3.T, error) {
 	swagger, err := GetSwagger()
 	if err != nil {
 		return nil, err
 	}
+	for _, srv := range swagger.Servers {
+		srv.URL = c.BasePath + srv.URL
+	}
+	return swagger, nil
+}
 
+func newSkipperFunc(swagger *openapi3.T) echomiddleware.Skipper {
 	// list of API paths to exclude from security checks.
 	// Each item is a string in the format of "<method> <path>"
 	// For example: ["GET /v1/settings"]
@@ -271,12 +386,26 @@ func newSkipperFunc() (echomiddleware.Skipper, error) {
 	return func(c echo.Context) bool {
 		target := c.Request().Method + " " + c.Path()
 		return slices.Contains(excluded, target)
-	}, nil
+	}
 }
 
 // Start starts everest server.
+// If TLS is enabled, the server listens on the TLS port, and the HTTP port redirects to it if configured so.
 func (e *EverestServer) Start() error {
-	return e.echo.Start(fmt.Sprintf("0.0.0.0:%d", e.config.HTTPPort))
+	if e.tlsConfig == nil {
+		return e.echo.Start(fmt.Sprintf("0.0.0.0:%d", e.config.HTTPPort))
+	}
+
+	if e.redirectServer != nil {
+		go func() {
+			if err := e.redirectServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				e.l.Error(errors.Join(err, errors.New("could not start HTTPS redirect server")))
+			}
+		}()
+	}
+	e.echo.TLSServer.Addr = fmt.Sprintf("0.0.0.0:%d", e.config.TLS.Port)
+	e.echo.TLSServer.TLSConfig = e.tlsConfig
+	return e.echo.StartServer(e.echo.TLSServer)
 }
 
 // Shutdown gracefully stops the Everest server.
@@ -286,8 +415,19 @@ func (e *EverestServer) Shutdown(ctx context.Context) error {
 		e.l.Error(errors.Join(err, errors.New("could not shut down http server")))
 		return err
 	}
+	if e.redirectServer != nil {
+		if err := e.redirectServer.Shutdown(ctx); err != nil {
+			e.l.Error(errors.Join(err, errors.New("could not shut down HTTPS redirect server")))
+		}
+	}
 	e.l.Info("http server shut down")
 
+	if e.auditLog != nil {
+		if err := e.auditLog.Close(ctx); err != nil {
+			e.l.Error(errors.Join(err, errors.New("could not close audit log")))
+		}
+	}
+
 	return nil
 }
 
@@ -305,37 +445,12 @@ func (e *EverestServer) getBodyFromContext(ctx echo.Context, into any) error {
 	return nil
 }
 
-func sessionRateLimiter(limit int) (echo.MiddlewareFunc, *RateLimiterMemoryStore) {
-	allButSession := func(c echo.Context) bool {
-		return c.Request().Method != echo.POST || c.Request().URL.Path != "/v1/session"
-	}
-	config := echomiddleware.DefaultRateLimiterConfig
-	config.Skipper = allButSession
-	store := NewRateLimiterMemoryStoreWithConfig(RateLimiterMemoryStoreConfig{
-		Rate: rate.Limit(limit),
-	})
-	config.Store = store
-	return echomiddleware.RateLimiterWithConfig(config), store
-}
-
 func (e *EverestServer) errorHandlerChain() echo.HTTPErrorHandler {
-	h := e.echo.DefaultHTTPErrorHandler
-	h = k8sToAPIErrorHandler(h)
+	h := problemErrorHandler
 	h = enforcerErrorHandler(h)
 	return h
 }
 
-func k8sToAPIErrorHandler(next echo.HTTPErrorHandler) echo.HTTPErrorHandler {
-	return func(err error, c echo.Context) {
-		if k8serrors.IsNotFound(err) {
-			err = &echo.HTTPError{
-				Code: http.StatusNotFound,
-			}
-		}
-		next(err, c)
-	}
-}
-
 func enforcerErrorHandler(next echo.HTTPErrorHandler) echo.HTTPErrorHandler {
 	return func(err error, c echo.Context) {
 		if errors.Is(err, errInsufficientPermissions) {
@@ -351,13 +466,14 @@ func enforcerErrorHandler(next echo.HTTPErrorHandler) echo.HTTPErrorHandler {
 // enforce is a wrapper arounf casbin.Enforce that returns an errInsufficientPermissions error when enforce fails.
 // Typically, this error is handled centrally by the Everest server error handler chain, but if needed, the caller should handle
 // it explicitly to differentiate between other errors.
-func (e *EverestServer) enforce(subject, resource, action, object string) error {
-	ok, err := e.rbacEnforcer.Enforce(subject, resource, action, object)
+func (e *EverestServer) enforce(user rbac.User, resource, action, object string) error {
+	ok, err := rbac.Enforce(e.rbacEnforcer, user, resource, action, object)
 	if err != nil {
 		return fmt.Errorf("failed to enforce: %w", err)
 	}
 	if !ok {
-		e.l.Warnf("Permission denied: [%s %s %s %s]", subject, resource, action, object)
+		e.l.Warnf("Permission denied: [%s %s %s %s]", user.Name, resource, action, object)
+		metrics.IncRBACDenial(resource, action)
 		return errInsufficientPermissions
 	}
 	return nil
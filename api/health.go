@@ -0,0 +1,90 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/percona/everest/pkg/healthz"
+	"github.com/percona/everest/pkg/kubernetes/informer"
+)
+
+// healthCheckPaths are the paths of the health check endpoints.
+//
+//nolint:gochecknoglobals
+var healthCheckPaths = []string{"/healthz", "/livez", "/readyz"}
+
+// installHealthChecks registers the liveness and readiness endpoints.
+// The liveness checks only show that the server handles requests, so that it is not restarted
+// while one of its dependencies is unavailable. The readiness checks cover the dependencies.
+// `/healthz` is kept as an alias of `/livez` for the existing probes.
+func (e *EverestServer) installHealthChecks() {
+	g := e.echo.Group(e.config.BasePath)
+	healthz.Install(g, "healthz", e.l, healthz.Ping())
+	healthz.Install(g, "livez", e.l, healthz.Ping())
+	healthz.Install(g, "readyz", e.l,
+		healthz.Ping(),
+		healthz.NamedCheck("kubernetes", e.checkKubernetes),
+		healthz.NamedCheck("rbac", e.checkRBAC),
+		healthz.NamedCheck("oidc", e.checkOIDC),
+		healthz.NamedCheck("informers", checkInformers),
+	)
+}
+
+// isHealthCheck returns true if the request is for one of the health check endpoints served under basePath.
+func isHealthCheck(basePath, path string) bool {
+	for _, p := range healthCheckPaths {
+		p = basePath + p
+		if path == p || strings.HasPrefix(path, p+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// checkKubernetes checks that the Kubernetes API is reachable.
+func (e *EverestServer) checkKubernetes(_ context.Context) error {
+	_, err := e.kubeClient.GetServerVersion()
+	return err
+}
+
+// checkRBAC checks that the RBAC enforcer is loaded.
+func (e *EverestServer) checkRBAC(_ context.Context) error {
+	if e.rbacEnforcer == nil {
+		return errors.New("RBAC enforcer is not loaded")
+	}
+	return nil
+}
+
+// checkOIDC checks that the signing keys of the trusted OIDC providers are cached, if OIDC is configured.
+func (e *EverestServer) checkOIDC(ctx context.Context) error {
+	verifier := e.oidcVerifier.Load()
+	if verifier == nil {
+		return nil
+	}
+	return verifier.CheckKeys(ctx)
+}
+
+// checkInformers checks that the caches of all the informers are synced.
+func checkInformers(_ context.Context) error {
+	if notSynced := informer.NotSynced(); len(notSynced) > 0 {
+		return fmt.Errorf("informers not synced: %s", strings.Join(notSynced, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsHealthCheck(t *testing.T) {
+	t.Parallel()
+	assert.True(t, isHealthCheck("", "/readyz"))
+	assert.True(t, isHealthCheck("", "/readyz/kubernetes"))
+	assert.False(t, isHealthCheck("", "/readyzz"))
+	assert.True(t, isHealthCheck("/everest", "/everest/livez"))
+	assert.False(t, isHealthCheck("/everest", "/livez"))
+}
@@ -42,15 +42,13 @@ func (e *EverestServer) GetKubernetesClusterResources(ctx echo.Context) error {
 		volumes, err = e.kubeClient.GetPersistentVolumes(ctx.Request().Context())
 		if err != nil {
 			e.l.Error(err)
-			return ctx.JSON(http.StatusInternalServerError, Error{
-				Message: pointer.ToString("Could not get persistent volumes"),
-			})
+			return writeError(ctx, http.StatusInternalServerError, "Could not get persistent volumes")
 		}
 	}
 
 	res, err := e.calculateClusterResources(ctx, e.kubeClient, clusterType, volumes)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString(err.Error())})
+		return writeErrorFrom(ctx, http.StatusInternalServerError, err)
 	}
 
 	return ctx.JSON(http.StatusOK, res)
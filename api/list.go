@@ -0,0 +1,183 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// continueTokenHeader is the header holding the token of the next page
+// of the list responses that are not Kubernetes lists.
+const continueTokenHeader = "X-Continue-Token"
+
+const (
+	// backupStorageLabelTmpl is the label the operator marks the backups stored in a backup storage with.
+	backupStorageLabelTmpl  = "backupStorage-%s"
+	backupStorageLabelValue = "used"
+)
+
+var (
+	errInvalidLimit         = newFieldError("limit", errors.New("'limit' should be greater than 0"))
+	errInvalidLabelSelector = errors.New("invalid 'labelSelector'")
+	errSortedPage           = errors.New("sorting cannot be combined with 'limit' or 'continue'")
+)
+
+// listParams are the pagination and sorting parameters of a list request.
+type listParams struct {
+	limit         *int
+	continueToken *string
+	labelSelector *string
+	sortBy        *ListSortBy
+	sortOrder     *ListSortOrder
+}
+
+// listOptions returns the Kubernetes list options for the page requested by p.
+// The given label selectors are required in addition to the one of the request, empty ones are ignored.
+func (p listParams) listOptions(selectors ...string) (metav1.ListOptions, error) {
+	opts := metav1.ListOptions{Continue: pointer.GetString(p.continueToken)}
+	// Kubernetes does not sort the items, so only the items of a single page could be sorted.
+	if p.limit != nil || p.continueToken != nil {
+		if p.sortBy != nil {
+			return opts, newFieldError("sortBy", errSortedPage)
+		}
+		if p.sortOrder != nil {
+			return opts, newFieldError("sortOrder", errSortedPage)
+		}
+	}
+	if p.limit != nil {
+		if *p.limit < 1 {
+			return opts, errInvalidLimit
+		}
+		opts.Limit = int64(*p.limit)
+	}
+	if s := pointer.GetString(p.labelSelector); s != "" {
+		if _, err := labels.Parse(s); err != nil {
+			return opts, newFieldError("labelSelector", errors.Join(errInvalidLabelSelector, err))
+		}
+		selectors = append(selectors, s)
+	}
+	opts.LabelSelector = strings.Join(slices.DeleteFunc(selectors, func(s string) bool { return s == "" }), ",")
+	return opts, nil
+}
+
+// proxyQuery returns the query of a list request proxied to Kubernetes.
+func (p listParams) proxyQuery(selectors ...string) (url.Values, error) {
+	opts, err := p.listOptions(selectors...)
+	if err != nil {
+		return nil, err
+	}
+	query := url.Values{}
+	if opts.Limit > 0 {
+		query.Set("limit", strconv.FormatInt(opts.Limit, 10))
+	}
+	if opts.Continue != "" {
+		query.Set("continue", opts.Continue)
+	}
+	if opts.LabelSelector != "" {
+		query.Set("labelSelector", opts.LabelSelector)
+	}
+	return query, nil
+}
+
+// setContinueToken sets the token of the next page on a list response that is not a Kubernetes list.
+func setContinueToken(c echo.Context, meta metav1.ListMeta) {
+	if meta.Continue != "" {
+		c.Response().Header().Set(continueTokenHeader, meta.Continue)
+	}
+}
+
+// backupStorageSelector returns the label selector of the backups stored in the given backup storage,
+// so that Kubernetes filters them before paginating. Returns an empty string if no backup storage is given.
+func backupStorageSelector(name *string) string {
+	if name == nil {
+		return ""
+	}
+	key := fmt.Sprintf(backupStorageLabelTmpl, *name)
+	if len(validation.IsQualifiedName(key)) > 0 {
+		// Not a valid backup storage name, the backups are left to the field filter.
+		return ""
+	}
+	return key + "=" + backupStorageLabelValue
+}
+
+// sortObjects sorts the objects by the field and in the order requested by p.
+// The objects are left in the Kubernetes order if no sorting is requested.
+func sortObjects[T any, PT interface {
+	*T
+	metav1.Object
+}](objs []T, p listParams) {
+	if p.sortBy == nil && p.sortOrder == nil {
+		return
+	}
+	by := pointer.Get(p.sortBy)
+	desc := pointer.Get(p.sortOrder) == Desc
+	slices.SortStableFunc(objs, func(a, b T) int {
+		objA, objB := PT(&a), PT(&b)
+		cmp := 0
+		if by == CreationTimestamp {
+			cmp = objA.GetCreationTimestamp().Time.Compare(objB.GetCreationTimestamp().Time)
+		}
+		if cmp == 0 {
+			cmp = strings.Compare(objA.GetName(), objB.GetName())
+		}
+		if desc {
+			return -cmp
+		}
+		return cmp
+	})
+}
+
+// listSorter returns a transformer for a list proxied from Kubernetes that sorts the objects
+// by the field and in the order requested by p.
+func listSorter(p listParams) apiResponseTransformerFn {
+	return transformK8sList(func(l *unstructured.UnstructuredList) error {
+		sortObjects(l.Items, p)
+		return nil
+	})
+}
+
+// listFieldFilter returns a transformer for a list proxied from Kubernetes that removes the objects
+// whose fields do not have the requested values. The fields are given by their path, e.g. spec.engine.type,
+// and are compared case-insensitively. The fields without a requested value are not filtered on.
+func listFieldFilter(fields map[string]*string) apiResponseTransformerFn {
+	return transformK8sList(func(l *unstructured.UnstructuredList) error {
+		l.Items = slices.DeleteFunc(l.Items, func(obj unstructured.Unstructured) bool {
+			for path, want := range fields {
+				if want == nil {
+					continue
+				}
+				got, _, _ := unstructured.NestedString(obj.Object, strings.Split(path, ".")...)
+				if !strings.EqualFold(got, *want) {
+					return true
+				}
+			}
+			return false
+		})
+		return nil
+	})
+}
@@ -0,0 +1,244 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+)
+
+func TestListOptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		params    listParams
+		selectors []string
+		expected  metav1.ListOptions
+		field     string
+	}{
+		{
+			name:     "no parameters",
+			expected: metav1.ListOptions{},
+		},
+		{
+			name: "page",
+			params: listParams{
+				limit:         pointer.ToInt(10),
+				continueToken: pointer.ToString("token"),
+			},
+			expected: metav1.ListOptions{Limit: 10, Continue: "token"},
+		},
+		{
+			name:      "label selectors",
+			params:    listParams{labelSelector: pointer.ToString("env=prod,team!=qa")},
+			selectors: []string{"clusterName=db"},
+			expected:  metav1.ListOptions{LabelSelector: "clusterName=db,env=prod,team!=qa"},
+		},
+		{
+			name:      "empty label selectors",
+			selectors: []string{"clusterName=db", ""},
+			expected:  metav1.ListOptions{LabelSelector: "clusterName=db"},
+		},
+		{
+			name: "sorted list",
+			params: listParams{
+				sortBy:    pointer.To(CreationTimestamp),
+				sortOrder: pointer.To(Desc),
+			},
+			expected: metav1.ListOptions{},
+		},
+		{
+			name: "sorted page",
+			params: listParams{
+				limit:  pointer.ToInt(10),
+				sortBy: pointer.To(CreationTimestamp),
+			},
+			field: "sortBy",
+		},
+		{
+			name: "sorted next page",
+			params: listParams{
+				continueToken: pointer.ToString("token"),
+				sortOrder:     pointer.To(Desc),
+			},
+			field: "sortOrder",
+		},
+		{
+			name:   "invalid limit",
+			params: listParams{limit: pointer.ToInt(0)},
+			field:  "limit",
+		},
+		{
+			name:   "invalid label selector",
+			params: listParams{labelSelector: pointer.ToString("env in (prod")},
+			field:  "labelSelector",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			opts, err := tc.params.listOptions(tc.selectors...)
+			if tc.field != "" {
+				require.Error(t, err)
+				p := problemFromError(http.StatusBadRequest, err)
+				assert.Equal(t, tc.field, pointer.GetString(p.Field))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, opts)
+		})
+	}
+}
+
+func TestBackupStorageSelector(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "", backupStorageSelector(nil))
+	assert.Equal(t, "backupStorage-s3=used", backupStorageSelector(pointer.ToString("s3")))
+	assert.Equal(t, "", backupStorageSelector(pointer.ToString("not a name")))
+}
+
+func TestSortObjects(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	storages := func() []everestv1alpha1.BackupStorage {
+		return []everestv1alpha1.BackupStorage{
+			{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "b", CreationTimestamp: metav1.NewTime(now)}},
+			{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "c", CreationTimestamp: metav1.NewTime(now.Add(-time.Hour))}},
+			{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "a", CreationTimestamp: metav1.NewTime(now)}},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		params   listParams
+		expected []string
+	}{
+		{
+			name:     "not sorted",
+			expected: []string{"ns/b", "ns/c", "ns/a"},
+		},
+		{
+			name:     "by name",
+			params:   listParams{sortBy: pointer.To(Name)},
+			expected: []string{"ns/a", "ns/b", "ns/c"},
+		},
+		{
+			name:     "by name descending",
+			params:   listParams{sortOrder: pointer.To(Desc)},
+			expected: []string{"ns/c", "ns/b", "ns/a"},
+		},
+		{
+			name:     "by creation timestamp",
+			params:   listParams{sortBy: pointer.To(CreationTimestamp)},
+			expected: []string{"ns/c", "ns/a", "ns/b"},
+		},
+		{
+			name:     "by creation timestamp descending",
+			params:   listParams{sortBy: pointer.To(CreationTimestamp), sortOrder: pointer.To(Desc)},
+			expected: []string{"ns/b", "ns/a", "ns/c"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			objs := storages()
+			sortObjects(objs, tc.params)
+			assert.Equal(t, tc.expected, objectNames(objs))
+		})
+	}
+}
+
+func TestListFieldFilter(t *testing.T) {
+	t.Parallel()
+	engines := map[string]string{
+		"dev/db-1": "pxc",
+		"dev/db-2": "psmdb",
+		"dev/db-3": "pxc",
+	}
+	names := []string{"dev/db-1", "dev/db-2", "dev/db-3"}
+
+	testCases := []struct {
+		name       string
+		engineType *string
+		status     *string
+		expected   []string
+	}{
+		{
+			name:     "no filters",
+			expected: names,
+		},
+		{
+			name:       "engine type",
+			engineType: pointer.ToString("PXC"),
+			expected:   []string{"dev/db-1", "dev/db-3"},
+		},
+		{
+			name:       "engine type and status",
+			engineType: pointer.ToString("pxc"),
+			status:     pointer.ToString("ready"),
+			expected:   []string{"dev/db-3"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			filtered := filterK8sList(t, names, func(obj *unstructured.Unstructured) {
+				name := obj.GetNamespace() + "/" + obj.GetName()
+				require.NoError(t, unstructured.SetNestedField(obj.Object, engines[name], "spec", "engine", "type"))
+				if name == "dev/db-3" {
+					require.NoError(t, unstructured.SetNestedField(obj.Object, "ready", "status", "status"))
+				}
+			}, listFieldFilter(map[string]*string{
+				"spec.engine.type": tc.engineType,
+				"status.status":    tc.status,
+			}))
+			assert.Equal(t, tc.expected, filtered)
+		})
+	}
+}
+
+func TestTransformK8sListPage(t *testing.T) {
+	t.Parallel()
+	list := &unstructured.UnstructuredList{Object: map[string]interface{}{}}
+	list.SetAPIVersion("everest.percona.com/v1alpha1")
+	list.SetKind("List")
+	list.SetContinue("token")
+	list.SetRemainingItemCount(pointer.ToInt64(5))
+	in, err := json.Marshal(list)
+	require.NoError(t, err)
+
+	out, err := transformK8sList(func(*unstructured.UnstructuredList) error { return nil })(in)
+	require.NoError(t, err)
+
+	result := &unstructured.UnstructuredList{}
+	require.NoError(t, json.Unmarshal(out, result))
+	assert.Equal(t, "token", result.GetContinue())
+	assert.Nil(t, result.GetRemainingItemCount())
+}
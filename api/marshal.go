@@ -3,6 +3,8 @@ package api
 import (
 	"encoding/json"
 	"unicode"
+
+	"github.com/AlekSi/pointer"
 )
 
 // MarshalJSON capitalizes Error.Message and marshals it to byte array.
@@ -10,11 +12,9 @@ func (e Error) MarshalJSON() ([]byte, error) {
 	if e.Message != nil && *e.Message != "" {
 		r := []rune(*e.Message)
 		r[0] = unicode.ToUpper(r[0])
-		*e.Message = string(r)
+		e.Message = pointer.ToString(string(r))
 	}
-	return json.Marshal(&struct {
-		Message *string `json:"message,omitempty"`
-	}{
-		Message: e.Message,
-	})
+	// The alias has the fields of Error, but not this method.
+	type problem Error
+	return json.Marshal(problem(e))
 }
@@ -0,0 +1,63 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+
+	"github.com/percona/everest/pkg/metrics"
+)
+
+// apiOperations maps the method and echo route of every operation of the API to its operation ID.
+type apiOperations map[string]string
+
+func newAPIOperations(swagger *openapi3.T, basePath string) apiOperations {
+	operations := make(apiOperations)
+	for path, item := range swagger.Paths.Map() {
+		// Convert the path to the echo format, e.g. '/{namespace}/clusters' -> '/:namespace/clusters'
+		echoPath := strings.NewReplacer("{", ":", "}", "").Replace(path)
+		for method, op := range item.Operations() {
+			operations[method+" "+basePath+echoPath] = op.OperationID
+		}
+	}
+	return operations
+}
+
+// operationID returns the ID of the API operation the request was routed to.
+// Returns false if the request does not match an operation of the API, e.g. for static files.
+func (o apiOperations) operationID(c echo.Context) (string, bool) {
+	id, ok := o[c.Request().Method+" "+c.Path()]
+	return id, ok
+}
+
+// newMetricsMiddleware returns a middleware that records the requests handled for every operation of the API.
+// Requests that do not match an operation of the API are not recorded.
+func newMetricsMiddleware(operations apiOperations) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			err := next(c)
+			if operation, ok := operations.operationID(c); ok {
+				metrics.ObserveRequest(operation, c.Request().Method, responseStatus(c, err), time.Since(start))
+			}
+			return err
+		}
+	}
+}
@@ -20,10 +20,13 @@ import (
 	"slices"
 	"strings"
 
-	"github.com/AlekSi/pointer"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/metrics"
+	"github.com/percona/everest/pkg/rbac"
+	"github.com/percona/everest/pkg/session"
 )
 
 func (e *EverestServer) shouldAllowRequestDuringEngineUpgrade(c echo.Context) (bool, error) {
@@ -65,6 +68,7 @@ func (e *EverestServer) shouldAllowRequestDuringEngineUpgrade(c echo.Context) (b
 		_, found := annotations[everestv1alpha1.DatabaseOperatorUpgradeLockAnnotation]
 		return found
 	})
+	metrics.SetOperatorUpgradeLocked(namespace, locked)
 	return !locked, nil
 }
 
@@ -76,10 +80,36 @@ func (e *EverestServer) checkOperatorUpgradeState(next echo.HandlerFunc) echo.Ha
 			e.l.Error(err)
 			return err
 		} else if !allow {
-			return c.JSON(http.StatusPreconditionFailed, Error{
-				Message: pointer.ToString("Cannot perform this operation while the operator is upgrading"),
-			})
+			return writeError(c, http.StatusPreconditionFailed, "Cannot perform this operation while the operator is upgrading")
 		}
 		return next(c)
 	}
 }
+
+// setOIDCUser is a middleware that resolves the RBAC user and groups from the claims
+// of OIDC tokens, according to the configuration of the provider that issued the token.
+func (e *EverestServer) setOIDCUser(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		verifier := e.oidcVerifier.Load()
+		if verifier == nil {
+			return next(c)
+		}
+		token, ok := c.Get("user").(*jwt.Token) // by default token is stored under `user` key
+		if !ok {
+			return next(c)
+		}
+		claims, ok := token.Claims.(jwt.MapClaims)
+		if !ok {
+			return next(c)
+		}
+		if issuer, err := claims.GetIssuer(); err != nil || issuer == session.SessionManagerClaimsIssuer {
+			return next(c)
+		}
+		name, groups, err := verifier.User(claims)
+		if err != nil {
+			return err
+		}
+		rbac.SetUser(c, rbac.User{Name: name, Groups: groups})
+		return next(c)
+	}
+}
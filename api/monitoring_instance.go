@@ -42,36 +42,30 @@ const (
 func (e *EverestServer) CreateMonitoringInstance(ctx echo.Context, namespace string) error {
 	params, err := validateCreateMonitoringInstanceRequest(ctx)
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		return writeErrorFrom(ctx, http.StatusBadRequest, err)
 	}
 	c := ctx.Request().Context()
 	m, err := e.kubeClient.GetMonitoringConfig(c, namespace, params.Name)
 	if err != nil && !k8serrors.IsNotFound(err) {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Could not get monitoring instance"),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Could not get monitoring instance")
 	}
 	// TODO: Change the design of operator's structs so they return nil struct so
 	// if s != nil passes
 	if m != nil && m.Name != "" {
 		err = fmt.Errorf("monitoring instance %s already exists in namespace %s", params.Name, namespace)
 		e.l.Error(err)
-		return ctx.JSON(http.StatusConflict, Error{Message: pointer.ToString(err.Error())})
+		return writeErrorFrom(ctx, http.StatusConflict, err)
 	}
 
 	apiKey, err := e.getPMMApiKey(c, params)
 	if err != nil {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Could not create an API key in PMM"),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Could not create an API key in PMM")
 	}
 
 	if err := e.createMonitoringK8sResources(c, namespace, params, apiKey); err != nil {
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString(err.Error()),
-		})
+		return writeErrorFrom(ctx, http.StatusInternalServerError, err)
 	}
 
 	result := MonitoringInstance{
@@ -149,7 +143,7 @@ func (e *EverestServer) createMonitoringK8sResources(
 }
 
 // enforceMonitoringConfigRBAC checks if the user has permissions to read the monitoring config.
-func (e *EverestServer) enforceMonitoringConfigRBAC(user string, mc everestv1alpha1.MonitoringConfig) error {
+func (e *EverestServer) enforceMonitoringConfigRBAC(user rbac.User, mc everestv1alpha1.MonitoringConfig) error {
 	// Check if the user has permissions for this monitoring config.
 	if err := e.enforce(user, rbac.ResourceMonitoringInstances, rbac.ActionRead, rbac.ObjectName(mc.GetNamespace(), mc.GetName())); err != nil {
 		if !errors.Is(err, errInsufficientPermissions) {
@@ -162,28 +156,40 @@ func (e *EverestServer) enforceMonitoringConfigRBAC(user string, mc everestv1alp
 }
 
 // ListMonitoringInstances lists all monitoring instances.
-func (e *EverestServer) ListMonitoringInstances(ctx echo.Context, namespace string) error {
+func (e *EverestServer) ListMonitoringInstances(ctx echo.Context, namespace string, params ListMonitoringInstancesParams) error {
 	user, err := rbac.GetUser(ctx)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed to get user from context" + err.Error()),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed to get user from context"+err.Error())
 	}
 
-	mcList, err := e.kubeClient.ListMonitoringConfigs(ctx.Request().Context(), namespace)
+	list := listParams{
+		limit:         params.Limit,
+		continueToken: params.Continue,
+		labelSelector: params.LabelSelector,
+		sortBy:        params.SortBy,
+		sortOrder:     params.SortOrder,
+	}
+	opts, err := list.listOptions()
+	if err != nil {
+		return writeErrorFrom(ctx, http.StatusBadRequest, err)
+	}
+	mcList, err := e.kubeClient.ListMonitoringConfigs(ctx.Request().Context(), namespace, opts)
 	if err != nil {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get a list of monitoring instances")})
+		return writeError(ctx, http.StatusInternalServerError, "Could not get a list of monitoring instances")
 	}
 
-	result := make([]*MonitoringInstance, 0, len(mcList.Items))
-	for _, mc := range mcList.Items {
-		if err := e.enforceMonitoringConfigRBAC(user, mc); errors.Is(err, errInsufficientPermissions) {
-			continue
-		} else if err != nil {
-			return err
-		}
+	configs, err := filterAllowed(mcList.Items, func(mc everestv1alpha1.MonitoringConfig) error {
+		return e.enforceMonitoringConfigRBAC(user, mc)
+	})
+	if err != nil {
+		return err
+	}
+	sortObjects(configs, list)
+	setContinueToken(ctx, mcList.ListMeta)
 
+	result := make([]*MonitoringInstance, 0, len(configs))
+	for _, mc := range configs {
 		result = append(result, &MonitoringInstance{
 			Type:      MonitoringInstanceBaseWithNameType(mc.Spec.Type),
 			Name:      mc.GetName(),
@@ -202,12 +208,10 @@ func (e *EverestServer) GetMonitoringInstance(ctx echo.Context, namespace, name
 	m, err := e.kubeClient.GetMonitoringConfig(ctx.Request().Context(), namespace, name)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
-			return ctx.JSON(http.StatusNotFound, Error{
-				Message: pointer.ToString("Monitoring instance is not found"),
-			})
+			return writeError(ctx, http.StatusNotFound, "Monitoring instance is not found")
 		}
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get a list of monitoring instances")})
+		return writeError(ctx, http.StatusInternalServerError, "Could not get a list of monitoring instances")
 	}
 
 	return ctx.JSON(http.StatusOK, &MonitoringInstance{
@@ -226,19 +230,15 @@ func (e *EverestServer) UpdateMonitoringInstance(ctx echo.Context, namespace, na
 	m, err := e.kubeClient.GetMonitoringConfig(c, namespace, name)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
-			return ctx.JSON(http.StatusNotFound, Error{
-				Message: pointer.ToString("Monitoring instance is not found"),
-			})
+			return writeError(ctx, http.StatusNotFound, "Monitoring instance is not found")
 		}
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed getting monitoring instance"),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed getting monitoring instance")
 	}
 
 	params, err := e.validateUpdateMonitoringInstanceRequest(ctx)
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		return writeErrorFrom(ctx, http.StatusBadRequest, err)
 	}
 
 	var apiKey string
@@ -254,9 +254,7 @@ func (e *EverestServer) UpdateMonitoringInstance(ctx echo.Context, namespace, na
 		)
 		if err != nil {
 			e.l.Error(err)
-			return ctx.JSON(http.StatusInternalServerError, Error{
-				Message: pointer.ToString("Could not create an API key in PMM"),
-			})
+			return writeError(ctx, http.StatusInternalServerError, "Could not create an API key in PMM")
 		}
 	}
 	if apiKey != "" {
@@ -270,9 +268,7 @@ func (e *EverestServer) UpdateMonitoringInstance(ctx echo.Context, namespace, na
 		})
 		if err != nil {
 			e.l.Error(err)
-			return ctx.JSON(http.StatusInternalServerError, Error{
-				Message: pointer.ToString(fmt.Sprintf("Could not update k8s secret %s", name)),
-			})
+			return writeError(ctx, http.StatusInternalServerError, fmt.Sprintf("Could not update k8s secret %s", name))
 		}
 	}
 	if params.Url != "" {
@@ -287,9 +283,7 @@ func (e *EverestServer) UpdateMonitoringInstance(ctx echo.Context, namespace, na
 	err = e.kubeClient.UpdateMonitoringConfig(c, m)
 	if err != nil {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed updating monitoring instance"),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed updating monitoring instance")
 	}
 
 	return ctx.JSON(http.StatusOK, &MonitoringInstance{
@@ -307,39 +301,27 @@ func (e *EverestServer) DeleteMonitoringInstance(ctx echo.Context, namespace, na
 	used, err := e.kubeClient.IsMonitoringConfigUsed(ctx.Request().Context(), namespace, name)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
-			return ctx.JSON(http.StatusNotFound, Error{
-				Message: pointer.ToString("Monitoring instance is not found"),
-			})
+			return writeError(ctx, http.StatusNotFound, "Monitoring instance is not found")
 		}
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed to check the monitoring instance is used"),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed to check the monitoring instance is used")
 	}
 	if used {
-		return ctx.JSON(http.StatusBadRequest, Error{
-			Message: pointer.ToString(fmt.Sprintf("Monitoring instance %s is used", name)),
-		})
+		return writeError(ctx, http.StatusBadRequest, fmt.Sprintf("Monitoring instance %s is used", name))
 	}
 	if err := e.kubeClient.DeleteMonitoringConfig(ctx.Request().Context(), namespace, name); err != nil {
 		if k8serrors.IsNotFound(err) {
-			return ctx.JSON(http.StatusNotFound, Error{
-				Message: pointer.ToString("Monitoring instance is not found"),
-			})
+			return writeError(ctx, http.StatusNotFound, "Monitoring instance is not found")
 		}
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed to get monitoring instance"),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed to get monitoring instance")
 	}
 	if err := e.kubeClient.DeleteSecret(ctx.Request().Context(), namespace, name); err != nil {
 		if k8serrors.IsNotFound(err) {
 			return ctx.NoContent(http.StatusNoContent)
 		}
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed deleting monitoring instance"),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed deleting monitoring instance")
 	}
 	return ctx.NoContent(http.StatusNoContent)
 }
@@ -1,10 +1,8 @@
 package api
 
 import (
-	"errors"
 	"net/http"
 
-	"github.com/AlekSi/pointer"
 	"github.com/labstack/echo/v4"
 
 	"github.com/percona/everest/pkg/rbac"
@@ -15,27 +13,18 @@ func (e *EverestServer) ListNamespaces(ctx echo.Context) error {
 	namespaces, err := e.kubeClient.GetDBNamespaces(ctx.Request().Context())
 	if err != nil {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed to list namespaces"),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed to list namespaces")
 	}
 	user, err := rbac.GetUser(ctx)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Failed to get user from context" + err.Error()),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed to get user from context"+err.Error())
 	}
 	// Filter out result based on permission.
-	result := make([]string, 0, len(namespaces))
-	for _, ns := range namespaces {
-		if err := e.enforce(user, rbac.ResourceNamespaces, rbac.ActionRead, ns); errors.Is(err, errInsufficientPermissions) {
-			continue
-		} else if err != nil {
-			return ctx.JSON(http.StatusInternalServerError, Error{
-				Message: pointer.ToString("Failed to check namespace permission"),
-			})
-		}
-		result = append(result, ns)
+	result, err := filterAllowed(namespaces, func(ns string) error {
+		return e.enforce(user, rbac.ResourceNamespaces, rbac.ActionRead, ns)
+	})
+	if err != nil {
+		return writeError(ctx, http.StatusInternalServerError, "Failed to check namespace permission")
 	}
 	return ctx.JSON(http.StatusOK, result)
 }
@@ -0,0 +1,118 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes/informer"
+	"github.com/percona/everest/pkg/oidc"
+)
+
+// newOIDCVerifier returns a verifier for the OIDC providers in the given settings.
+// Returns nil if OIDC is not configured.
+func newOIDCVerifier(ctx context.Context, settings common.EverestSettings) (*oidc.Verifier, error) {
+	if settings.OIDCConfigRaw == "" {
+		return nil, nil //nolint:nilnil
+	}
+	oidcConfig, err := settings.OIDCConfig()
+	if err != nil {
+		return nil, errors.Join(err, errors.New("cannot parse OIDC raw config"))
+	}
+	return oidc.NewVerifier(ctx, oidcConfig)
+}
+
+// loadOIDCVerifier reads the Everest settings and replaces the OIDC verifier accordingly.
+func (e *EverestServer) loadOIDCVerifier(ctx context.Context) error {
+	settings, err := e.kubeClient.GetEverestSettings(ctx)
+	if err = client.IgnoreNotFound(err); err != nil {
+		return err
+	}
+	return e.setOIDCVerifier(ctx, settings)
+}
+
+// setOIDCVerifier atomically replaces the OIDC verifier with one created from the given settings.
+// The previous verifier is kept if the settings are invalid or have not changed.
+func (e *EverestServer) setOIDCVerifier(ctx context.Context, settings common.EverestSettings) error {
+	if e.cancelOIDCVerifier != nil && settings.OIDCConfigRaw == e.oidcConfigRaw {
+		return nil
+	}
+	// The verifier caches the keys of the providers in the background until its context is cancelled.
+	verifierCtx, cancel := context.WithCancel(ctx)
+	verifier, err := newOIDCVerifier(verifierCtx, settings)
+	if err != nil {
+		cancel()
+		return err
+	}
+	e.oidcVerifier.Store(verifier)
+	if e.cancelOIDCVerifier != nil {
+		e.cancelOIDCVerifier()
+	}
+	e.cancelOIDCVerifier = cancel
+	e.oidcConfigRaw = settings.OIDCConfigRaw
+	return nil
+}
+
+// Setup a new informer that watches the Everest settings ConfigMap.
+// This informer reloads the OIDC settings whenever the ConfigMap is updated,
+// so that OIDC can be enabled, changed or disabled without restarting the server.
+func (e *EverestServer) refreshOIDCVerifierInBackground(ctx context.Context) error {
+	inf, err := informer.New(
+		informer.WithConfig(e.kubeClient.Config()),
+		informer.WithLogger(e.l),
+		informer.WithName("everest-settings"),
+		informer.Watches(&corev1.ConfigMap{}, common.SystemNamespace),
+	)
+	if err != nil {
+		return errors.Join(err, errors.New("failed to create Everest settings informer"))
+	}
+	onChange := func(obj interface{}) {
+		cm, ok := obj.(*corev1.ConfigMap)
+		if !ok || cm.GetName() != common.EverestSettingsConfigMapName {
+			return
+		}
+		settings := common.EverestSettings{}
+		if err := settings.FromMap(cm.Data); err != nil {
+			e.l.Error(errors.Join(err, errors.New("failed to parse Everest settings")))
+			return
+		}
+		if err := e.setOIDCVerifier(ctx, settings); err != nil {
+			e.l.Error(errors.Join(err, errors.New("failed to reload OIDC settings")))
+		}
+	}
+	inf.OnAdd(onChange)
+	inf.OnUpdate(func(_, newObj interface{}) {
+		onChange(newObj)
+	})
+	inf.OnDelete(func(obj interface{}) {
+		cm, ok := obj.(*corev1.ConfigMap)
+		if !ok || cm.GetName() != common.EverestSettingsConfigMapName {
+			return
+		}
+		if err := e.setOIDCVerifier(ctx, common.EverestSettings{}); err != nil {
+			e.l.Error(errors.Join(err, errors.New("failed to disable OIDC")))
+		}
+	})
+	if err := inf.Start(ctx, &corev1.ConfigMap{}); err != nil {
+		return errors.Join(err, errors.New("failed to watch Everest settings ConfigMap"))
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/everest/pkg/common"
+)
+
+func TestSetOIDCVerifier(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	e := &EverestServer{}
+
+	// OIDC is not configured.
+	require.NoError(t, e.setOIDCVerifier(ctx, common.EverestSettings{}))
+	assert.Nil(t, e.oidcVerifier.Load())
+	assert.NotNil(t, e.cancelOIDCVerifier)
+
+	// Invalid settings keep the current verifier.
+	err := e.setOIDCVerifier(ctx, common.EverestSettings{OIDCConfigRaw: "issuerUrl: [invalid"})
+	require.Error(t, err)
+	assert.Nil(t, e.oidcVerifier.Load())
+	assert.Empty(t, e.oidcConfigRaw)
+}
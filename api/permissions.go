@@ -22,15 +22,22 @@ func (e *EverestServer) GetUserPermissions(c echo.Context) error {
 		return err
 	}
 
-	permissions, err := e.rbacEnforcer.GetImplicitPermissionsForUser(user)
-	if err != nil {
-		e.l.Error("Failed to get implicit permissions: ", zap.Error(err))
-		return err
-	}
-
-	if err := e.resolveRoles(user, permissions); err != nil {
-		e.l.Error(err)
-		return err
+	permissions := [][]string{}
+	for _, subject := range user.Subjects() {
+		perms, err := e.rbacEnforcer.GetImplicitPermissionsForUser(subject)
+		if err != nil {
+			e.l.Error("Failed to get implicit permissions: ", zap.Error(err))
+			return err
+		}
+		if err := e.resolveRoles(subject, perms); err != nil {
+			e.l.Error(err)
+			return err
+		}
+		// Permissions granted to the groups of the user are reported as the user's own.
+		for i := range perms {
+			perms[i][0] = user.Name
+		}
+		permissions = append(permissions, perms...)
 	}
 	result := pointer.To(permissions)
 
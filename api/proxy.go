@@ -45,13 +45,6 @@ var (
 		"databaseclusterrestores.everest.percona.com": "Restore",
 		"databaseclusterbackups.everest.percona.com":  "Backup",
 	}
-	//nolint:gochecknoglobals
-	rewriteCodes = map[int]bool{
-		http.StatusBadRequest:          true,
-		http.StatusNotFound:            true,
-		http.StatusUnprocessableEntity: true,
-		http.StatusConflict:            true,
-	}
 )
 
 type apiResponseTransformerFn func(in []byte) ([]byte, error)
@@ -72,16 +65,18 @@ func (e *EverestServer) proxyKubernetes(
 	transport, err := rest.TransportFor(config)
 	if err != nil {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusBadRequest, Error{
-			Message: pointer.ToString("Could not create REST transport"),
-		})
+		return writeError(ctx, http.StatusBadRequest, "Could not create REST transport")
 	}
 	reverseProxy.Transport = transport
 	reverseProxy.ErrorHandler = everestErrorHandler(e.l)
 	modifiers := make([]func(*http.Response) error, 0, len(respTransformers)+1)
-	modifiers = append(modifiers, everestResponseModifier(e.l)) //nolint:bodyclose
+	modifiers = append(modifiers, everestResponseModifier(e.l, ctx.Request().URL.Path)) //nolint:bodyclose
 	for _, fn := range respTransformers {
 		modifiers = append(modifiers, func(r *http.Response) error { //nolint:bodyclose
+			// The error responses are already rewritten as problems.
+			if r.StatusCode >= http.StatusBadRequest {
+				return nil
+			}
 			return runResponseModifier(r, e.l, fn)
 		})
 	}
@@ -121,6 +116,10 @@ func buildProxiedURL(namespace, kind, name string) string {
 // This list is fetched by the proxy.
 // API callers may call this function to modify the list after it is fetched by the proxy and before returning to the client.
 // For example, this can filter out the contents of a list based on the user's permissions.
+//
+// The continue token of a paginated list is kept, so the next pages are still mutated the same way.
+// The remaining item count is removed, since it is counted by Kubernetes before any filtering
+// and would disclose the number of the objects the user is not allowed to access.
 func transformK8sList(mutate func(l *unstructured.UnstructuredList) error) apiResponseTransformerFn {
 	return func(in []byte) ([]byte, error) {
 		list := &unstructured.UnstructuredList{}
@@ -130,6 +129,7 @@ func transformK8sList(mutate func(l *unstructured.UnstructuredList) error) apiRe
 		if err := mutate(list); err != nil {
 			return nil, err
 		}
+		list.SetRemainingItemCount(nil)
 		out, err := json.Marshal(list)
 		if err != nil {
 			return nil, err
@@ -163,62 +163,64 @@ func runResponseModifier(resp *http.Response, logger *zap.SugaredLogger, transfo
 	return nil
 }
 
-func everestResponseModifier(logger *zap.SugaredLogger) func(resp *http.Response) error {
+// everestResponseModifier rewrites the Kubernetes status of the error responses as a problem.
+func everestResponseModifier(logger *zap.SugaredLogger, instance string) func(resp *http.Response) error {
 	return func(resp *http.Response) error {
-		if _, ok := rewriteCodes[resp.StatusCode]; ok {
-			b, err := io.ReadAll(resp.Body)
-			if err != nil {
-				logger.Error(errors.Join(err, errors.New("failed reading body")))
-				return err
-			}
-			err = resp.Body.Close()
-			if err != nil {
-				logger.Error(errors.Join(err, errors.New("failed closing body")))
-				return err
-			}
-			b, err = tryOverrideResponseBody(b)
-			if err != nil {
+		if resp.StatusCode < http.StatusBadRequest {
+			return nil
+		}
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			logger.Error(errors.Join(err, errors.New("failed reading body")))
+			return err
+		}
+		err = resp.Body.Close()
+		if err != nil {
+			logger.Error(errors.Join(err, errors.New("failed closing body")))
+			return err
+		}
+		if problem, ok := problemFromStatusBody(resp.StatusCode, b); ok {
+			problem.Instance = pointer.ToString(instance)
+			if b, err = json.Marshal(problem); err != nil {
 				logger.Error(errors.Join(err, errors.New("failed overriding response body")))
 				return err
 			}
-
-			body := io.NopCloser(bytes.NewReader(b))
-			resp.Body = body
-			resp.ContentLength = int64(len(b))
-			resp.Header.Set("Content-Length", strconv.Itoa(len(b)))
+			resp.Header.Set(echo.HeaderContentType, problemContentType)
 		}
+
+		body := io.NopCloser(bytes.NewReader(b))
+		resp.Body = body
+		resp.ContentLength = int64(len(b))
+		resp.Header.Set("Content-Length", strconv.Itoa(len(b)))
 		return nil
 	}
 }
 
-func tryOverrideResponseBody(b []byte) ([]byte, error) {
+// problemFromStatusBody returns the problem for the Kubernetes status in the body of an error response.
+// The Kubernetes resource names in the message are replaced with their Everest names.
+// Returns false if the body is not a Kubernetes status.
+func problemFromStatusBody(code int, b []byte) (Error, bool) {
 	status := metav1.Status{}
-	err := json.Unmarshal(b, &status)
-	if err != nil {
-		return b, err
+	if err := json.Unmarshal(b, &status); err != nil || status.Kind != "Status" {
+		return Error{}, false
 	}
 	parts := strings.Split(status.Message, " ")
-	if len(parts) == 0 {
-		// Do not override it and return the original response
-		return b, nil
-	}
-	var ok bool
-	parts[0], ok = everestCRDErrorMessageMap[parts[0]]
-	if !ok {
-		// Do not override it and return the original response
-		return b, nil
+	if name, ok := everestCRDErrorMessageMap[parts[0]]; ok {
+		parts[0] = name
+		status.Message = strings.Join(parts, " ")
 	}
-	status.Message = strings.Join(parts, " ")
-	b, err = json.Marshal(status)
-	return b, err
+	problem := newProblem(code, codeForStatus(code), status.Message)
+	setKubernetesStatus(&problem, status)
+	return problem, true
 }
 
 func everestErrorHandler(logger *zap.SugaredLogger) func(http.ResponseWriter, *http.Request, error) {
-	b, err := json.Marshal(Error{Message: pointer.ToString("Kubernetes cluster is unavailable")})
+	b, err := json.Marshal(newProblem(http.StatusInternalServerError, Unavailable, "Kubernetes cluster is unavailable"))
 	if err != nil {
 		logger.Error(err.Error())
 	}
 	return func(res http.ResponseWriter, _ *http.Request, _ error) {
+		res.Header().Set(echo.HeaderContentType, problemContentType)
 		res.WriteHeader(http.StatusInternalServerError)
 		if _, err := res.Write(b); err != nil {
 			logger.Error(err.Error())
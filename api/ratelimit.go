@@ -0,0 +1,220 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+
+	"github.com/labstack/echo/v4"
+	echomiddleware "github.com/labstack/echo/v4/middleware"
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/cmd/config"
+	"github.com/percona/everest/pkg/accounts"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/metrics"
+	"github.com/percona/everest/pkg/ratelimit"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+const (
+	sessionRateLimiterName = "session"
+	loginThrottleName      = "login"
+)
+
+// newRateLimiter returns the rate limiters of the API requests and the throttle of the failed logins,
+// which use the store selected in the configuration.
+// The first rate limiter limits the requests by client address and applies before authentication.
+// The second one limits the requests by user and applies after authentication. It is nil unless
+// the requests are limited by user.
+func newRateLimiter(
+	ctx context.Context,
+	c *config.EverestConfig,
+	kubeClient *kubernetes.Kubernetes,
+	basePath string,
+	l *zap.SugaredLogger,
+) (*ratelimit.Limiter, *ratelimit.Limiter, ratelimit.Throttle, error) { //nolint:ireturn
+	var store ratelimit.Store
+	var throttle ratelimit.Throttle
+	switch c.RateLimit.Store {
+	case ratelimit.StoreMemory:
+		store = ratelimit.NewMemoryStore()
+		throttle = ratelimit.NewMemoryThrottle()
+	case ratelimit.StorePerReplica:
+		identity, err := os.Hostname()
+		if err != nil {
+			return nil, nil, nil, errors.Join(err, errors.New("failed to get the replica identity"))
+		}
+		leaseStore := ratelimit.NewLeaseStore(kubeClient.Leases(common.SystemNamespace), identity, l)
+		if err := leaseStore.Start(ctx); err != nil {
+			return nil, nil, nil, err
+		}
+		store = leaseStore
+		throttle = ratelimit.NewConfigMapThrottle(kubeClient, common.SystemNamespace, common.EverestLoginThrottleConfigMapName)
+	default:
+		return nil, nil, nil, errors.New("unknown rate limit store " + c.RateLimit.Store)
+	}
+
+	// The quotas of the session routes apply unless they are overridden in the configuration.
+	sessionQuota := ratelimit.Quota{Rate: float64(c.CreateSessionRateLimit)}
+	sessionRoutes := make([]ratelimit.RouteQuota, 0, len(loginPaths))
+	for _, path := range loginPaths {
+		sessionRoutes = append(sessionRoutes, ratelimit.RouteQuota{
+			Name:   sessionRateLimiterName,
+			Method: echo.POST,
+			Path:   basePath + path,
+			Quota:  sessionQuota,
+		})
+	}
+	apiQuota := ratelimit.Quota{Rate: float64(c.APIRequestsRateLimit)}
+	if c.RateLimit.KeyBy != ratelimit.KeyByUser {
+		return ratelimit.NewLimiter(store, apiQuota, l,
+			ratelimit.WithRoutes(c.RateLimit.Routes...),
+			ratelimit.WithRoutes(sessionRoutes...),
+		), nil, throttle, nil
+	}
+
+	// The requests with a missing or invalid token are not attributed to a user,
+	// so all the requests are limited by client address before authentication as well.
+	ipQuota := apiQuota
+	if c.RateLimit.IPRate > 0 {
+		ipQuota = ratelimit.Quota{Rate: c.RateLimit.IPRate}
+	}
+	return ratelimit.NewLimiter(store, ipQuota, l),
+		ratelimit.NewLimiter(store, apiQuota, l,
+			ratelimit.WithKeyFunc(userRateLimitKey),
+			ratelimit.WithRoutes(c.RateLimit.Routes...),
+			ratelimit.WithRoutes(sessionRoutes...),
+		), throttle, nil
+}
+
+// loginPaths are the paths, relative to the base path, of the requests that authenticate with credentials.
+//
+//nolint:gochecknoglobals
+var loginPaths = []string{"/session", "/session/refresh", "/session/password"}
+
+// userRateLimitKey returns the key of the requests limited by user.
+// The requests made without authentication are limited by the client address.
+func userRateLimitKey(c echo.Context) string {
+	if user, err := rbac.GetUsername(c); err == nil && user != "" {
+		return "user:" + user
+	}
+	return "ip:" + c.RealIP()
+}
+
+// loginThrottleMiddleware rejects the login requests from the clients that have to wait after failed logins.
+func (e *EverestServer) loginThrottleMiddleware(basePath string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !isLoginRequest(c, basePath) {
+				return next(c)
+			}
+			username, err := loginUsername(c)
+			if err != nil {
+				return err
+			}
+			// The failed logins for unknown accounts are recorded for the client only,
+			// so the client has to be allowed as well as the client for the account.
+			keys := []string{loginThrottleKey(c, "")}
+			if username != "" {
+				keys = append(keys, loginThrottleKey(c, username))
+			}
+			for _, key := range keys {
+				ok, err := e.loginThrottle.Allow(c.Request().Context(), key)
+				if err != nil {
+					// Do not lock the users out if the throttle is unavailable.
+					e.l.Error(errors.Join(err, errors.New("failed to check login throttle")))
+					return next(c)
+				}
+				if !ok {
+					metrics.IncRateLimited(loginThrottleName)
+					return echomiddleware.ErrRateLimitExceeded
+				}
+			}
+			return next(c)
+		}
+	}
+}
+
+func isLoginRequest(c echo.Context, basePath string) bool {
+	if c.Request().Method != echo.POST {
+		return false
+	}
+	for _, path := range loginPaths {
+		if c.Request().URL.Path == basePath+path {
+			return true
+		}
+	}
+	return false
+}
+
+// loginUsername returns the username the credentials of a login request are sent for.
+// Returns an empty string for the login requests that are not made with a username,
+// e.g. for refreshing a session. The body of the request is left intact for the handler.
+func loginUsername(c echo.Context) (string, error) {
+	req := c.Request()
+	if req.Body == nil {
+		return "", nil
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return "", err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	var credentials struct {
+		Username string `json:"username"`
+	}
+	// Malformed requests are rejected by the handler.
+	_ = json.Unmarshal(body, &credentials)
+	return credentials.Username, nil
+}
+
+// loginThrottleKey returns the key the failed logins of the client for the given username are recorded by.
+// The failed logins are recorded for every account separately, so that a successful login only forgets
+// the failures of the same account.
+func loginThrottleKey(c echo.Context, username string) string {
+	if username == "" {
+		return c.RealIP()
+	}
+	return c.RealIP() + "|" + username
+}
+
+// loginFailed records a failed login from the client for the given username,
+// which has to wait longer before its next attempt. Only the wrong passwords of existing
+// accounts are recorded for the username, the other failures, e.g. for accounts that do
+// not exist, are recorded for the client only, so that every guessed username does not
+// add a key to the throttle.
+func (e *EverestServer) loginFailed(c echo.Context, username string, loginErr error) {
+	if !errors.Is(loginErr, accounts.ErrIncorrectPassword) {
+		username = ""
+	}
+	if err := e.loginThrottle.Fail(c.Request().Context(), loginThrottleKey(c, username)); err != nil {
+		e.l.Error(errors.Join(err, errors.New("failed to record failed login")))
+	}
+}
+
+// loginSucceeded forgets the failed logins from the client for the given username.
+func (e *EverestServer) loginSucceeded(c echo.Context, username string) {
+	if err := e.loginThrottle.Reset(c.Request().Context(), loginThrottleKey(c, username)); err != nil {
+		e.l.Error(errors.Join(err, errors.New("failed to reset failed logins")))
+	}
+}
@@ -0,0 +1,92 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	echomiddleware "github.com/labstack/echo/v4/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/pkg/accounts"
+	"github.com/percona/everest/pkg/ratelimit"
+)
+
+func TestLoginThrottle(t *testing.T) {
+	t.Parallel()
+	e := &EverestServer{
+		l:             zap.NewNop().Sugar(),
+		loginThrottle: ratelimit.NewMemoryThrottle(),
+	}
+	var handlerBody string
+	handler := e.loginThrottleMiddleware("/v1")(func(c echo.Context) error {
+		// The body is still readable by the handler.
+		b, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return err
+		}
+		handlerBody = string(b)
+		return c.NoContent(http.StatusOK)
+	})
+	login := func(username string) echo.Context {
+		body := `{"username":"` + username + `","password":"password"}`
+		req := httptest.NewRequest(http.MethodPost, "/v1/session", strings.NewReader(body))
+		req.RemoteAddr = "192.0.2.1:1234"
+		return echo.New().NewContext(req, httptest.NewRecorder())
+	}
+
+	for range 5 {
+		e.loginFailed(login("alice"), "alice", accounts.ErrIncorrectPassword)
+	}
+	// A successful login to another account does not forget the failures of alice.
+	e.loginSucceeded(login("mallory"), "mallory")
+	require.ErrorIs(t, handler(login("alice")), echomiddleware.ErrRateLimitExceeded)
+	require.NoError(t, handler(login("mallory")))
+	assert.JSONEq(t, `{"username":"mallory","password":"password"}`, handlerBody)
+}
+
+func TestLoginThrottleUnknownAccounts(t *testing.T) {
+	t.Parallel()
+	e := &EverestServer{
+		l:             zap.NewNop().Sugar(),
+		loginThrottle: ratelimit.NewMemoryThrottle(),
+	}
+	handler := e.loginThrottleMiddleware("/v1")(func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+	login := func(username string) echo.Context {
+		body := `{"username":"` + username + `","password":"password"}`
+		req := httptest.NewRequest(http.MethodPost, "/v1/session", strings.NewReader(body))
+		req.RemoteAddr = "192.0.2.1:1234"
+		return echo.New().NewContext(req, httptest.NewRecorder())
+	}
+
+	// The failures for guessed usernames are recorded for the client,
+	// so the client cannot try another username instead.
+	for i := range 5 {
+		username := "guess-" + strconv.Itoa(i)
+		e.loginFailed(login(username), username, accounts.ErrAccountNotFound)
+	}
+	require.ErrorIs(t, handler(login("guess-5")), echomiddleware.ErrRateLimitExceeded)
+}
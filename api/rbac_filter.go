@@ -0,0 +1,62 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// filterAllowed returns the items that the user is allowed to access.
+// enforce is called for each item and must return errInsufficientPermissions
+// if the user is not allowed to access it. Any other error aborts the filtering.
+//
+// List endpoints are not restricted by the RBAC middleware, so every list
+// handler must filter its result using this function or rbacListFilter.
+func filterAllowed[T any](items []T, enforce func(item T) error) ([]T, error) {
+	allowed := make([]T, 0, len(items))
+	for _, item := range items {
+		if err := enforce(item); errors.Is(err, errInsufficientPermissions) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		allowed = append(allowed, item)
+	}
+	return allowed, nil
+}
+
+// rbacListFilter returns a transformer for a list proxied from Kubernetes that removes the objects
+// that the user is not allowed to access. Each object is converted to T before it is passed to enforce.
+func rbacListFilter[T any](enforce func(obj *T) error) apiResponseTransformerFn {
+	return transformK8sList(func(l *unstructured.UnstructuredList) error {
+		items, err := filterAllowed(l.Items, func(item unstructured.Unstructured) error {
+			obj := new(T)
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, obj); err != nil {
+				return errors.Join(err, fmt.Errorf("failed to convert unstructured to %T", obj))
+			}
+			return enforce(obj)
+		})
+		if err != nil {
+			return err
+		}
+		l.Items = items
+		return nil
+	})
+}
@@ -0,0 +1,184 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+func TestRBACListFilter(t *testing.T) {
+	t.Parallel()
+	enforcer, err := rbac.NewEnforcerFromFilePath("./testdata/rbac-list-policy.csv")
+	require.NoError(t, err)
+	e := &EverestServer{
+		l:            zap.NewNop().Sugar(),
+		rbacEnforcer: enforcer,
+	}
+
+	namespaces := []string{"dev", "prod"}
+	dbClusters := []string{"dev/db-1", "dev/secret-db", "prod/alice-db", "prod/db-2"}
+	backupStorages := []string{"dev/s3", "prod/s3"}
+	monitoringConfigs := []string{"dev/pmm-1", "dev/other", "prod/pmm-1"}
+
+	testcases := []struct {
+		description       string
+		user              rbac.User
+		namespaces        []string
+		dbClusters        []string
+		backupStorages    []string
+		monitoringConfigs []string
+	}{
+		{
+			description:       "user with a role",
+			user:              rbac.User{Name: "bob"},
+			namespaces:        []string{"dev"},
+			dbClusters:        []string{"dev/db-1"},
+			backupStorages:    []string{"dev/s3"},
+			monitoringConfigs: []string{"dev/pmm-1"},
+		},
+		{
+			description:       "user with a role through a group",
+			user:              rbac.User{Name: "carol", Groups: []string{"dev-group"}},
+			namespaces:        []string{"dev"},
+			dbClusters:        []string{"dev/db-1"},
+			backupStorages:    []string{"dev/s3"},
+			monitoringConfigs: []string{"dev/pmm-1"},
+		},
+		{
+			description:       "user with direct policies",
+			user:              rbac.User{Name: "alice"},
+			namespaces:        []string{"prod"},
+			dbClusters:        []string{"prod/alice-db"},
+			backupStorages:    []string{},
+			monitoringConfigs: []string{},
+		},
+		{
+			description:       "user without permissions",
+			user:              rbac.User{Name: "eve"},
+			namespaces:        []string{},
+			dbClusters:        []string{},
+			backupStorages:    []string{},
+			monitoringConfigs: []string{},
+		},
+		{
+			description:       "admin",
+			user:              rbac.User{Name: "admin", Groups: []string{"role:admin"}},
+			namespaces:        namespaces,
+			dbClusters:        dbClusters,
+			backupStorages:    backupStorages,
+			monitoringConfigs: monitoringConfigs,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+
+			allowedNamespaces, err := filterAllowed(namespaces, func(ns string) error {
+				return e.enforce(tc.user, rbac.ResourceNamespaces, rbac.ActionRead, ns)
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.namespaces, allowedNamespaces)
+
+			allowedDBClusters := filterK8sList(t, dbClusters, func(obj *unstructured.Unstructured) {
+				require.NoError(t, unstructured.SetNestedField(obj.Object, string(everestv1alpha1.DatabaseEnginePXC), "spec", "engine", "type"))
+			}, rbacListFilter(func(db *everestv1alpha1.DatabaseCluster) error {
+				return e.enforceDBClusterRBAC(tc.user, db)
+			}))
+			assert.Equal(t, tc.dbClusters, allowedDBClusters)
+
+			storages := make([]everestv1alpha1.BackupStorage, 0, len(backupStorages))
+			for _, name := range backupStorages {
+				storages = append(storages, everestv1alpha1.BackupStorage{ObjectMeta: objectMeta(name)})
+			}
+			allowedStorages, err := filterAllowed(storages, func(s everestv1alpha1.BackupStorage) error {
+				return e.enforceBackupStorageRBAC(tc.user, s)
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.backupStorages, objectNames(allowedStorages))
+
+			configs := make([]everestv1alpha1.MonitoringConfig, 0, len(monitoringConfigs))
+			for _, name := range monitoringConfigs {
+				configs = append(configs, everestv1alpha1.MonitoringConfig{ObjectMeta: objectMeta(name)})
+			}
+			allowedConfigs, err := filterAllowed(configs, func(mc everestv1alpha1.MonitoringConfig) error {
+				return e.enforceMonitoringConfigRBAC(tc.user, mc)
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.monitoringConfigs, objectNames(allowedConfigs))
+		})
+	}
+}
+
+// objectMeta returns the metadata of an object with a name of the form `namespace/name`.
+func objectMeta(name string) metav1.ObjectMeta {
+	var meta metav1.ObjectMeta
+	meta.Namespace, meta.Name, _ = strings.Cut(name, "/")
+	return meta
+}
+
+// objectNames returns the names of the given objects in the form `namespace/name`.
+func objectNames[T any, PT interface {
+	*T
+	metav1.Object
+}](objs []T) []string {
+	names := make([]string, 0, len(objs))
+	for i := range objs {
+		obj := PT(&objs[i])
+		names = append(names, rbac.ObjectName(obj.GetNamespace(), obj.GetName()))
+	}
+	return names
+}
+
+// filterK8sList runs the given transformer on a list of objects with the given
+// names, as returned by the Kubernetes API, and returns the names in the result.
+func filterK8sList(
+	t *testing.T,
+	names []string,
+	mutate func(obj *unstructured.Unstructured),
+	transform apiResponseTransformerFn,
+) []string {
+	t.Helper()
+	list := &unstructured.UnstructuredList{Object: map[string]interface{}{}}
+	list.SetAPIVersion("everest.percona.com/v1alpha1")
+	list.SetKind("List")
+	for _, name := range names {
+		obj := unstructured.Unstructured{Object: map[string]interface{}{}}
+		meta := objectMeta(name)
+		obj.SetNamespace(meta.Namespace)
+		obj.SetName(meta.Name)
+		mutate(&obj)
+		list.Items = append(list.Items, obj)
+	}
+	in, err := json.Marshal(list)
+	require.NoError(t, err)
+	out, err := transform(in)
+	require.NoError(t, err)
+
+	result := &unstructured.UnstructuredList{}
+	require.NoError(t, json.Unmarshal(out, result))
+	return objectNames(result.Items)
+}
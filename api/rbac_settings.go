@@ -0,0 +1,88 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+
+	"github.com/percona/everest/pkg/rbac"
+)
+
+// GetRBACSettings returns the current RBAC settings.
+func (e *EverestServer) GetRBACSettings(ctx echo.Context) error {
+	v, err := e.rbacStore.Get(ctx.Request().Context())
+	if err != nil {
+		return errors.Join(err, errors.New("failed to get RBAC settings"))
+	}
+	return ctx.JSON(http.StatusOK, rbacSettingsToAPI(*v))
+}
+
+// UpdateRBACSettings validates and updates the RBAC settings.
+func (e *EverestServer) UpdateRBACSettings(ctx echo.Context) error {
+	var params UpdateRBACSettingsParams
+	if err := ctx.Bind(&params); err != nil {
+		return err
+	}
+	user, err := rbac.GetUsername(ctx)
+	if err != nil {
+		return err
+	}
+
+	v, err := e.rbacStore.Set(ctx.Request().Context(), params.Enabled, params.Policy, user, params.Version)
+	if errors.Is(err, rbac.ErrInvalidPolicy) {
+		return writeErrorFrom(ctx, http.StatusBadRequest, err)
+	}
+	if errors.Is(err, rbac.ErrPolicyVersionConflict) {
+		return writeError(ctx, http.StatusConflict, "RBAC settings were modified since the given version")
+	}
+	if err != nil {
+		return errors.Join(err, errors.New("failed to update RBAC settings"))
+	}
+	e.l.Infof("RBAC settings updated to version %d by %s", v.Version, user)
+	return ctx.JSON(http.StatusOK, rbacSettingsToAPI(*v))
+}
+
+// ListRBACSettingsVersions lists the previous versions of the RBAC settings.
+func (e *EverestServer) ListRBACSettingsVersions(ctx echo.Context) error {
+	history, err := e.rbacStore.History(ctx.Request().Context())
+	if err != nil {
+		return errors.Join(err, errors.New("failed to get RBAC settings history"))
+	}
+	result := make(RBACSettingsList, 0, len(history))
+	for _, v := range history {
+		result = append(result, rbacSettingsToAPI(v))
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
+
+func rbacSettingsToAPI(v rbac.PolicyVersion) RBACSettings {
+	result := RBACSettings{
+		Enabled: v.Enabled,
+		Policy:  v.Policy,
+		Version: v.Version,
+	}
+	if v.UpdatedBy != "" {
+		result.UpdatedBy = pointer.ToString(v.UpdatedBy)
+	}
+	if !v.UpdatedAt.IsZero() {
+		result.UpdatedAt = pointer.ToTime(v.UpdatedAt)
+	}
+	return result
+}
@@ -18,24 +18,32 @@ package api
 
 import (
 	"errors"
-	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/AlekSi/pointer"
-	"github.com/google/uuid"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
 
 	"github.com/percona/everest/pkg/accounts"
 	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/metrics"
+	"github.com/percona/everest/pkg/session"
 )
 
 const (
-	jwtSubjectTml    = "%s:%s" // username:capability
 	jwtDefaultExpiry = time.Hour * 24
 )
 
+var (
+	errTokenRevoked   = errors.New("token has been revoked")
+	errNotAccessToken = errors.New("only access tokens can be used for authentication")
+)
+
 // CreateSession creates a new session.
+// If MFA is enabled for the user, a challenge is returned instead, which has to be
+// sent back along with the MFA code for creating the session.
 func (e *EverestServer) CreateSession(ctx echo.Context) error {
 	var params UserCredentials
 	if err := ctx.Bind(&params); err != nil {
@@ -43,20 +51,39 @@ func (e *EverestServer) CreateSession(ctx echo.Context) error {
 	}
 
 	c := ctx.Request().Context()
-	err := e.sessionMgr.Authenticate(c, *params.Username, *params.Password)
+	if params.MfaChallenge != nil {
+		subject, err := e.sessionMgr.VerifyMFA(c, *params.MfaChallenge, pointer.GetString(params.MfaCode))
+		if err != nil {
+			e.loginFailed(ctx, pointer.GetString(params.Username), err)
+			return sessionErrToHTTPRes(ctx, err)
+		}
+		return e.createSession(ctx, subject)
+	}
+
+	username, password := pointer.GetString(params.Username), pointer.GetString(params.Password)
+	err := e.sessionMgr.Authenticate(c, username, password)
 	if err != nil {
-		e.attemptsStore.IncreaseTimeout(ctx.RealIP())
+		e.loginFailed(ctx, username, err)
 		return sessionErrToHTTPRes(ctx, err)
 	}
 
-	uniqueID, err := uuid.NewRandom()
+	challenge, err := e.sessionMgr.MFAChallenge(c, username)
 	if err != nil {
 		return err
 	}
-	subject := fmt.Sprintf(jwtSubjectTml, *params.Username, accounts.AccountCapabilityLogin)
+	if challenge != "" {
+		return ctx.JSON(http.StatusOK, map[string]any{
+			"mfaRequired":  true,
+			"mfaChallenge": challenge,
+		})
+	}
+	return e.createSession(ctx, session.Subject(username, accounts.AccountCapabilityLogin))
+}
+
+func (e *EverestServer) createSession(ctx echo.Context, subject string) error {
 	secondsBeforeExpiry := int64(jwtDefaultExpiry.Seconds())
 
-	jwtToken, err := e.sessionMgr.Create(subject, secondsBeforeExpiry, uniqueID.String())
+	jwtToken, refreshToken, err := e.sessionMgr.CreateRefreshable(subject, secondsBeforeExpiry)
 	if err != nil {
 		return err
 	}
@@ -65,29 +92,135 @@ func (e *EverestServer) CreateSession(ctx echo.Context) error {
 		Name:  common.EverestTokenCookie,
 		Value: jwtToken,
 	})
-	e.attemptsStore.CleanupVisitor(ctx.RealIP())
+	e.loginSucceeded(ctx, strings.Split(subject, ":")[0])
+
+	return ctx.JSON(http.StatusOK, map[string]string{
+		"token":        jwtToken,
+		"refreshToken": refreshToken,
+	})
+}
+
+// RefreshSession exchanges a refresh token for a new pair of tokens.
+func (e *EverestServer) RefreshSession(ctx echo.Context) error {
+	var params RefreshSessionParams
+	if err := ctx.Bind(&params); err != nil {
+		return err
+	}
+	if params.RefreshToken == "" {
+		return writeError(ctx, http.StatusBadRequest, "refreshToken is required")
+	}
+
+	secondsBeforeExpiry := int64(jwtDefaultExpiry.Seconds())
+	jwtToken, refreshToken, err := e.sessionMgr.Refresh(ctx.Request().Context(), params.RefreshToken, secondsBeforeExpiry)
+	if err != nil {
+		e.loginFailed(ctx, "", err)
+		return sessionErrToHTTPRes(ctx, err)
+	}
+
+	ctx.SetCookie(&http.Cookie{
+		Name:  common.EverestTokenCookie,
+		Value: jwtToken,
+	})
+	e.loginSucceeded(ctx, "")
+
+	return ctx.JSON(http.StatusOK, map[string]string{
+		"token":        jwtToken,
+		"refreshToken": refreshToken,
+	})
+}
+
+// ChangePassword changes the password of a local account.
+func (e *EverestServer) ChangePassword(ctx echo.Context) error {
+	var params ChangePasswordParams
+	if err := ctx.Bind(&params); err != nil {
+		return err
+	}
+
+	err := e.sessionMgr.ChangePassword(ctx.Request().Context(),
+		params.Username, params.Password, pointer.GetString(params.MfaCode), params.NewPassword)
+	if err != nil {
+		if !errors.Is(err, accounts.ErrPasswordPolicyViolation) {
+			e.loginFailed(ctx, params.Username, err)
+		}
+		return sessionErrToHTTPRes(ctx, err)
+	}
+	e.loginSucceeded(ctx, params.Username)
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// DeleteSession revokes the token of the current session and clears the session cookie.
+func (e *EverestServer) DeleteSession(ctx echo.Context) error {
+	// Users authenticated with a client certificate have no token to revoke,
+	// so for these we only clear the session cookie.
+	if token, ok := ctx.Get("user").(*jwt.Token); ok { // by default token is stored under `user` key
+		claims, ok := token.Claims.(jwt.MapClaims)
+		if !ok {
+			return errors.New("failed to get claims from token")
+		}
+		issuer, err := claims.GetIssuer()
+		if err != nil {
+			return errors.Join(err, errors.New("failed to get issuer from claims"))
+		}
 
-	return ctx.JSON(http.StatusOK, map[string]string{"token": jwtToken})
+		// Tokens issued by an external identity provider cannot be revoked by Everest,
+		// so for these we only clear the session cookie as well.
+		if issuer == session.SessionManagerClaimsIssuer {
+			if err := e.sessionMgr.Revoke(ctx.Request().Context(), claims); err != nil {
+				return errors.Join(err, errors.New("failed to revoke session"))
+			}
+		}
+	}
+
+	ctx.SetCookie(&http.Cookie{
+		Name:   common.EverestTokenCookie,
+		Value:  "",
+		MaxAge: -1,
+	})
+	return ctx.NoContent(http.StatusNoContent)
 }
 
 func sessionErrToHTTPRes(ctx echo.Context, err error) error {
 	if errors.Is(err, accounts.ErrAccountNotFound) ||
 		errors.Is(err, accounts.ErrIncorrectPassword) {
-		return ctx.JSON(http.StatusUnauthorized, Error{
-			Message: pointer.To("Incorrect username or password provided"),
-		})
+		metrics.IncSessionFailure("invalid_credentials")
+		return writeProblem(ctx, newProblem(http.StatusUnauthorized, InvalidCredentials, "Incorrect username or password provided"))
+	}
+
+	if errors.Is(err, session.ErrInvalidMFAChallenge) ||
+		errors.Is(err, session.ErrInvalidMFACode) {
+		metrics.IncSessionFailure("invalid_mfa_code")
+		return writeProblem(ctx, newProblem(http.StatusUnauthorized, InvalidCredentials, "Invalid or expired MFA code provided"))
+	}
+
+	if errors.Is(err, session.ErrInvalidRefreshToken) ||
+		errors.Is(err, session.ErrRefreshTokenReused) {
+		metrics.IncSessionFailure("invalid_refresh_token")
+		return writeError(ctx, http.StatusUnauthorized, "Invalid or expired refresh token provided")
+	}
+
+	if errors.Is(err, accounts.ErrPasswordExpired) {
+		metrics.IncSessionFailure("password_expired")
+		return writeProblem(ctx, newProblem(http.StatusForbidden, PasswordExpired, "Password has expired and must be changed"))
+	}
+
+	if errors.Is(err, accounts.ErrPasswordPolicyViolation) {
+		metrics.IncSessionFailure("password_policy_violation")
+		return writeErrorFrom(ctx, http.StatusBadRequest, err)
+	}
+
+	if errors.Is(err, accounts.ErrAccountLocked) {
+		metrics.IncSessionFailure("account_locked")
+		return writeProblem(ctx, newProblem(http.StatusTooManyRequests, AccountLocked, "User account is temporarily locked after too many failed login attempts"))
 	}
 
 	if errors.Is(err, accounts.ErrAccountDisabled) {
-		return ctx.JSON(http.StatusForbidden, Error{
-			Message: pointer.To("User account is disabled"),
-		})
+		metrics.IncSessionFailure("account_disabled")
+		return writeProblem(ctx, newProblem(http.StatusForbidden, AccountDisabled, "User account is disabled"))
 	}
 
 	if errors.Is(err, accounts.ErrInsufficientCapabilities) {
-		return ctx.JSON(http.StatusForbidden, Error{
-			Message: pointer.To("User account lacks required capabilities"),
-		})
+		metrics.IncSessionFailure("insufficient_capabilities")
+		return writeProblem(ctx, newProblem(http.StatusForbidden, AccountDisabled, "User account lacks required capabilities"))
 	}
 	return err
 }
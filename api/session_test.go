@@ -0,0 +1,46 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+func TestDeleteSessionWithoutToken(t *testing.T) {
+	t.Parallel()
+	e := &EverestServer{}
+	req := httptest.NewRequest(http.MethodDelete, "/v1/session", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	// Users authenticated with a client certificate have no token.
+	rbac.SetUser(c, rbac.User{Name: "alice"})
+
+	require.NoError(t, e.DeleteSession(c))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	cookies := rec.Result().Cookies() //nolint:bodyclose
+	require.Len(t, cookies, 1)
+	assert.Equal(t, common.EverestTokenCookie, cookies[0].Name)
+	assert.Equal(t, -1, cookies[0].MaxAge)
+}
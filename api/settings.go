@@ -3,7 +3,6 @@ package api
 import (
 	"net/http"
 
-	"github.com/AlekSi/pointer"
 	"github.com/labstack/echo/v4"
 	"k8s.io/apimachinery/pkg/api/errors"
 )
@@ -12,15 +11,11 @@ import (
 func (e *EverestServer) GetSettings(ctx echo.Context) error {
 	settings, err := e.kubeClient.GetEverestSettings(ctx.Request().Context())
 	if err != nil && !errors.IsNotFound(err) {
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.To("Failed to get Everest settings"),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed to get Everest settings")
 	}
 	config, err := settings.OIDCConfig()
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.To("Failed to read Everest settings"),
-		})
+		return writeError(ctx, http.StatusInternalServerError, "Failed to read Everest settings")
 	}
 	return ctx.JSON(http.StatusOK, &Settings{
 		OidcConfig: OIDCConfig{
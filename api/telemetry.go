@@ -13,6 +13,8 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/percona/everest/cmd/config"
+	"github.com/percona/everest/pkg/metrics"
+	"github.com/percona/everest/pkg/tracing"
 	"github.com/percona/everest/pkg/version"
 )
 
@@ -61,7 +63,7 @@ func (e *EverestServer) report(ctx context.Context, baseURL string, data Telemet
 	}
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := tracing.Client(http.DefaultTransport).Do(req)
 	if err != nil {
 		e.l.Error(errors.Join(err, errors.New("failed to send telemetry request")))
 		return err
@@ -94,6 +96,7 @@ func (e *EverestServer) RunTelemetryJob(ctx context.Context, c *config.EverestCo
 			timer.Reset(interval)
 			err = e.collectMetrics(ctx, *c)
 			if err != nil {
+				metrics.IncTelemetryError()
 				e.l.Error(errors.Join(err, errors.New("failed to collect telemetry data")))
 			}
 		}
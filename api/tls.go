@@ -0,0 +1,78 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/percona/everest/pkg/rbac"
+	"github.com/percona/everest/pkg/tlsconfig"
+)
+
+const (
+	// defaultHTTPSPort is the port that is omitted from the HTTPS redirect URLs.
+	defaultHTTPSPort = 443
+	// redirectReadHeaderTimeout is the time the HTTPS redirect server waits for the request headers.
+	redirectReadHeaderTimeout = 10 * time.Second
+)
+
+// clientCertUser returns the RBAC user and groups of the verified client certificate of the request.
+// Returns false if the request was not made with a verified client certificate.
+func (e *EverestServer) clientCertUser(c echo.Context) (rbac.User, bool) {
+	if !e.config.TLS.Enabled {
+		return rbac.User{}, false
+	}
+	name, groups, ok := tlsconfig.ClientCertUser(c.Request().TLS, e.config.TLS.ClientCertUserPrefix)
+	if !ok {
+		return rbac.User{}, false
+	}
+	return rbac.User{Name: name, Groups: groups}, true
+}
+
+// setClientCertUser is a middleware that authenticates the requests made with a verified client certificate.
+// Such requests do not need a token, and the RBAC user is resolved from the certificate instead.
+func (e *EverestServer) setClientCertUser(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if user, ok := e.clientCertUser(c); ok {
+			rbac.SetUser(c, user)
+		}
+		return next(c)
+	}
+}
+
+// httpsRedirectHandler redirects the requests to the same host and path over HTTPS on the given port.
+func httpsRedirectHandler(port int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host, _, err := net.SplitHostPort(r.Host)
+		if err != nil {
+			// The host has no port.
+			host = strings.TrimSuffix(strings.TrimPrefix(r.Host, "["), "]")
+		}
+		if port != defaultHTTPSPort {
+			host = net.JoinHostPort(host, strconv.Itoa(port))
+		} else if strings.Contains(host, ":") {
+			host = "[" + host + "]"
+		}
+		// 308 keeps the method and the body of the request.
+		http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), http.StatusPermanentRedirect)
+	})
+}
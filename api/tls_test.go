@@ -0,0 +1,70 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPSRedirectHandler(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		host     string
+		target   string
+		port     int
+		location string
+	}{
+		{
+			host:     "everest.example.com:8080",
+			target:   "/v1/version?x=1",
+			port:     8443,
+			location: "https://everest.example.com:8443/v1/version?x=1",
+		},
+		{
+			host:     "everest.example.com",
+			target:   "/",
+			port:     443,
+			location: "https://everest.example.com/",
+		},
+		{
+			host:     "[::1]:8080",
+			target:   "/v1/session",
+			port:     8443,
+			location: "https://[::1]:8443/v1/session",
+		},
+		{
+			host:     "[::1]",
+			target:   "/v1/session",
+			port:     443,
+			location: "https://[::1]/v1/session",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.host, func(t *testing.T) {
+			t.Parallel()
+			req := httptest.NewRequest(http.MethodPost, tc.target, nil)
+			req.Host = tc.host
+			rec := httptest.NewRecorder()
+			httpsRedirectHandler(tc.port).ServeHTTP(rec, req)
+			assert.Equal(t, http.StatusPermanentRedirect, rec.Code)
+			assert.Equal(t, tc.location, rec.Header().Get("Location"))
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"errors"
+	"html"
+	"io/fs"
+	"net/http"
+	"regexp"
+	"slices"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/percona/everest/public"
+)
+
+// basePathMetaName is the name of the meta tag the UI reads the base path it is served under from.
+const basePathMetaName = "everest-base-path"
+
+// rootURLAttr matches the attributes of index.html that point to a path on the server, e.g. src="/static/main.js".
+//
+//nolint:gochecknoglobals
+var rootURLAttr = regexp.MustCompile(`(?:src|href)="/[^/]`)
+
+// installUI registers the handlers of the UI under the base path.
+// Every path that is not handled otherwise serves index.html, so that the UI can route it.
+func (e *EverestServer) installUI() error {
+	fsys, err := fs.Sub(public.Static, "dist")
+	if err != nil {
+		return errors.Join(err, errors.New("error reading filesystem"))
+	}
+	staticFilesHandler := echo.WrapHandler(http.StripPrefix(e.config.BasePath, http.FileServer(http.FS(fsys))))
+	index, err := fs.ReadFile(public.Index, "dist/index.html")
+	if err != nil {
+		return errors.Join(err, errors.New("error reading index.html"))
+	}
+	index = rewriteIndex(index, e.config.BasePath)
+	indexHandler := func(c echo.Context) error {
+		return c.HTMLBlob(http.StatusOK, index)
+	}
+
+	ui := e.echo.Group(e.config.BasePath)
+	ui.GET("", indexHandler)
+	ui.GET("/*", indexHandler)
+	ui.GET("/favicon.ico", staticFilesHandler)
+	ui.GET("/assets-manifest.json", staticFilesHandler)
+	ui.GET("/static/*", staticFilesHandler)
+	return nil
+}
+
+// rewriteIndex prefixes the paths index.html points to with the base path
+// and passes the base path to the UI in a meta tag.
+func rewriteIndex(index []byte, basePath string) []byte {
+	if basePath == "" {
+		return index
+	}
+	escaped := []byte(html.EscapeString(basePath))
+	index = rootURLAttr.ReplaceAllFunc(index, func(attr []byte) []byte {
+		i := bytes.IndexByte(attr, '"') + 1
+		return slices.Concat(attr[:i], escaped, attr[i:])
+	})
+	meta := []byte(`<meta name="` + basePathMetaName + `" content="` + string(escaped) + `" />`)
+	if i := bytes.Index(index, []byte("</head>")); i >= 0 {
+		return slices.Concat(index[:i], meta, index[i:])
+	}
+	return slices.Concat(meta, index)
+}
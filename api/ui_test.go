@@ -0,0 +1,62 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRewriteIndex(t *testing.T) {
+	t.Parallel()
+	index := `<html><head><link rel="icon" href="/static/everest.svg" />` +
+		`<script type="module" src="/static/index.js"></script>` +
+		`<link rel="preconnect" href="https://fonts.example.com" />` +
+		`<link rel="preconnect" href="//cdn.example.com" /></head><body></body></html>`
+
+	testCases := []struct {
+		basePath string
+		expected string
+	}{
+		{
+			basePath: "",
+			expected: index,
+		},
+		{
+			basePath: "/everest",
+			expected: `<html><head><link rel="icon" href="/everest/static/everest.svg" />` +
+				`<script type="module" src="/everest/static/index.js"></script>` +
+				`<link rel="preconnect" href="https://fonts.example.com" />` +
+				`<link rel="preconnect" href="//cdn.example.com" />` +
+				`<meta name="everest-base-path" content="/everest" /></head><body></body></html>`,
+		},
+		{
+			basePath: `/a"b`,
+			expected: `<html><head><link rel="icon" href="/a&#34;b/static/everest.svg" />` +
+				`<script type="module" src="/a&#34;b/static/index.js"></script>` +
+				`<link rel="preconnect" href="https://fonts.example.com" />` +
+				`<link rel="preconnect" href="//cdn.example.com" />` +
+				`<meta name="everest-base-path" content="/a&#34;b" /></head><body></body></html>`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.basePath, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, string(rewriteIndex([]byte(index), tc.basePath)))
+		})
+	}
+}
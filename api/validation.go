@@ -31,6 +31,7 @@ import (
 	"time"
 
 	"github.com/AlekSi/pointer"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
@@ -50,6 +51,7 @@ import (
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
 	"github.com/percona/everest/pkg/rbac"
+	"github.com/percona/everest/pkg/tracing"
 )
 
 const (
@@ -74,58 +76,58 @@ var (
 	minCPUQuantity     = resource.MustParse("600m") //nolint:gochecknoglobals
 	minMemQuantity     = resource.MustParse("512M") //nolint:gochecknoglobals
 
-	errDBCEmptyMetadata              = errors.New("databaseCluster's Metadata should not be empty")
-	errDBCNameEmpty                  = errors.New("databaseCluster's metadata.name should not be empty")
-	errDBCNamespaceEmpty             = errors.New("databaseCluster's metadata.namespace should not be empty")
-	errDBCNameWrongFormat            = errors.New("databaseCluster's metadata.name should be a string")
-	errDBCNamespaceWrongFormat       = errors.New("databaseCluster's metadata.namespace should be a string")
-	errNotEnoughMemory               = fmt.Errorf("memory limits should be above %s", minMemQuantity.String())
+	errDBCEmptyMetadata              = newFieldError("metadata", errors.New("databaseCluster's Metadata should not be empty"))
+	errDBCNameEmpty                  = newFieldError("metadata.name", errors.New("databaseCluster's metadata.name should not be empty"))
+	errDBCNamespaceEmpty             = newFieldError("metadata.namespace", errors.New("databaseCluster's metadata.namespace should not be empty"))
+	errDBCNameWrongFormat            = newFieldError("metadata.name", errors.New("databaseCluster's metadata.name should be a string"))
+	errDBCNamespaceWrongFormat       = newFieldError("metadata.namespace", errors.New("databaseCluster's metadata.namespace should be a string"))
+	errNotEnoughMemory               = newFieldError("spec.engine.resources.memory", fmt.Errorf("memory limits should be above %s", minMemQuantity.String()))
 	errInt64NotSupported             = errors.New("specifying resources using int64 data type is not supported. Please use string format for that")
-	errNotEnoughCPU                  = fmt.Errorf("CPU limits should be above %s", minCPUQuantity.String())
-	errNotEnoughDiskSize             = fmt.Errorf("storage size should be above %s", minStorageQuantity.String())
+	errNotEnoughCPU                  = newFieldError("spec.engine.resources.cpu", fmt.Errorf("CPU limits should be above %s", minCPUQuantity.String()))
+	errNotEnoughDiskSize             = newFieldError("spec.engine.storage.size", fmt.Errorf("storage size should be above %s", minStorageQuantity.String()))
 	errUnsupportedPXCProxy           = errors.New("you can use either HAProxy or Proxy SQL for PXC clusters")
 	errUnsupportedPGProxy            = errors.New("you can use only PGBouncer as a proxy type for Postgres clusters")
 	errUnsupportedPSMDBProxy         = errors.New("you can use only Mongos as a proxy type for MongoDB clusters")
-	errNoSchedules                   = errors.New("please specify at least one backup schedule")
-	errNoNameInSchedule              = errors.New("'name' field for the backup schedules cannot be empty")
-	errScheduleNoBackupStorageName   = errors.New("'backupStorageName' field cannot be empty when schedule is enabled")
-	errPitrNoBackupStorageName       = errors.New("'backupStorageName' field cannot be empty when pitr is enabled")
-	errNoResourceDefined             = errors.New("please specify resource limits for the cluster")
-	errPitrUploadInterval            = errors.New("'uploadIntervalSec' should be more than 0")
+	errNoSchedules                   = newFieldError("spec.backup.schedules", errors.New("please specify at least one backup schedule"))
+	errNoNameInSchedule              = newFieldError("spec.backup.schedules.name", errors.New("'name' field for the backup schedules cannot be empty"))
+	errScheduleNoBackupStorageName   = newFieldError("spec.backup.schedules.backupStorageName", errors.New("'backupStorageName' field cannot be empty when schedule is enabled"))
+	errPitrNoBackupStorageName       = newFieldError("spec.backup.pitr.backupStorageName", errors.New("'backupStorageName' field cannot be empty when pitr is enabled"))
+	errNoResourceDefined             = newFieldError("spec.engine.resources", errors.New("please specify resource limits for the cluster"))
+	errPitrUploadInterval            = newFieldError("spec.backup.pitr.uploadIntervalSec", errors.New("'uploadIntervalSec' should be more than 0"))
 	errPXCPitrS3Only                 = errors.New("point-in-time recovery only supported for s3 compatible storages")
 	errPSMDBMultipleStorages         = errors.New("can't use more than one backup storage for PSMDB clusters")
 	errPSMDBViolateActiveStorage     = errors.New("can't change the active storage for PSMDB clusters")
-	errDataSourceConfig              = errors.New("either DBClusterBackupName or BackupSource must be specified in the DataSource field")
-	errDataSourceNoPitrDateSpecified = errors.New("pitr Date must be specified for type Date")
-	errDataSourceWrongDateFormat     = errors.New("failed to parse .Spec.DataSource.Pitr.Date as 2006-01-02T15:04:05Z")
-	errDataSourceNoBackupStorageName = errors.New("'backupStorageName' should be specified in .Spec.DataSource.BackupSource")
-	errDataSourceNoPath              = errors.New("'path' should be specified in .Spec.DataSource.BackupSource")
+	errDataSourceConfig              = newFieldError("spec.dataSource", errors.New("either DBClusterBackupName or BackupSource must be specified in the DataSource field"))
+	errDataSourceNoPitrDateSpecified = newFieldError("spec.dataSource.pitr.date", errors.New("pitr Date must be specified for type Date"))
+	errDataSourceWrongDateFormat     = newFieldError("spec.dataSource.pitr.date", errors.New("failed to parse .Spec.DataSource.Pitr.Date as 2006-01-02T15:04:05Z"))
+	errDataSourceNoBackupStorageName = newFieldError("spec.dataSource.backupSource.backupStorageName", errors.New("'backupStorageName' should be specified in .Spec.DataSource.BackupSource"))
+	errDataSourceNoPath              = newFieldError("spec.dataSource.backupSource.path", errors.New("'path' should be specified in .Spec.DataSource.BackupSource"))
 	errIncorrectDataSourceStruct     = errors.New("incorrect data source struct")
-	errUnsupportedPitrType           = errors.New("the given point-in-time recovery type is not supported")
+	errUnsupportedPitrType           = newFieldError("spec.dataSource.pitr.type", errors.New("the given point-in-time recovery type is not supported"))
 	errTooManyPGStorages             = fmt.Errorf("only %d different storages are allowed in a PostgreSQL cluster", pgReposLimit)
-	errNoMetadata                    = fmt.Errorf("no metadata provided")
-	errInvalidResourceVersion        = fmt.Errorf("invalid 'resourceVersion' value")
-	errInvalidBucketName             = fmt.Errorf("invalid bucketName")
-	errInvalidVersion                = errors.New("invalid database engine version provided")
-	errDBEngineMajorVersionUpgrade   = errors.New("database engine cannot be upgraded to a major version")
-	errDBEngineDowngrade             = errors.New("database engine version cannot be downgraded")
-	errDuplicatedSchedules           = errors.New("duplicated backup schedules are not allowed")
+	errNoMetadata                    = newFieldError("metadata", fmt.Errorf("no metadata provided"))
+	errInvalidResourceVersion        = newFieldError("metadata.resourceVersion", fmt.Errorf("invalid 'resourceVersion' value"))
+	errInvalidBucketName             = newFieldError("bucketName", fmt.Errorf("invalid bucketName"))
+	errInvalidVersion                = newFieldError("spec.engine.version", errors.New("invalid database engine version provided"))
+	errDBEngineMajorVersionUpgrade   = newFieldError("spec.engine.version", errors.New("database engine cannot be upgraded to a major version"))
+	errDBEngineDowngrade             = newFieldError("spec.engine.version", errors.New("database engine version cannot be downgraded"))
+	errDuplicatedSchedules           = newFieldError("spec.backup.schedules", errors.New("duplicated backup schedules are not allowed"))
 	errDuplicatedStoragePG           = errors.New("postgres clusters can't use the same storage for the different schedules")
 	errStorageChangePG               = errors.New("the existing postgres schedules can't change their storage")
 	errDuplicatedBackupStorage       = errors.New("backup storages with the same url, bucket and url are not allowed")
 	errEditBackupStorageInUse        = errors.New("can't edit bucket or region of the backup storage in use")
 	errInsufficientPermissions       = errors.New("insufficient permissions for performing the operation")
 	errShardingIsNotSupported        = errors.New("sharding is not supported")
-	errInsufficientShardsNumber      = errors.New("shards number should be greater than 0")
-	errInsufficientCfgSrvNumber      = errors.New("sharding: minimum config servers number is 3")
-	errInsufficientCfgSrvNumber1Node = errors.New("sharding: minimum config servers number for 1 node replsets is 1")
-	errEvenServersNumber             = errors.New("sharding: config servers number should be odd")
-	errDisableShardingNotSupported   = errors.New("sharding: disable sharding is not supported")
-	errShardingEnablingNotSupported  = errors.New("sharding: enable sharding is not supported when editing db cluster")
+	errInsufficientShardsNumber      = newFieldError("spec.sharding.shards", errors.New("shards number should be greater than 0"))
+	errInsufficientCfgSrvNumber      = newFieldError("spec.sharding.configServer.replicas", errors.New("sharding: minimum config servers number is 3"))
+	errInsufficientCfgSrvNumber1Node = newFieldError("spec.sharding.configServer.replicas", errors.New("sharding: minimum config servers number for 1 node replsets is 1"))
+	errEvenServersNumber             = newFieldError("spec.sharding.configServer.replicas", errors.New("sharding: config servers number should be odd"))
+	errDisableShardingNotSupported   = newFieldError("spec.sharding.enabled", errors.New("sharding: disable sharding is not supported"))
+	errShardingEnablingNotSupported  = newFieldError("spec.sharding.enabled", errors.New("sharding: enable sharding is not supported when editing db cluster"))
 	errShardingVersion               = errors.New("sharding is available starting PSMDB 1.17.0")
-	errEvenEngineReplicas            = errors.New("engine replicas number should be odd")
-	errMaxPXCEngineReplicas          = errors.New("max replicas number for MySQL is 5")
-	errMinPXCProxyReplicas           = errors.New("min replicas number for Proxy is 2")
+	errEvenEngineReplicas            = newFieldError("spec.engine.replicas", errors.New("engine replicas number should be odd"))
+	errMaxPXCEngineReplicas          = newFieldError("spec.engine.replicas", errors.New("max replicas number for MySQL is 5"))
+	errMinPXCProxyReplicas           = newFieldError("spec.proxy.replicas", errors.New("min replicas number for Proxy is 2"))
 
 	//nolint:gochecknoglobals
 	operatorEngine = map[everestv1alpha1.EngineType]string{
@@ -137,14 +139,14 @@ var (
 
 // ErrNameNotRFC1035Compatible when the given fieldName doesn't contain RFC 1035 compatible string.
 func ErrNameNotRFC1035Compatible(fieldName string) error {
-	return fmt.Errorf(`'%s' is not RFC 1035 compatible. The name should contain only lowercase alphanumeric characters or '-', start with an alphabetic character, end with an alphanumeric character`,
+	return newFieldError(fieldName, fmt.Errorf(`'%s' is not RFC 1035 compatible. The name should contain only lowercase alphanumeric characters or '-', start with an alphabetic character, end with an alphanumeric character`,
 		fieldName,
-	)
+	))
 }
 
 // ErrNameTooLong when the given fieldName is longer than expected.
 func ErrNameTooLong(fieldName string) error {
-	return fmt.Errorf("'%s' can be at most 22 characters long", fieldName)
+	return newFieldError(fieldName, fmt.Errorf("'%s' can be at most 22 characters long", fieldName))
 }
 
 // ErrCreateStorageNotSupported appears when trying to create a storage of a type that is not supported.
@@ -159,7 +161,7 @@ func ErrUpdateStorageNotSupported(storageType string) error {
 
 // ErrInvalidURL when the given fieldName contains invalid URL.
 func ErrInvalidURL(fieldName string) error {
-	return fmt.Errorf("'%s' is an invalid URL", fieldName)
+	return newFieldError(fieldName, fmt.Errorf("'%s' is an invalid URL", fieldName))
 }
 
 // validates names to be RFC-1035 compatible  https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#rfc-1035-label-names
@@ -185,7 +187,7 @@ func validateURL(urlStr string) bool {
 func validateStorageAccessByCreate(ctx context.Context, params CreateBackupStorageParams, l *zap.SugaredLogger) error {
 	switch params.Type {
 	case CreateBackupStorageParamsTypeS3:
-		return s3Access(l, params.Url, params.AccessKey, params.SecretKey, params.BucketName, params.Region, pointer.Get(params.VerifyTLS), pointer.Get(params.ForcePathStyle))
+		return s3Access(ctx, l, params.Url, params.AccessKey, params.SecretKey, params.BucketName, params.Region, pointer.Get(params.VerifyTLS), pointer.Get(params.ForcePathStyle))
 	case CreateBackupStorageParamsTypeAzure:
 		return azureAccess(ctx, l, params.AccessKey, params.SecretKey, params.BucketName)
 	default:
@@ -195,25 +197,28 @@ func validateStorageAccessByCreate(ctx context.Context, params CreateBackupStora
 
 //nolint:funlen
 func s3Access(
+	ctx context.Context,
 	l *zap.SugaredLogger,
 	endpoint *string,
 	accessKey, secretKey, bucketName, region string,
 	verifyTLS bool,
 	forcePathStyle bool,
-) error {
+) (err error) {
 	if config.Debug {
 		return nil
 	}
 
+	ctx, span := tracing.Start(ctx, "s3Access")
+	defer func() { tracing.End(span, err) }()
+
 	if endpoint != nil && *endpoint == "" {
 		endpoint = nil
 	}
 
-	c := http.DefaultClient
-	c.Timeout = timeoutS3AccessSec * time.Second
-	c.Transport = &http.Transport{
+	c := tracing.Client(&http.Transport{
 		TLSClientConfig: &tls.Config{InsecureSkipVerify: !verifyTLS}, //nolint:gosec
-	}
+	})
+	c.Timeout = timeoutS3AccessSec * time.Second
 	// Create a new session with the provided credentials
 	sess, err := session.NewSession(&aws.Config{
 		Endpoint:         endpoint,
@@ -230,7 +235,7 @@ func s3Access(
 	// Create a new S3 client with the session
 	svc := s3.New(sess)
 
-	_, err = svc.HeadBucket(&s3.HeadBucketInput{
+	_, err = svc.HeadBucketWithContext(ctx, &s3.HeadBucketInput{
 		Bucket: aws.String(bucketName),
 	})
 	if err != nil {
@@ -239,7 +244,7 @@ func s3Access(
 	}
 
 	testKey := "everest-write-test"
-	_, err = svc.PutObject(&s3.PutObjectInput{
+	_, err = svc.PutObjectWithContext(ctx, &s3.PutObjectInput{
 		Bucket: aws.String(bucketName),
 		Body:   bytes.NewReader([]byte{}),
 		Key:    aws.String(testKey),
@@ -249,7 +254,7 @@ func s3Access(
 		return errors.New("could not write to S3 bucket")
 	}
 
-	_, err = svc.GetObject(&s3.GetObjectInput{
+	_, err = svc.GetObjectWithContext(ctx, &s3.GetObjectInput{
 		Bucket: aws.String(bucketName),
 		Key:    aws.String(testKey),
 	})
@@ -258,14 +263,14 @@ func s3Access(
 		return errors.New("could not read from S3 bucket")
 	}
 
-	_, err = svc.ListObjectsV2(&s3.ListObjectsV2Input{
+	_, err = svc.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
 		Bucket: aws.String(bucketName),
 	})
 	if err != nil {
 		return errors.New("could not list objects in S3 bucket")
 	}
 
-	_, err = svc.DeleteObject(&s3.DeleteObjectInput{
+	_, err = svc.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
 		Bucket: aws.String(bucketName),
 		Key:    aws.String(testKey),
 	})
@@ -277,18 +282,25 @@ func s3Access(
 	return nil
 }
 
-func azureAccess(ctx context.Context, l *zap.SugaredLogger, accountName, accountKey, containerName string) error {
+func azureAccess(ctx context.Context, l *zap.SugaredLogger, accountName, accountKey, containerName string) (err error) {
 	if config.Debug {
 		return nil
 	}
 
+	ctx, span := tracing.Start(ctx, "azureAccess")
+	defer func() { tracing.End(span, err) }()
+
 	cred, err := azblob.NewSharedKeyCredential(accountName, accountKey)
 	if err != nil {
 		l.Error(err)
 		return errors.New("could not initialize Azure credentials")
 	}
 
-	client, err := azblob.NewClientWithSharedKeyCredential(fmt.Sprintf("https://%s.blob.core.windows.net/", url.PathEscape(accountName)), cred, nil)
+	client, err := azblob.NewClientWithSharedKeyCredential(
+		fmt.Sprintf("https://%s.blob.core.windows.net/", url.PathEscape(accountName)),
+		cred,
+		&azblob.ClientOptions{ClientOptions: azcore.ClientOptions{Transport: tracing.Client(http.DefaultTransport)}},
+	)
 	if err != nil {
 		l.Error(err)
 		return errors.New("could not initialize Azure client")
@@ -335,7 +347,7 @@ func validateBackupStorageAccess(
 		if region == "" {
 			return errors.New("region is required when using S3 storage type")
 		}
-		if err := s3Access(l, url, accessKey, secretKey, bucketName, region, verifyTLS, forcePathStyle); err != nil {
+		if err := s3Access(ctx.Request().Context(), l, url, accessKey, secretKey, bucketName, region, verifyTLS, forcePathStyle); err != nil {
 			return err
 		}
 	case string(BackupStorageTypeAzure):
@@ -370,7 +382,7 @@ func (e *EverestServer) validateUpdateBackupStorageRequest(
 		return nil, errEditBackupStorageInUse
 	}
 
-	existingStorages, err := e.kubeClient.ListBackupStorages(c, e.kubeClient.Namespace())
+	existingStorages, err := e.kubeClient.ListBackupStorages(c, e.kubeClient.Namespace(), metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -668,7 +680,7 @@ func (e *EverestServer) validateDatabaseClusterOnCreate(
 // - create restores.
 // - read database cluster credentials.
 func (e *EverestServer) enforceRestoreToNewDBRBAC(
-	ctx context.Context, user, namespace string, databaseCluster *DatabaseCluster,
+	ctx context.Context, user rbac.User, namespace string, databaseCluster *DatabaseCluster,
 ) error {
 	sourceBackup := pointer.Get(pointer.Get(pointer.Get(databaseCluster.Spec).DataSource).DbClusterBackupName)
 	if sourceBackup == "" {
@@ -696,52 +708,114 @@ func (e *EverestServer) enforceRestoreToNewDBRBAC(
 func (e *EverestServer) validateDatabaseClusterCR(
 	ctx echo.Context, namespace string, databaseCluster *DatabaseCluster,
 ) error {
-	if err := validateCreateDatabaseClusterRequest(*databaseCluster); err != nil {
-		return err
-	}
-
-	engineName, ok := operatorEngine[everestv1alpha1.EngineType(databaseCluster.Spec.Engine.Type)]
-	if !ok {
-		return errors.New("unsupported database engine")
-	}
-	engine, err := e.kubeClient.GetDatabaseEngine(ctx.Request().Context(), namespace, engineName)
-	if err != nil {
-		return err
-	}
-	if err := validateEngine(databaseCluster, engine); err != nil {
-		return err
-	}
-	if databaseCluster.Spec.Proxy != nil && databaseCluster.Spec.Proxy.Type != nil {
-		if err := validateProxy(databaseCluster); err != nil {
-			return err
-		}
-	}
-	if err := validateBackupSpec(databaseCluster); err != nil {
-		return err
-	}
+	reqCtx, span := tracing.Start(ctx.Request().Context(), "validateDatabaseClusterCR")
+	err := runValidationSteps(reqCtx, e.databaseClusterValidationSteps(namespace, databaseCluster))
+	tracing.End(span, err)
+	return err
+}
 
-	if err = e.validateBackupStoragesFor(ctx.Request().Context(), namespace, databaseCluster); err != nil {
-		return err
-	}
+// validationStep is a named step of the validation of a request.
+// Every step is run in its own span, so that slow steps can be spotted in the traces.
+type validationStep struct {
+	name     string
+	validate func(ctx context.Context) error
+}
 
-	if databaseCluster.Spec.DataSource != nil {
-		if err := validateDBDataSource(databaseCluster); err != nil {
+// runValidationSteps runs the steps in order and stops at the first one that fails.
+func runValidationSteps(ctx context.Context, steps []validationStep) error {
+	for _, step := range steps {
+		if err := tracing.Trace(ctx, step.name, step.validate); err != nil {
 			return err
 		}
 	}
+	return nil
+}
 
-	if databaseCluster.Spec.Engine.Type == DatabaseClusterSpecEngineType(everestv1alpha1.DatabaseEnginePostgresql) {
-		if err = e.validatePGSchedulesRestrictions(ctx.Request().Context(), *databaseCluster); err != nil {
-			return err
-		}
-		if err = validatePGReposForAPIDB(ctx.Request().Context(), databaseCluster, e.kubeClient.ListDatabaseClusterBackups); err != nil {
-			return err
-		}
-	}
-	if err := validateSharding(*databaseCluster); err != nil {
-		return err
+//nolint:funlen
+func (e *EverestServer) databaseClusterValidationSteps(namespace string, databaseCluster *DatabaseCluster) []validationStep {
+	isPG := databaseCluster.Spec.Engine.Type == DatabaseClusterSpecEngineType(everestv1alpha1.DatabaseEnginePostgresql)
+	return []validationStep{
+		{
+			name: "validateCreateDatabaseClusterRequest",
+			validate: func(context.Context) error {
+				return validateCreateDatabaseClusterRequest(*databaseCluster)
+			},
+		},
+		{
+			name: "validateEngine",
+			validate: func(ctx context.Context) error {
+				engineName, ok := operatorEngine[everestv1alpha1.EngineType(databaseCluster.Spec.Engine.Type)]
+				if !ok {
+					return errors.New("unsupported database engine")
+				}
+				engine, err := e.kubeClient.GetDatabaseEngine(ctx, namespace, engineName)
+				if err != nil {
+					return err
+				}
+				return validateEngine(databaseCluster, engine)
+			},
+		},
+		{
+			name: "validateProxy",
+			validate: func(context.Context) error {
+				if databaseCluster.Spec.Proxy == nil || databaseCluster.Spec.Proxy.Type == nil {
+					return nil
+				}
+				return validateProxy(databaseCluster)
+			},
+		},
+		{
+			name: "validateBackupSpec",
+			validate: func(context.Context) error {
+				return validateBackupSpec(databaseCluster)
+			},
+		},
+		{
+			name: "validateBackupStorages",
+			validate: func(ctx context.Context) error {
+				return e.validateBackupStoragesFor(ctx, namespace, databaseCluster)
+			},
+		},
+		{
+			name: "validateDataSource",
+			validate: func(context.Context) error {
+				if databaseCluster.Spec.DataSource == nil {
+					return nil
+				}
+				return validateDBDataSource(databaseCluster)
+			},
+		},
+		{
+			name: "validatePGSchedulesRestrictions",
+			validate: func(ctx context.Context) error {
+				if !isPG {
+					return nil
+				}
+				return e.validatePGSchedulesRestrictions(ctx, *databaseCluster)
+			},
+		},
+		{
+			name: "validatePGRepos",
+			validate: func(ctx context.Context) error {
+				if !isPG {
+					return nil
+				}
+				return validatePGReposForAPIDB(ctx, databaseCluster, e.kubeClient.ListDatabaseClusterBackups)
+			},
+		},
+		{
+			name: "validateSharding",
+			validate: func(context.Context) error {
+				return validateSharding(*databaseCluster)
+			},
+		},
+		{
+			name: "validateResourceLimits",
+			validate: func(context.Context) error {
+				return validateResourceLimits(databaseCluster)
+			},
+		},
 	}
-	return validateResourceLimits(databaseCluster)
 }
 
 func validateSharding(dbc DatabaseCluster) error {
@@ -1193,7 +1267,7 @@ func validateDBEngineVersionUpgrade(newVersion, oldVersion string) error {
 }
 
 func (e *EverestServer) validateBackupScheduledUpdate(
-	user string,
+	user rbac.User,
 	dbc *DatabaseCluster,
 	oldDB *everestv1alpha1.DatabaseCluster,
 ) error {
@@ -1249,7 +1323,7 @@ func (e *EverestServer) validateBackupScheduledUpdate(
 }
 
 func (e *EverestServer) validateDatabaseClusterOnUpdate(
-	user string,
+	user rbac.User,
 	dbc *DatabaseCluster,
 	oldDB *everestv1alpha1.DatabaseCluster,
 ) error {
@@ -1277,6 +1351,10 @@ func (e *EverestServer) validateDatabaseClusterOnUpdate(
 	if err := e.validateBackupScheduledUpdate(user, dbc, oldDB); err != nil {
 		return err
 	}
+
+	if err := e.enforceDBClusterUpdateRBAC(user, dbc, oldDB); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1332,19 +1332,19 @@ func TestValidateBackupSchedulesUpdate(t *testing.T) {
 				l: zap.NewNop().Sugar(),
 			}
 			enforcer := &mocks.IEnforcer{}
-			enforcer.On("Enforce",
+			enforcer.On("EnforceEx",
 				"user", rbac.ResourceDatabaseClusterBackups, rbac.ActionCreate, "test-ns/",
-			).Return(tc.canTakeBackups, nil)
-			enforcer.On("Enforce",
+			).Return(tc.canTakeBackups, []string{}, nil)
+			enforcer.On("EnforceEx",
 				"user", rbac.ResourceBackupStorages, rbac.ActionRead, mock.Anything,
-			).Return(true, nil)
+			).Return(true, []string{}, nil)
 			e.rbacEnforcer = enforcer
 
 			updated := &DatabaseCluster{}
 			err := json.Unmarshal(tc.updated, updated)
 			require.NoError(t, err)
 
-			err = e.validateBackupScheduledUpdate("user", updated, tc.old)
+			err = e.validateBackupScheduledUpdate(rbac.User{Name: "user"}, updated, tc.old)
 			assert.ErrorIs(t, err, tc.expected)
 		})
 	}
@@ -70,6 +70,37 @@ const (
 	DatabaseClusterRestoreSpecDataSourcePitrTypeLatest DatabaseClusterRestoreSpecDataSourcePitrType = "latest"
 )
 
+// Defines values for ErrorCode.
+const (
+	AccountDisabled    ErrorCode = "account_disabled"
+	AccountLocked      ErrorCode = "account_locked"
+	AlreadyExists      ErrorCode = "already_exists"
+	BadRequest         ErrorCode = "bad_request"
+	Conflict           ErrorCode = "conflict"
+	Forbidden          ErrorCode = "forbidden"
+	InternalError      ErrorCode = "internal_error"
+	Invalid            ErrorCode = "invalid"
+	InvalidCredentials ErrorCode = "invalid_credentials"
+	NotFound           ErrorCode = "not_found"
+	PasswordExpired    ErrorCode = "password_expired"
+	TooManyRequests    ErrorCode = "too_many_requests"
+	Unauthorized       ErrorCode = "unauthorized"
+	Unavailable        ErrorCode = "unavailable"
+	ValidationFailed   ErrorCode = "validation_failed"
+)
+
+// Defines values for ListSortBy.
+const (
+	CreationTimestamp ListSortBy = "creationTimestamp"
+	Name              ListSortBy = "name"
+)
+
+// Defines values for ListSortOrder.
+const (
+	Asc  ListSortOrder = "asc"
+	Desc ListSortOrder = "desc"
+)
+
 // Defines values for MonitoringInstanceBaseType.
 const (
 	MonitoringInstanceBaseTypePmm MonitoringInstanceBaseType = "pmm"
@@ -98,6 +129,20 @@ const (
 	UpgradeEngine UpgradeTaskPendingTask = "upgradeEngine"
 )
 
+// APIKey API key information
+type APIKey struct {
+	Description *string    `json:"description,omitempty"`
+	ExpiresAt   *time.Time `json:"expiresAt,omitempty"`
+	Id          string     `json:"id"`
+	IssuedAt    time.Time  `json:"issuedAt"`
+
+	// Key The API key. It is returned only once, when the key is created.
+	Key *string `json:"key,omitempty"`
+}
+
+// APIKeyList defines model for APIKeyList.
+type APIKeyList = []APIKey
+
 // BackupStorage Backup storage information
 type BackupStorage struct {
 	// AllowedNamespaces List of namespaces allowed to use this backup storage
@@ -120,6 +165,25 @@ type BackupStorageType string
 // BackupStoragesList defines model for BackupStoragesList.
 type BackupStoragesList = []BackupStorage
 
+// ChangePasswordParams defines model for ChangePasswordParams.
+type ChangePasswordParams struct {
+	// MfaCode A code from the authenticator app, or one of the recovery codes. Required if MFA is enabled for the user
+	MfaCode *string `json:"mfaCode,omitempty"`
+
+	NewPassword string `json:"newPassword"`
+	Password    string `json:"password"`
+	Username    string `json:"username"`
+}
+
+// CreateAPIKeyParams defines model for CreateAPIKeyParams.
+type CreateAPIKeyParams struct {
+	// Description A user defined description of the API key
+	Description *string `json:"description,omitempty"`
+
+	// ExpiresIn Number of seconds after which the API key expires. The key never expires if not set.
+	ExpiresIn *int64 `json:"expiresIn,omitempty"`
+}
+
 // CreateBackupStorageParams Backup storage parameters
 type CreateBackupStorageParams struct {
 	AccessKey string `json:"accessKey"`
@@ -747,10 +811,69 @@ type DatabaseEngineList struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
-// Error Error response
+// Error Error response in the problem details format of RFC 7807, served as `application/problem+json`.
+// Clients should branch on `code`, which is stable, rather than on `detail`, which is meant for humans.
 type Error struct {
+	// Code Stable, machine-readable code of the problem:
+	//   * `bad_request` - the request is malformed or not allowed in the current state
+	//   * `validation_failed` - a field of the request is invalid, see `field`
+	//   * `unauthorized` - the request is not authenticated
+	//   * `invalid_credentials` - the username, the password or the MFA code is wrong
+	//   * `password_expired` - the password must be changed before logging in
+	//   * `account_locked` - the account is temporarily locked after too many failed logins
+	//   * `account_disabled` - the account is disabled or lacks the required capabilities
+	//   * `forbidden` - the user is not allowed to perform the operation
+	//   * `not_found` - the resource does not exist
+	//   * `already_exists` - a resource with the same name already exists
+	//   * `conflict` - the resource was changed concurrently or is in use
+	//   * `invalid` - Kubernetes rejected the resource, see `field`
+	//   * `too_many_requests` - the request was rate limited
+	//   * `unavailable` - a dependency, e.g. the Kubernetes API, is unavailable
+	//   * `internal_error` - an unexpected error occurred
+	Code *ErrorCode `json:"code,omitempty"`
+
+	// Detail Human-readable explanation of the problem
+	Detail *string `json:"detail,omitempty"`
+
+	// Field Path of the request field that caused the problem, if any
+	Field *string `json:"field,omitempty"`
+
+	// Instance Path of the request that caused the problem
+	Instance *string `json:"instance,omitempty"`
+
+	// Message Same as `detail`. Deprecated, use `detail` instead
 	Message *string `json:"message,omitempty"`
-}
+
+	// Reason Reason reported by Kubernetes, if the problem comes from the Kubernetes API
+	Reason *string `json:"reason,omitempty"`
+
+	// Status HTTP status code of the response
+	Status *int `json:"status,omitempty"`
+
+	// Title Short summary of the problem, i.e. the reason phrase of the HTTP status
+	Title *string `json:"title,omitempty"`
+
+	// Type URI reference identifying the problem type. Always `about:blank`, the problem is identified by `code`
+	Type *string `json:"type,omitempty"`
+}
+
+// ErrorCode Stable, machine-readable code of the problem:
+//   - `bad_request` - the request is malformed or not allowed in the current state
+//   - `validation_failed` - a field of the request is invalid, see `field`
+//   - `unauthorized` - the request is not authenticated
+//   - `invalid_credentials` - the username, the password or the MFA code is wrong
+//   - `password_expired` - the password must be changed before logging in
+//   - `account_locked` - the account is temporarily locked after too many failed logins
+//   - `account_disabled` - the account is disabled or lacks the required capabilities
+//   - `forbidden` - the user is not allowed to perform the operation
+//   - `not_found` - the resource does not exist
+//   - `already_exists` - a resource with the same name already exists
+//   - `conflict` - the resource was changed concurrently or is in use
+//   - `invalid` - Kubernetes rejected the resource, see `field`
+//   - `too_many_requests` - the request was rate limited
+//   - `unavailable` - a dependency, e.g. the Kubernetes API, is unavailable
+//   - `internal_error` - an unexpected error occurred
+type ErrorCode string
 
 // KubernetesClusterInfo kubernetes cluster info
 type KubernetesClusterInfo struct {
@@ -764,6 +887,31 @@ type KubernetesClusterResources struct {
 	Capacity  ResourcesCapacity  `json:"capacity"`
 }
 
+// RBACSettings RBAC settings
+type RBACSettings struct {
+	// Enabled RBAC is enforced if true
+	Enabled bool `json:"enabled"`
+
+	// Policy The RBAC policy in CSV format
+	Policy string `json:"policy"`
+
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+
+	// UpdatedBy The user that made the last update
+	UpdatedBy *string `json:"updatedBy,omitempty"`
+
+	// Version Incremented every time the RBAC settings are updated
+	Version int `json:"version"`
+}
+
+// RBACSettingsList defines model for RBACSettingsList.
+type RBACSettingsList = []RBACSettings
+
+// RefreshSessionParams defines model for RefreshSessionParams.
+type RefreshSessionParams struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
 // ResourcesAvailable defines model for .
 type ResourcesAvailable struct {
 	CpuMillis   *uint64 `json:"cpuMillis,omitempty"`
@@ -778,6 +926,12 @@ type ResourcesCapacity struct {
 	MemoryBytes *uint64 `json:"memoryBytes,omitempty"`
 }
 
+// ListSortBy Field to sort the items of a list by.
+type ListSortBy string
+
+// ListSortOrder Order to sort the items of a list in.
+type ListSortOrder string
+
 // MonitoringInstance Monitoring instance information
 type MonitoringInstance = MonitoringInstanceBaseWithName
 
@@ -902,6 +1056,18 @@ type UpdateBackupStorageParams struct {
 	VerifyTLS      *bool   `json:"verifyTLS,omitempty"`
 }
 
+// UpdateRBACSettingsParams RBAC settings parameters
+type UpdateRBACSettingsParams struct {
+	// Enabled RBAC is enforced if true
+	Enabled bool `json:"enabled"`
+
+	// Policy The RBAC policy in CSV format
+	Policy string `json:"policy"`
+
+	// Version The version of the RBAC settings that is being updated. Used for detecting concurrent updates.
+	Version *int `json:"version,omitempty"`
+}
+
 // Upgrade defines model for Upgrade.
 type Upgrade struct {
 	// CurrentVersion The current operator version
@@ -939,6 +1105,12 @@ type UpgradeTaskPendingTask string
 
 // UserCredentials defines model for UserCredentials.
 type UserCredentials struct {
+	// MfaChallenge The challenge returned by a previous request when MFA is required
+	MfaChallenge *string `json:"mfaChallenge,omitempty"`
+
+	// MfaCode A code from the authenticator app, or one of the recovery codes
+	MfaCode *string `json:"mfaCode,omitempty"`
+
 	Password *string `json:"password,omitempty"`
 	Username *string `json:"username,omitempty"`
 }
@@ -1037,18 +1209,101 @@ type IoK8sApimachineryPkgApisMetaV1StatusV2 struct {
 	Status *string `json:"status,omitempty"`
 }
 
+// ListBackupStoragesParams defines parameters for ListBackupStorages.
+type ListBackupStoragesParams struct {
+	// Limit Maximum number of items to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+	// Continue Token returned with the previous page to get the next one
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+	// LabelSelector Only list the items with the matching labels, in the Kubernetes label selector format, e.g. env=prod,team!=qa
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+	// SortBy Field to sort the items by, cannot be combined with `limit` or `continue`
+	SortBy *ListSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+	// SortOrder Order to sort the items in, ascending by default. Cannot be combined with `limit` or `continue`
+	SortOrder *ListSortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+}
+
 // DeleteDatabaseClusterBackupParams defines parameters for DeleteDatabaseClusterBackup.
 type DeleteDatabaseClusterBackupParams struct {
 	// CleanupBackupStorage If set, remove the backed up data from storage
 	CleanupBackupStorage *bool `form:"cleanupBackupStorage,omitempty" json:"cleanupBackupStorage,omitempty"`
 }
 
+// ListDatabaseClustersParams defines parameters for ListDatabaseClusters.
+type ListDatabaseClustersParams struct {
+	// Limit Maximum number of items to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+	// Continue Token returned with the previous page to get the next one
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+	// LabelSelector Only list the items with the matching labels, in the Kubernetes label selector format, e.g. env=prod,team!=qa
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+	// SortBy Field to sort the items by, cannot be combined with `limit` or `continue`
+	SortBy *ListSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+	// SortOrder Order to sort the items in, ascending by default. Cannot be combined with `limit` or `continue`
+	SortOrder *ListSortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+	// EngineType Only list the database clusters with the given engine type, e.g. pxc, psmdb or postgresql
+	EngineType *string `form:"engineType,omitempty" json:"engineType,omitempty"`
+	// Status Only list the database clusters in the given status, e.g. ready
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListDatabaseClusterBackupsParams defines parameters for ListDatabaseClusterBackups.
+type ListDatabaseClusterBackupsParams struct {
+	// Limit Maximum number of items to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+	// Continue Token returned with the previous page to get the next one
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+	// LabelSelector Only list the items with the matching labels, in the Kubernetes label selector format, e.g. env=prod,team!=qa
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+	// SortBy Field to sort the items by, cannot be combined with `limit` or `continue`
+	SortBy *ListSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+	// SortOrder Order to sort the items in, ascending by default. Cannot be combined with `limit` or `continue`
+	SortOrder *ListSortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+	// Status Only list the backups in the given state, e.g. Succeeded
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+	// BackupStorage Only list the backups stored in the given backup storage
+	BackupStorage *string `form:"backupStorage,omitempty" json:"backupStorage,omitempty"`
+}
+
+// ListDatabaseClusterRestoresParams defines parameters for ListDatabaseClusterRestores.
+type ListDatabaseClusterRestoresParams struct {
+	// Limit Maximum number of items to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+	// Continue Token returned with the previous page to get the next one
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+	// LabelSelector Only list the items with the matching labels, in the Kubernetes label selector format, e.g. env=prod,team!=qa
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+	// SortBy Field to sort the items by, cannot be combined with `limit` or `continue`
+	SortBy *ListSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+	// SortOrder Order to sort the items in, ascending by default. Cannot be combined with `limit` or `continue`
+	SortOrder *ListSortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+	// Status Only list the restores in the given state, e.g. Succeeded
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+}
+
 // DeleteDatabaseClusterParams defines parameters for DeleteDatabaseCluster.
 type DeleteDatabaseClusterParams struct {
 	// CleanupBackupStorage If set, remove the backed up data from storage
 	CleanupBackupStorage *bool `form:"cleanupBackupStorage,omitempty" json:"cleanupBackupStorage,omitempty"`
 }
 
+// ListMonitoringInstancesParams defines parameters for ListMonitoringInstances.
+type ListMonitoringInstancesParams struct {
+	// Limit Maximum number of items to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+	// Continue Token returned with the previous page to get the next one
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+	// LabelSelector Only list the items with the matching labels, in the Kubernetes label selector format, e.g. env=prod,team!=qa
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+	// SortBy Field to sort the items by, cannot be combined with `limit` or `continue`
+	SortBy *ListSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+	// SortOrder Order to sort the items in, ascending by default. Cannot be combined with `limit` or `continue`
+	SortOrder *ListSortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+}
+
+// CreateAPIKeyJSONRequestBody defines body for CreateAPIKey for application/json ContentType.
+type CreateAPIKeyJSONRequestBody = CreateAPIKeyParams
+
 // CreateBackupStorageJSONRequestBody defines body for CreateBackupStorage for application/json ContentType.
 type CreateBackupStorageJSONRequestBody = CreateBackupStorageParams
 
@@ -1085,6 +1340,15 @@ type UpdateMonitoringInstanceJSONRequestBody = MonitoringInstanceUpdateParams
 // CreateSessionJSONRequestBody defines body for CreateSession for application/json ContentType.
 type CreateSessionJSONRequestBody = UserCredentials
 
+// ChangePasswordJSONRequestBody defines body for ChangePassword for application/json ContentType.
+type ChangePasswordJSONRequestBody = ChangePasswordParams
+
+// RefreshSessionJSONRequestBody defines body for RefreshSession for application/json ContentType.
+type RefreshSessionJSONRequestBody = RefreshSessionParams
+
+// UpdateRBACSettingsJSONRequestBody defines body for UpdateRBACSettings for application/json ContentType.
+type UpdateRBACSettingsJSONRequestBody = UpdateRBACSettingsParams
+
 // AsDatabaseClusterSpecEngineResourcesCpu0 returns the union data inside the DatabaseCluster_Spec_Engine_Resources_Cpu as a DatabaseClusterSpecEngineResourcesCpu0
 func (t DatabaseCluster_Spec_Engine_Resources_Cpu) AsDatabaseClusterSpecEngineResourcesCpu0() (DatabaseClusterSpecEngineResourcesCpu0, error) {
 	var body DatabaseClusterSpecEngineResourcesCpu0
@@ -1592,6 +1856,17 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// ListAPIKeys request
+	ListAPIKeys(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateAPIKeyWithBody request with any body
+	CreateAPIKeyWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateAPIKey(ctx context.Context, name string, body CreateAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAPIKey request
+	DeleteAPIKey(ctx context.Context, name string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetKubernetesClusterInfo request
 	GetKubernetesClusterInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1599,7 +1874,7 @@ type ClientInterface interface {
 	ListNamespaces(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ListBackupStorages request
-	ListBackupStorages(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ListBackupStorages(ctx context.Context, namespace string, params *ListBackupStoragesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateBackupStorageWithBody request with any body
 	CreateBackupStorageWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -1645,7 +1920,7 @@ type ClientInterface interface {
 	UpdateDatabaseClusterRestore(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ListDatabaseClusters request
-	ListDatabaseClusters(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ListDatabaseClusters(ctx context.Context, namespace string, params *ListDatabaseClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateDatabaseClusterWithBody request with any body
 	CreateDatabaseClusterWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -1653,10 +1928,10 @@ type ClientInterface interface {
 	CreateDatabaseCluster(ctx context.Context, namespace string, body CreateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ListDatabaseClusterBackups request
-	ListDatabaseClusterBackups(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ListDatabaseClusterBackups(ctx context.Context, namespace string, clusterName string, params *ListDatabaseClusterBackupsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ListDatabaseClusterRestores request
-	ListDatabaseClusterRestores(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ListDatabaseClusterRestores(ctx context.Context, namespace string, clusterName string, params *ListDatabaseClusterRestoresParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteDatabaseCluster request
 	DeleteDatabaseCluster(ctx context.Context, namespace string, name string, params *DeleteDatabaseClusterParams, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -1698,7 +1973,7 @@ type ClientInterface interface {
 	UpdateDatabaseEngine(ctx context.Context, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ListMonitoringInstances request
-	ListMonitoringInstances(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ListMonitoringInstances(ctx context.Context, namespace string, params *ListMonitoringInstancesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateMonitoringInstanceWithBody request with any body
 	CreateMonitoringInstanceWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -1722,18 +1997,90 @@ type ClientInterface interface {
 	// GetKubernetesClusterResources request
 	GetKubernetesClusterResources(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteSession request
+	DeleteSession(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateSessionWithBody request with any body
 	CreateSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	CreateSession(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ChangePasswordWithBody request with any body
+	ChangePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ChangePassword(ctx context.Context, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RefreshSessionWithBody request with any body
+	RefreshSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RefreshSession(ctx context.Context, body RefreshSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetSettings request
 	GetSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetRBACSettings request
+	GetRBACSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateRBACSettingsWithBody request with any body
+	UpdateRBACSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateRBACSettings(ctx context.Context, body UpdateRBACSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListRBACSettingsVersions request
+	ListRBACSettingsVersions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// VersionInfo request
 	VersionInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
+func (c *Client) ListAPIKeys(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAPIKeysRequest(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateAPIKeyWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAPIKeyRequestWithBody(c.Server, name, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateAPIKey(ctx context.Context, name string, body CreateAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAPIKeyRequest(c.Server, name, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAPIKey(ctx context.Context, name string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAPIKeyRequest(c.Server, name, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetKubernetesClusterInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetKubernetesClusterInfoRequest(c.Server)
 	if err != nil {
@@ -1758,8 +2105,8 @@ func (c *Client) ListNamespaces(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListBackupStorages(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListBackupStoragesRequest(c.Server, namespace)
+func (c *Client) ListBackupStorages(ctx context.Context, namespace string, params *ListBackupStoragesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBackupStoragesRequest(c.Server, namespace, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1962,8 +2309,8 @@ func (c *Client) UpdateDatabaseClusterRestore(ctx context.Context, namespace str
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListDatabaseClusters(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListDatabaseClustersRequest(c.Server, namespace)
+func (c *Client) ListDatabaseClusters(ctx context.Context, namespace string, params *ListDatabaseClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDatabaseClustersRequest(c.Server, namespace, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1998,8 +2345,8 @@ func (c *Client) CreateDatabaseCluster(ctx context.Context, namespace string, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListDatabaseClusterBackups(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListDatabaseClusterBackupsRequest(c.Server, namespace, clusterName)
+func (c *Client) ListDatabaseClusterBackups(ctx context.Context, namespace string, clusterName string, params *ListDatabaseClusterBackupsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDatabaseClusterBackupsRequest(c.Server, namespace, clusterName, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2010,8 +2357,8 @@ func (c *Client) ListDatabaseClusterBackups(ctx context.Context, namespace strin
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListDatabaseClusterRestores(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListDatabaseClusterRestoresRequest(c.Server, namespace, clusterName)
+func (c *Client) ListDatabaseClusterRestores(ctx context.Context, namespace string, clusterName string, params *ListDatabaseClusterRestoresParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDatabaseClusterRestoresRequest(c.Server, namespace, clusterName, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2190,8 +2537,8 @@ func (c *Client) UpdateDatabaseEngine(ctx context.Context, namespace string, nam
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListMonitoringInstances(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListMonitoringInstancesRequest(c.Server, namespace)
+func (c *Client) ListMonitoringInstances(ctx context.Context, namespace string, params *ListMonitoringInstancesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListMonitoringInstancesRequest(c.Server, namespace, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2298,6 +2645,18 @@ func (c *Client) GetKubernetesClusterResources(ctx context.Context, reqEditors .
 	return c.Client.Do(req)
 }
 
+func (c *Client) DeleteSession(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSessionRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) CreateSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewCreateSessionRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -2322,8 +2681,8 @@ func (c *Client) CreateSession(ctx context.Context, body CreateSessionJSONReques
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetSettingsRequest(c.Server)
+func (c *Client) ChangePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewChangePasswordRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2334,8 +2693,8 @@ func (c *Client) GetSettings(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) VersionInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewVersionInfoRequest(c.Server)
+func (c *Client) ChangePassword(ctx context.Context, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewChangePasswordRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2346,67 +2705,109 @@ func (c *Client) VersionInfo(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-// NewGetKubernetesClusterInfoRequest generates requests for GetKubernetesClusterInfo
-func NewGetKubernetesClusterInfoRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) RefreshSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshSessionRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/cluster-info")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RefreshSession(ctx context.Context, body RefreshSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshSessionRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSettingsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewListNamespacesRequest generates requests for ListNamespaces
-func NewListNamespacesRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetRBACSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRBACSettingsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/namespaces")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) UpdateRBACSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRBACSettingsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateRBACSettings(ctx context.Context, body UpdateRBACSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRBACSettingsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) ListRBACSettingsVersions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRBACSettingsVersionsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) VersionInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVersionInfoRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewListBackupStoragesRequest generates requests for ListBackupStorages
-func NewListBackupStoragesRequest(server string, namespace string) (*http.Request, error) {
+// NewListAPIKeysRequest generates requests for ListAPIKeys
+func NewListAPIKeysRequest(server string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -2416,7 +2817,7 @@ func NewListBackupStoragesRequest(server string, namespace string) (*http.Reques
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages", pathParam0)
+	operationPath := fmt.Sprintf("/accounts/%s/api-keys", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2434,24 +2835,24 @@ func NewListBackupStoragesRequest(server string, namespace string) (*http.Reques
 	return req, nil
 }
 
-// NewCreateBackupStorageRequest calls the generic CreateBackupStorage builder with application/json body
-func NewCreateBackupStorageRequest(server string, namespace string, body CreateBackupStorageJSONRequestBody) (*http.Request, error) {
+// NewCreateAPIKeyRequest calls the generic CreateAPIKey builder with application/json body
+func NewCreateAPIKeyRequest(server string, name string, body CreateAPIKeyJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateBackupStorageRequestWithBody(server, namespace, "application/json", bodyReader)
+	return NewCreateAPIKeyRequestWithBody(server, name, "application/json", bodyReader)
 }
 
-// NewCreateBackupStorageRequestWithBody generates requests for CreateBackupStorage with any type of body
-func NewCreateBackupStorageRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateAPIKeyRequestWithBody generates requests for CreateAPIKey with any type of body
+func NewCreateAPIKeyRequestWithBody(server string, name string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -2461,7 +2862,7 @@ func NewCreateBackupStorageRequestWithBody(server string, namespace string, cont
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages", pathParam0)
+	operationPath := fmt.Sprintf("/accounts/%s/api-keys", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2481,20 +2882,20 @@ func NewCreateBackupStorageRequestWithBody(server string, namespace string, cont
 	return req, nil
 }
 
-// NewDeleteBackupStorageRequest generates requests for DeleteBackupStorage
-func NewDeleteBackupStorageRequest(server string, namespace string, name string) (*http.Request, error) {
+// NewDeleteAPIKeyRequest generates requests for DeleteAPIKey
+func NewDeleteAPIKeyRequest(server string, name string, id string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
@@ -2504,7 +2905,7 @@ func NewDeleteBackupStorageRequest(server string, namespace string, name string)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/accounts/%s/api-keys/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2522,30 +2923,43 @@ func NewDeleteBackupStorageRequest(server string, namespace string, name string)
 	return req, nil
 }
 
-// NewGetBackupStorageRequest generates requests for GetBackupStorage
-func NewGetBackupStorageRequest(server string, namespace string, name string) (*http.Request, error) {
+// NewGetKubernetesClusterInfoRequest generates requests for GetKubernetesClusterInfo
+func NewGetKubernetesClusterInfoRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	operationPath := fmt.Sprintf("/cluster-info")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	return req, nil
+}
+
+// NewListNamespacesRequest generates requests for ListNamespaces
+func NewListNamespacesRequest(server string) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/namespaces")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2563,19 +2977,258 @@ func NewGetBackupStorageRequest(server string, namespace string, name string) (*
 	return req, nil
 }
 
-// NewUpdateBackupStorageRequest calls the generic UpdateBackupStorage builder with application/json body
-func NewUpdateBackupStorageRequest(server string, namespace string, name string, body UpdateBackupStorageJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateBackupStorageRequestWithBody(server, namespace, name, "application/json", bodyReader)
-}
-
-// NewUpdateBackupStorageRequestWithBody generates requests for UpdateBackupStorage with any type of body
-func NewUpdateBackupStorageRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+// NewListBackupStoragesRequest generates requests for ListBackupStorages
+func NewListBackupStoragesRequest(server string, namespace string, params *ListBackupStoragesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.Continue != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "continue", runtime.ParamLocationQuery, *params.Continue); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.LabelSelector != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelSelector", runtime.ParamLocationQuery, *params.LabelSelector); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.SortBy != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortBy", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.SortOrder != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortOrder", runtime.ParamLocationQuery, *params.SortOrder); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateBackupStorageRequest calls the generic CreateBackupStorage builder with application/json body
+func NewCreateBackupStorageRequest(server string, namespace string, body CreateBackupStorageJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateBackupStorageRequestWithBody(server, namespace, "application/json", bodyReader)
+}
+
+// NewCreateBackupStorageRequestWithBody generates requests for CreateBackupStorage with any type of body
+func NewCreateBackupStorageRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteBackupStorageRequest generates requests for DeleteBackupStorage
+func NewDeleteBackupStorageRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetBackupStorageRequest generates requests for GetBackupStorage
+func NewGetBackupStorageRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateBackupStorageRequest calls the generic UpdateBackupStorage builder with application/json body
+func NewUpdateBackupStorageRequest(server string, namespace string, name string, body UpdateBackupStorageJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateBackupStorageRequestWithBody(server, namespace, name, "application/json", bodyReader)
+}
+
+// NewUpdateBackupStorageRequestWithBody generates requests for UpdateBackupStorage with any type of body
+func NewUpdateBackupStorageRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2950,7 +3603,7 @@ func NewUpdateDatabaseClusterRestoreRequestWithBody(server string, namespace str
 }
 
 // NewListDatabaseClustersRequest generates requests for ListDatabaseClusters
-func NewListDatabaseClustersRequest(server string, namespace string) (*http.Request, error) {
+func NewListDatabaseClustersRequest(server string, namespace string, params *ListDatabaseClustersParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2975,6 +3628,110 @@ func NewListDatabaseClustersRequest(server string, namespace string) (*http.Requ
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.Continue != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "continue", runtime.ParamLocationQuery, *params.Continue); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.LabelSelector != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelSelector", runtime.ParamLocationQuery, *params.LabelSelector); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.SortBy != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortBy", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.SortOrder != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortOrder", runtime.ParamLocationQuery, *params.SortOrder); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.EngineType != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "engineType", runtime.ParamLocationQuery, *params.EngineType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.Status != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -3031,7 +3788,7 @@ func NewCreateDatabaseClusterRequestWithBody(server string, namespace string, co
 }
 
 // NewListDatabaseClusterBackupsRequest generates requests for ListDatabaseClusterBackups
-func NewListDatabaseClusterBackupsRequest(server string, namespace string, clusterName string) (*http.Request, error) {
+func NewListDatabaseClusterBackupsRequest(server string, namespace string, clusterName string, params *ListDatabaseClusterBackupsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3063,6 +3820,110 @@ func NewListDatabaseClusterBackupsRequest(server string, namespace string, clust
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.Continue != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "continue", runtime.ParamLocationQuery, *params.Continue); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.LabelSelector != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelSelector", runtime.ParamLocationQuery, *params.LabelSelector); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.SortBy != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortBy", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.SortOrder != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortOrder", runtime.ParamLocationQuery, *params.SortOrder); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.Status != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.BackupStorage != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "backupStorage", runtime.ParamLocationQuery, *params.BackupStorage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -3072,7 +3933,7 @@ func NewListDatabaseClusterBackupsRequest(server string, namespace string, clust
 }
 
 // NewListDatabaseClusterRestoresRequest generates requests for ListDatabaseClusterRestores
-func NewListDatabaseClusterRestoresRequest(server string, namespace string, clusterName string) (*http.Request, error) {
+func NewListDatabaseClusterRestoresRequest(server string, namespace string, clusterName string, params *ListDatabaseClusterRestoresParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3099,9 +3960,99 @@ func NewListDatabaseClusterRestoresRequest(server string, namespace string, clus
 		operationPath = "." + operationPath
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.Continue != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "continue", runtime.ParamLocationQuery, *params.Continue); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.LabelSelector != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelSelector", runtime.ParamLocationQuery, *params.LabelSelector); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.SortBy != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortBy", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.SortOrder != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortOrder", runtime.ParamLocationQuery, *params.SortOrder); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.Status != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -3602,7 +4553,7 @@ func NewUpdateDatabaseEngineRequestWithBody(server string, namespace string, nam
 }
 
 // NewListMonitoringInstancesRequest generates requests for ListMonitoringInstances
-func NewListMonitoringInstancesRequest(server string, namespace string) (*http.Request, error) {
+func NewListMonitoringInstancesRequest(server string, namespace string, params *ListMonitoringInstancesParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3627,6 +4578,82 @@ func NewListMonitoringInstancesRequest(server string, namespace string) (*http.R
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.Continue != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "continue", runtime.ParamLocationQuery, *params.Continue); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.LabelSelector != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelSelector", runtime.ParamLocationQuery, *params.LabelSelector); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.SortBy != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortBy", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.SortOrder != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortOrder", runtime.ParamLocationQuery, *params.SortOrder); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -3786,19 +4813,220 @@ func NewUpdateMonitoringInstanceRequestWithBody(server string, namespace string,
 		return nil, err
 	}
 
-	var pathParam1 string
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/monitoring-instances/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUserPermissionsRequest generates requests for GetUserPermissions
+func NewGetUserPermissionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/permissions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetKubernetesClusterResourcesRequest generates requests for GetKubernetesClusterResources
+func NewGetKubernetesClusterResourcesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/resources")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteSessionRequest generates requests for DeleteSession
+func NewDeleteSessionRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/session")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateSessionRequest calls the generic CreateSession builder with application/json body
+func NewCreateSessionRequest(server string, body CreateSessionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSessionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateSessionRequestWithBody generates requests for CreateSession with any type of body
+func NewCreateSessionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/session")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewChangePasswordRequest calls the generic ChangePassword builder with application/json body
+func NewChangePasswordRequest(server string, body ChangePasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewChangePasswordRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewChangePasswordRequestWithBody generates requests for ChangePassword with any type of body
+func NewChangePasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/session/password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	return req, nil
+}
+
+// NewRefreshSessionRequest calls the generic RefreshSession builder with application/json body
+func NewRefreshSessionRequest(server string, body RefreshSessionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRefreshSessionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRefreshSessionRequestWithBody generates requests for RefreshSession with any type of body
+func NewRefreshSessionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/monitoring-instances/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/session/refresh")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3808,7 +5036,7 @@ func NewUpdateMonitoringInstanceRequestWithBody(server string, namespace string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -3818,8 +5046,8 @@ func NewUpdateMonitoringInstanceRequestWithBody(server string, namespace string,
 	return req, nil
 }
 
-// NewGetUserPermissionsRequest generates requests for GetUserPermissions
-func NewGetUserPermissionsRequest(server string) (*http.Request, error) {
+// NewGetSettingsRequest generates requests for GetSettings
+func NewGetSettingsRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3827,7 +5055,7 @@ func NewGetUserPermissionsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/permissions")
+	operationPath := fmt.Sprintf("/settings")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3845,8 +5073,8 @@ func NewGetUserPermissionsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetKubernetesClusterResourcesRequest generates requests for GetKubernetesClusterResources
-func NewGetKubernetesClusterResourcesRequest(server string) (*http.Request, error) {
+// NewGetRBACSettingsRequest generates requests for GetRBACSettings
+func NewGetRBACSettingsRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3854,7 +5082,7 @@ func NewGetKubernetesClusterResourcesRequest(server string) (*http.Request, erro
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/resources")
+	operationPath := fmt.Sprintf("/settings/rbac")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3872,19 +5100,19 @@ func NewGetKubernetesClusterResourcesRequest(server string) (*http.Request, erro
 	return req, nil
 }
 
-// NewCreateSessionRequest calls the generic CreateSession builder with application/json body
-func NewCreateSessionRequest(server string, body CreateSessionJSONRequestBody) (*http.Request, error) {
+// NewUpdateRBACSettingsRequest calls the generic UpdateRBACSettings builder with application/json body
+func NewUpdateRBACSettingsRequest(server string, body UpdateRBACSettingsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateSessionRequestWithBody(server, "application/json", bodyReader)
+	return NewUpdateRBACSettingsRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCreateSessionRequestWithBody generates requests for CreateSession with any type of body
-func NewCreateSessionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateRBACSettingsRequestWithBody generates requests for UpdateRBACSettings with any type of body
+func NewUpdateRBACSettingsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3892,7 +5120,7 @@ func NewCreateSessionRequestWithBody(server string, contentType string, body io.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/session")
+	operationPath := fmt.Sprintf("/settings/rbac")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3902,7 +5130,7 @@ func NewCreateSessionRequestWithBody(server string, contentType string, body io.
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -3912,8 +5140,8 @@ func NewCreateSessionRequestWithBody(server string, contentType string, body io.
 	return req, nil
 }
 
-// NewGetSettingsRequest generates requests for GetSettings
-func NewGetSettingsRequest(server string) (*http.Request, error) {
+// NewListRBACSettingsVersionsRequest generates requests for ListRBACSettingsVersions
+func NewListRBACSettingsVersionsRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3921,7 +5149,7 @@ func NewGetSettingsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/settings")
+	operationPath := fmt.Sprintf("/settings/rbac/versions")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4009,6 +5237,17 @@ func WithBaseURL(baseURL string) ClientOption {
 
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
+	// ListAPIKeysWithResponse request
+	ListAPIKeysWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*ListAPIKeysResponse, error)
+
+	// CreateAPIKeyWithBodyWithResponse request with any body
+	CreateAPIKeyWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAPIKeyResponse, error)
+
+	CreateAPIKeyWithResponse(ctx context.Context, name string, body CreateAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAPIKeyResponse, error)
+
+	// DeleteAPIKeyWithResponse request
+	DeleteAPIKeyWithResponse(ctx context.Context, name string, id string, reqEditors ...RequestEditorFn) (*DeleteAPIKeyResponse, error)
+
 	// GetKubernetesClusterInfoWithResponse request
 	GetKubernetesClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterInfoResponse, error)
 
@@ -4016,7 +5255,7 @@ type ClientWithResponsesInterface interface {
 	ListNamespacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListNamespacesResponse, error)
 
 	// ListBackupStoragesWithResponse request
-	ListBackupStoragesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListBackupStoragesResponse, error)
+	ListBackupStoragesWithResponse(ctx context.Context, namespace string, params *ListBackupStoragesParams, reqEditors ...RequestEditorFn) (*ListBackupStoragesResponse, error)
 
 	// CreateBackupStorageWithBodyWithResponse request with any body
 	CreateBackupStorageWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBackupStorageResponse, error)
@@ -4062,7 +5301,7 @@ type ClientWithResponsesInterface interface {
 	UpdateDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterRestoreResponse, error)
 
 	// ListDatabaseClustersWithResponse request
-	ListDatabaseClustersWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListDatabaseClustersResponse, error)
+	ListDatabaseClustersWithResponse(ctx context.Context, namespace string, params *ListDatabaseClustersParams, reqEditors ...RequestEditorFn) (*ListDatabaseClustersResponse, error)
 
 	// CreateDatabaseClusterWithBodyWithResponse request with any body
 	CreateDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterResponse, error)
@@ -4070,10 +5309,10 @@ type ClientWithResponsesInterface interface {
 	CreateDatabaseClusterWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterResponse, error)
 
 	// ListDatabaseClusterBackupsWithResponse request
-	ListDatabaseClusterBackupsWithResponse(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterBackupsResponse, error)
+	ListDatabaseClusterBackupsWithResponse(ctx context.Context, namespace string, clusterName string, params *ListDatabaseClusterBackupsParams, reqEditors ...RequestEditorFn) (*ListDatabaseClusterBackupsResponse, error)
 
 	// ListDatabaseClusterRestoresWithResponse request
-	ListDatabaseClusterRestoresWithResponse(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterRestoresResponse, error)
+	ListDatabaseClusterRestoresWithResponse(ctx context.Context, namespace string, clusterName string, params *ListDatabaseClusterRestoresParams, reqEditors ...RequestEditorFn) (*ListDatabaseClusterRestoresResponse, error)
 
 	// DeleteDatabaseClusterWithResponse request
 	DeleteDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, params *DeleteDatabaseClusterParams, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterResponse, error)
@@ -4115,7 +5354,7 @@ type ClientWithResponsesInterface interface {
 	UpdateDatabaseEngineWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseEngineResponse, error)
 
 	// ListMonitoringInstancesWithResponse request
-	ListMonitoringInstancesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListMonitoringInstancesResponse, error)
+	ListMonitoringInstancesWithResponse(ctx context.Context, namespace string, params *ListMonitoringInstancesParams, reqEditors ...RequestEditorFn) (*ListMonitoringInstancesResponse, error)
 
 	// CreateMonitoringInstanceWithBodyWithResponse request with any body
 	CreateMonitoringInstanceWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMonitoringInstanceResponse, error)
@@ -4139,18 +5378,116 @@ type ClientWithResponsesInterface interface {
 	// GetKubernetesClusterResourcesWithResponse request
 	GetKubernetesClusterResourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterResourcesResponse, error)
 
+	// DeleteSessionWithResponse request
+	DeleteSessionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteSessionResponse, error)
+
 	// CreateSessionWithBodyWithResponse request with any body
 	CreateSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error)
 
 	CreateSessionWithResponse(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error)
 
+	// ChangePasswordWithBodyWithResponse request with any body
+	ChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ChangePasswordResponse, error)
+
+	ChangePasswordWithResponse(ctx context.Context, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ChangePasswordResponse, error)
+
+	// RefreshSessionWithBodyWithResponse request with any body
+	RefreshSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshSessionResponse, error)
+
+	RefreshSessionWithResponse(ctx context.Context, body RefreshSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshSessionResponse, error)
+
 	// GetSettingsWithResponse request
 	GetSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSettingsResponse, error)
 
+	// GetRBACSettingsWithResponse request
+	GetRBACSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRBACSettingsResponse, error)
+
+	// UpdateRBACSettingsWithBodyWithResponse request with any body
+	UpdateRBACSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRBACSettingsResponse, error)
+
+	UpdateRBACSettingsWithResponse(ctx context.Context, body UpdateRBACSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRBACSettingsResponse, error)
+
+	// ListRBACSettingsVersionsWithResponse request
+	ListRBACSettingsVersionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListRBACSettingsVersionsResponse, error)
+
 	// VersionInfoWithResponse request
 	VersionInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VersionInfoResponse, error)
 }
 
+type ListAPIKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *APIKeyList
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAPIKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAPIKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAPIKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *APIKey
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAPIKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAPIKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAPIKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAPIKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAPIKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetKubernetesClusterInfoResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -4883,7 +6220,152 @@ type CreateMonitoringInstanceResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateMonitoringInstanceResponse) Status() string {
+func (r CreateMonitoringInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateMonitoringInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMonitoringInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMonitoringInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMonitoringInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMonitoringInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MonitoringInstance
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMonitoringInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMonitoringInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateMonitoringInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MonitoringInstance
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateMonitoringInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateMonitoringInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserPermissionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserPermissions
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserPermissionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserPermissionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetKubernetesClusterResourcesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KubernetesClusterResources
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetKubernetesClusterResourcesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetKubernetesClusterResourcesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSessionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4891,23 +6373,33 @@ func (r CreateMonitoringInstanceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateMonitoringInstanceResponse) StatusCode() int {
+func (r DeleteSessionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteMonitoringInstanceResponse struct {
+type CreateSessionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON400      *Error
-	JSON404      *Error
-	JSON500      *Error
+	JSON200      *struct {
+		// MfaChallenge A short-lived token that must be sent back along with the MFA code
+		MfaChallenge *string `json:"mfaChallenge,omitempty"`
+		// MfaRequired Set if a second factor is required for completing the login
+		MfaRequired *bool `json:"mfaRequired,omitempty"`
+		// RefreshToken A single-use token for obtaining a new access token using the `/session/refresh` API
+		RefreshToken *string `json:"refreshToken,omitempty"`
+		Token        *string `json:"token,omitempty"`
+	}
+	JSON400 *Error
+	JSON403 *Error
+	JSON429 *Error
+	JSON500 *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteMonitoringInstanceResponse) Status() string {
+func (r CreateSessionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4915,24 +6407,24 @@ func (r DeleteMonitoringInstanceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteMonitoringInstanceResponse) StatusCode() int {
+func (r CreateSessionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetMonitoringInstanceResponse struct {
+type ChangePasswordResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *MonitoringInstance
 	JSON400      *Error
-	JSON404      *Error
+	JSON401      *Error
+	JSON429      *Error
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetMonitoringInstanceResponse) Status() string {
+func (r ChangePasswordResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4940,24 +6432,28 @@ func (r GetMonitoringInstanceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetMonitoringInstanceResponse) StatusCode() int {
+func (r ChangePasswordResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateMonitoringInstanceResponse struct {
+type RefreshSessionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *MonitoringInstance
-	JSON400      *Error
-	JSON404      *Error
-	JSON500      *Error
+	JSON200      *struct {
+		// RefreshToken A single-use token for obtaining a new access token using the `/session/refresh` API
+		RefreshToken *string `json:"refreshToken,omitempty"`
+		Token        *string `json:"token,omitempty"`
+	}
+	JSON400 *Error
+	JSON401 *Error
+	JSON500 *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateMonitoringInstanceResponse) Status() string {
+func (r RefreshSessionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4965,23 +6461,21 @@ func (r UpdateMonitoringInstanceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateMonitoringInstanceResponse) StatusCode() int {
+func (r RefreshSessionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetUserPermissionsResponse struct {
+type GetSettingsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *UserPermissions
-	JSON400      *Error
-	JSON500      *Error
+	JSON200      *Settings
 }
 
 // Status returns HTTPResponse.Status
-func (r GetUserPermissionsResponse) Status() string {
+func (r GetSettingsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4989,23 +6483,23 @@ func (r GetUserPermissionsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetUserPermissionsResponse) StatusCode() int {
+func (r GetSettingsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetKubernetesClusterResourcesResponse struct {
+type GetRBACSettingsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *KubernetesClusterResources
+	JSON200      *RBACSettings
 	JSON400      *Error
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetKubernetesClusterResourcesResponse) Status() string {
+func (r GetRBACSettingsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5013,25 +6507,24 @@ func (r GetKubernetesClusterResourcesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetKubernetesClusterResourcesResponse) StatusCode() int {
+func (r GetRBACSettingsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateSessionResponse struct {
+type UpdateRBACSettingsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *struct {
-		Token *string `json:"token,omitempty"`
-	}
-	JSON400 *Error
-	JSON500 *Error
+	JSON200      *RBACSettings
+	JSON400      *Error
+	JSON409      *Error
+	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateSessionResponse) Status() string {
+func (r UpdateRBACSettingsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5039,21 +6532,23 @@ func (r CreateSessionResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateSessionResponse) StatusCode() int {
+func (r UpdateRBACSettingsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetSettingsResponse struct {
+type ListRBACSettingsVersionsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Settings
+	JSON200      *RBACSettingsList
+	JSON400      *Error
+	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetSettingsResponse) Status() string {
+func (r ListRBACSettingsVersionsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -5061,7 +6556,7 @@ func (r GetSettingsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetSettingsResponse) StatusCode() int {
+func (r ListRBACSettingsVersionsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -5090,6 +6585,41 @@ func (r VersionInfoResponse) StatusCode() int {
 	return 0
 }
 
+// ListAPIKeysWithResponse request returning *ListAPIKeysResponse
+func (c *ClientWithResponses) ListAPIKeysWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*ListAPIKeysResponse, error) {
+	rsp, err := c.ListAPIKeys(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAPIKeysResponse(rsp)
+}
+
+// CreateAPIKeyWithBodyWithResponse request with arbitrary body returning *CreateAPIKeyResponse
+func (c *ClientWithResponses) CreateAPIKeyWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAPIKeyResponse, error) {
+	rsp, err := c.CreateAPIKeyWithBody(ctx, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAPIKeyResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateAPIKeyWithResponse(ctx context.Context, name string, body CreateAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAPIKeyResponse, error) {
+	rsp, err := c.CreateAPIKey(ctx, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAPIKeyResponse(rsp)
+}
+
+// DeleteAPIKeyWithResponse request returning *DeleteAPIKeyResponse
+func (c *ClientWithResponses) DeleteAPIKeyWithResponse(ctx context.Context, name string, id string, reqEditors ...RequestEditorFn) (*DeleteAPIKeyResponse, error) {
+	rsp, err := c.DeleteAPIKey(ctx, name, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAPIKeyResponse(rsp)
+}
+
 // GetKubernetesClusterInfoWithResponse request returning *GetKubernetesClusterInfoResponse
 func (c *ClientWithResponses) GetKubernetesClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterInfoResponse, error) {
 	rsp, err := c.GetKubernetesClusterInfo(ctx, reqEditors...)
@@ -5109,8 +6639,8 @@ func (c *ClientWithResponses) ListNamespacesWithResponse(ctx context.Context, re
 }
 
 // ListBackupStoragesWithResponse request returning *ListBackupStoragesResponse
-func (c *ClientWithResponses) ListBackupStoragesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListBackupStoragesResponse, error) {
-	rsp, err := c.ListBackupStorages(ctx, namespace, reqEditors...)
+func (c *ClientWithResponses) ListBackupStoragesWithResponse(ctx context.Context, namespace string, params *ListBackupStoragesParams, reqEditors ...RequestEditorFn) (*ListBackupStoragesResponse, error) {
+	rsp, err := c.ListBackupStorages(ctx, namespace, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -5257,8 +6787,8 @@ func (c *ClientWithResponses) UpdateDatabaseClusterRestoreWithResponse(ctx conte
 }
 
 // ListDatabaseClustersWithResponse request returning *ListDatabaseClustersResponse
-func (c *ClientWithResponses) ListDatabaseClustersWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListDatabaseClustersResponse, error) {
-	rsp, err := c.ListDatabaseClusters(ctx, namespace, reqEditors...)
+func (c *ClientWithResponses) ListDatabaseClustersWithResponse(ctx context.Context, namespace string, params *ListDatabaseClustersParams, reqEditors ...RequestEditorFn) (*ListDatabaseClustersResponse, error) {
+	rsp, err := c.ListDatabaseClusters(ctx, namespace, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -5283,8 +6813,8 @@ func (c *ClientWithResponses) CreateDatabaseClusterWithResponse(ctx context.Cont
 }
 
 // ListDatabaseClusterBackupsWithResponse request returning *ListDatabaseClusterBackupsResponse
-func (c *ClientWithResponses) ListDatabaseClusterBackupsWithResponse(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterBackupsResponse, error) {
-	rsp, err := c.ListDatabaseClusterBackups(ctx, namespace, clusterName, reqEditors...)
+func (c *ClientWithResponses) ListDatabaseClusterBackupsWithResponse(ctx context.Context, namespace string, clusterName string, params *ListDatabaseClusterBackupsParams, reqEditors ...RequestEditorFn) (*ListDatabaseClusterBackupsResponse, error) {
+	rsp, err := c.ListDatabaseClusterBackups(ctx, namespace, clusterName, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -5292,8 +6822,8 @@ func (c *ClientWithResponses) ListDatabaseClusterBackupsWithResponse(ctx context
 }
 
 // ListDatabaseClusterRestoresWithResponse request returning *ListDatabaseClusterRestoresResponse
-func (c *ClientWithResponses) ListDatabaseClusterRestoresWithResponse(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterRestoresResponse, error) {
-	rsp, err := c.ListDatabaseClusterRestores(ctx, namespace, clusterName, reqEditors...)
+func (c *ClientWithResponses) ListDatabaseClusterRestoresWithResponse(ctx context.Context, namespace string, clusterName string, params *ListDatabaseClusterRestoresParams, reqEditors ...RequestEditorFn) (*ListDatabaseClusterRestoresResponse, error) {
+	rsp, err := c.ListDatabaseClusterRestores(ctx, namespace, clusterName, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -5424,8 +6954,8 @@ func (c *ClientWithResponses) UpdateDatabaseEngineWithResponse(ctx context.Conte
 }
 
 // ListMonitoringInstancesWithResponse request returning *ListMonitoringInstancesResponse
-func (c *ClientWithResponses) ListMonitoringInstancesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListMonitoringInstancesResponse, error) {
-	rsp, err := c.ListMonitoringInstances(ctx, namespace, reqEditors...)
+func (c *ClientWithResponses) ListMonitoringInstancesWithResponse(ctx context.Context, namespace string, params *ListMonitoringInstancesParams, reqEditors ...RequestEditorFn) (*ListMonitoringInstancesResponse, error) {
+	rsp, err := c.ListMonitoringInstances(ctx, namespace, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -5502,6 +7032,15 @@ func (c *ClientWithResponses) GetKubernetesClusterResourcesWithResponse(ctx cont
 	return ParseGetKubernetesClusterResourcesResponse(rsp)
 }
 
+// DeleteSessionWithResponse request returning *DeleteSessionResponse
+func (c *ClientWithResponses) DeleteSessionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteSessionResponse, error) {
+	rsp, err := c.DeleteSession(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSessionResponse(rsp)
+}
+
 // CreateSessionWithBodyWithResponse request with arbitrary body returning *CreateSessionResponse
 func (c *ClientWithResponses) CreateSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error) {
 	rsp, err := c.CreateSessionWithBody(ctx, contentType, body, reqEditors...)
@@ -5519,22 +7058,225 @@ func (c *ClientWithResponses) CreateSessionWithResponse(ctx context.Context, bod
 	return ParseCreateSessionResponse(rsp)
 }
 
-// GetSettingsWithResponse request returning *GetSettingsResponse
-func (c *ClientWithResponses) GetSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSettingsResponse, error) {
-	rsp, err := c.GetSettings(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+// ChangePasswordWithBodyWithResponse request with arbitrary body returning *ChangePasswordResponse
+func (c *ClientWithResponses) ChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ChangePasswordResponse, error) {
+	rsp, err := c.ChangePasswordWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseChangePasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) ChangePasswordWithResponse(ctx context.Context, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ChangePasswordResponse, error) {
+	rsp, err := c.ChangePassword(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseChangePasswordResponse(rsp)
+}
+
+// RefreshSessionWithBodyWithResponse request with arbitrary body returning *RefreshSessionResponse
+func (c *ClientWithResponses) RefreshSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshSessionResponse, error) {
+	rsp, err := c.RefreshSessionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshSessionResponse(rsp)
+}
+
+func (c *ClientWithResponses) RefreshSessionWithResponse(ctx context.Context, body RefreshSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshSessionResponse, error) {
+	rsp, err := c.RefreshSession(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshSessionResponse(rsp)
+}
+
+// GetSettingsWithResponse request returning *GetSettingsResponse
+func (c *ClientWithResponses) GetSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSettingsResponse, error) {
+	rsp, err := c.GetSettings(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSettingsResponse(rsp)
+}
+
+// GetRBACSettingsWithResponse request returning *GetRBACSettingsResponse
+func (c *ClientWithResponses) GetRBACSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRBACSettingsResponse, error) {
+	rsp, err := c.GetRBACSettings(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRBACSettingsResponse(rsp)
+}
+
+// UpdateRBACSettingsWithBodyWithResponse request with arbitrary body returning *UpdateRBACSettingsResponse
+func (c *ClientWithResponses) UpdateRBACSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRBACSettingsResponse, error) {
+	rsp, err := c.UpdateRBACSettingsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRBACSettingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateRBACSettingsWithResponse(ctx context.Context, body UpdateRBACSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRBACSettingsResponse, error) {
+	rsp, err := c.UpdateRBACSettings(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRBACSettingsResponse(rsp)
+}
+
+// ListRBACSettingsVersionsWithResponse request returning *ListRBACSettingsVersionsResponse
+func (c *ClientWithResponses) ListRBACSettingsVersionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListRBACSettingsVersionsResponse, error) {
+	rsp, err := c.ListRBACSettingsVersions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRBACSettingsVersionsResponse(rsp)
+}
+
+// VersionInfoWithResponse request returning *VersionInfoResponse
+func (c *ClientWithResponses) VersionInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VersionInfoResponse, error) {
+	rsp, err := c.VersionInfo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVersionInfoResponse(rsp)
+}
+
+// ParseListAPIKeysResponse parses an HTTP response from a ListAPIKeysWithResponse call
+func ParseListAPIKeysResponse(rsp *http.Response) (*ListAPIKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAPIKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest APIKeyList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateAPIKeyResponse parses an HTTP response from a CreateAPIKeyWithResponse call
+func ParseCreateAPIKeyResponse(rsp *http.Response) (*CreateAPIKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateAPIKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest APIKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAPIKeyResponse parses an HTTP response from a DeleteAPIKeyWithResponse call
+func ParseDeleteAPIKeyResponse(rsp *http.Response) (*DeleteAPIKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAPIKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetSettingsResponse(rsp)
-}
 
-// VersionInfoWithResponse request returning *VersionInfoResponse
-func (c *ClientWithResponses) VersionInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VersionInfoResponse, error) {
-	rsp, err := c.VersionInfo(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseVersionInfoResponse(rsp)
+	return response, nil
 }
 
 // ParseGetKubernetesClusterInfoResponse parses an HTTP response from a GetKubernetesClusterInfoWithResponse call
@@ -7011,6 +8753,39 @@ func ParseGetKubernetesClusterResourcesResponse(rsp *http.Response) (*GetKuberne
 	return response, nil
 }
 
+// ParseDeleteSessionResponse parses an HTTP response from a DeleteSessionWithResponse call
+func ParseDeleteSessionResponse(rsp *http.Response) (*DeleteSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseCreateSessionResponse parses an HTTP response from a CreateSessionWithResponse call
 func ParseCreateSessionResponse(rsp *http.Response) (*CreateSessionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -7027,7 +8802,118 @@ func ParseCreateSessionResponse(rsp *http.Response) (*CreateSessionResponse, err
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Token *string `json:"token,omitempty"`
+			// MfaChallenge A short-lived token that must be sent back along with the MFA code
+			MfaChallenge *string `json:"mfaChallenge,omitempty"`
+			// MfaRequired Set if a second factor is required for completing the login
+			MfaRequired *bool `json:"mfaRequired,omitempty"`
+			// RefreshToken A single-use token for obtaining a new access token using the `/session/refresh` API
+			RefreshToken *string `json:"refreshToken,omitempty"`
+			Token        *string `json:"token,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseChangePasswordResponse parses an HTTP response from a ChangePasswordWithResponse call
+func ParseChangePasswordResponse(rsp *http.Response) (*ChangePasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ChangePasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRefreshSessionResponse parses an HTTP response from a RefreshSessionWithResponse call
+func ParseRefreshSessionResponse(rsp *http.Response) (*RefreshSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RefreshSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// RefreshToken A single-use token for obtaining a new access token using the `/session/refresh` API
+			RefreshToken *string `json:"refreshToken,omitempty"`
+			Token        *string `json:"token,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -7041,6 +8927,13 @@ func ParseCreateSessionResponse(rsp *http.Response) (*CreateSessionResponse, err
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -7078,6 +8971,133 @@ func ParseGetSettingsResponse(rsp *http.Response) (*GetSettingsResponse, error)
 	return response, nil
 }
 
+// ParseGetRBACSettingsResponse parses an HTTP response from a GetRBACSettingsWithResponse call
+func ParseGetRBACSettingsResponse(rsp *http.Response) (*GetRBACSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRBACSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RBACSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateRBACSettingsResponse parses an HTTP response from a UpdateRBACSettingsWithResponse call
+func ParseUpdateRBACSettingsResponse(rsp *http.Response) (*UpdateRBACSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateRBACSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RBACSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListRBACSettingsVersionsResponse parses an HTTP response from a ListRBACSettingsVersionsWithResponse call
+func ParseListRBACSettingsVersionsResponse(rsp *http.Response) (*ListRBACSettingsVersionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRBACSettingsVersionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RBACSettingsList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseVersionInfoResponse parses an HTTP response from a VersionInfoWithResponse call
 func ParseVersionInfoResponse(rsp *http.Response) (*VersionInfoResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -7105,178 +9125,235 @@ func ParseVersionInfoResponse(rsp *http.Response) (*VersionInfoResponse, error)
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
-	"H4sIAAAAAAAC/+x9/XMbuZHov4Jiriq2Q1Kydzd14S95suzb6NaKVZKcq3emXgzOgCSiGWAWwEjmOv7f",
-	"X6EbmE8MNZQoWVozqcTiDAYfjf7uBvrLIJJpJgUTRg8mXwY6WrKUwp+vaXSZZ2dGKrpg9gGNY264FDQ5",
-	"UTJjynCmB5M5TTQbDmKmI8Uz+34wcd8SjR8TLuZSpRReDgdZ5esvA5ok8prFf6cp0xmN8GHMMsUialg8",
-	"mBiVt/p/x7Uhck5E8RVx/RAjSa4ZMUuuyaw2jcFwwA1LYQCzythgMtBGcbEYfB36B1QpurK/Z3l0yYyd",
-	"VbB5bTqB93OpInZCzfLMrBKGS5rTPDEFwNwnMykTRoX9RnQNVqyy/XY4+DxayJF9ONKXPBvJDLdolEku",
-	"DFMIv6/DgWKL4GT794DffRkwkaeDyceB/mEwHNDfcsUGF8P2rHOVBFdzxRSfr87fndWggrvcBArM+9ec",
-	"K4sIHxFCtb1xn5Tjy9m/WGTsODX81RZj7IAFBvyHYvPBZPCHvZIA9hz279VRP4Adh4pRw2rNTqii2PPt",
-	"6SSzfTDDlG6TSRQxrX9hqyBMnwQR1Uc/XzISJTKPi9Vj671ICkO5YIqIyg4/FPHVJ3lgwaBIzOZcMDtT",
-	"OwTMywLOLFmFxcHPN38/w9fI8MjSmExP9vYu8xlTghmmx1zuxTLSdp0Ry4zek1dMXXF2vXct1SUXi9E1",
-	"N8sRIrLeg93Z+0Ms9CihM5aM4MFgOGCfaZolAO9rPYrZVQhUd6d6zSLFTBfiPU6eUBJLdf5reMUbauiM",
-	"anaY5BoW30SERgPCNWz3GTAMu9nwM3atImylycHJ0bhNyhn/B1Pa7UsD4U6O3DuHdDjOFT6zKIgjAvZx",
-	"TZSlcc2EAeFqH1NBcF3jqThjyn5J9FLmSUwiKa6YMkSxSC4E/63oTluCt+Mk1DBtCGCAoAm5oknOhoSK",
-	"eCpSuiKK2Z5JLipdQBs9nopjqVDUTwq0X3AzvvxPwPlIpmkuuFkBgSs+y41Uei9mVyzZ03wxoipacsMi",
-	"kyu2RzM+gukKuy49TuM/KKZlriLA/RYCXXIRt6H5Cxex3SrqKRfmWgLNPrLLPn17dk58/whYhGHZVFfA",
-	"aSHBxZwpbDpXMoVumIiBeuBHlHAmDNH5LOXGbtSvOdPGQno8FYdUCGnIjJE8iy2HHk/FkSCHNGXJIdXs",
-	"/qFpIahHFmxBeKbMUIvNFWotqUVnLLqRRM4yFtVwOGba0izRhhpgn40PxmHV8IPQdM4OpZjzRa6oCZNN",
-	"R0sy5yyJLRMHmcaEzpXdYIp7BMw9ooJEIM8tgZTfapKLOTdA3JmScR5Bj7lm40FIgqCcbM/NyXjHMbw0",
-	"zVjE5zwK68RM0FnCAgj9Fl8gTs8TusBV2YeuZx2cW8ZNgKmdHJ2f+nnVlu6FG2KzFW08ZcA2rphataY7",
-	"q+pBYWn/utnEj1uVpbVG5HrJYK8Y8fP0YAng660gZvsNgivPEknjI8sFr2hyFsL2D80mROTpjCm7Fs0i",
-	"KWJNZsxcM4aKwYyLRC40wa4ru2R57YKpllzzKwqJK8u14zzxal51Xmf+Fa44cTqeR7viw4oaF9wp17CJ",
-	"tv5xDV3GD4QRh6dIuhWuMhVeAUtkQUzbwQ7Q7tx6B/1Vxq6ltLuqamkGWfOhzHhoV0/rDYr+C5Rz+xPh",
-	"ayOJYlaJHoAynFKDiPbDqwDelejUjU0Fl1BSrFlJA4XbWFBuxdArbkVvIUSvGxQbUIiVXWcgzsOCCt8V",
-	"mERBdSNOAbAcfyal0UbRzOoIlAh2TZxW14XsHaO9rrxtUpPTN+xuWTRmoEo8EDGBTISVotwYhxAzo2YZ",
-	"kBvULP0AtoXXHt2y5jxhezFXLDJSrca3QhMYOLixM6cu4GrC4HjzutUoBJA3r/2e+qm3t6INkhtFKUjN",
-	"ERejmtSss8zWJlstMIiqxcw/nB9aLHX4Ap1aZZJYQ9oaPJnBDU2pmZDp4NX+/p9H+y9H+6/OX/402f9x",
-	"sv/T/04HwV32RlxheOFsmv6C81VWTMZ+YsHoVzcG0kYb0H2MtkTADPza2tavgY1mYsEFC7Fs+9zPw1tc",
-	"BJvfoFfhFrT7RJ3R9+m6au5XC2yR6jTjDk+9Fcfryq8z5DwGWomGrhZr86NAy0XMVLKyfMfOnRqprHUw",
-	"J7lwq2PxkLArZjF15JuQa54kzmnDiLZY7seiOIVKZ/a/f39//nZCPljzA80gromD1opkEqxAbWiSoEZo",
-	"bZ6EUVCjKRAJVcYvIyo1+IBsyxIe0aBQwzdtaeZ2oPg0IMVSLnhq8e1lSKKVtmJgVPeKUKdeFoZfwsFU",
-	"s0yR0WjZmAZugjXbNDPD1le2N/uSp5nUIOAauJflYNOI1fv5YPLxS3vWLb/IRZMCD08+eGDZP4spOG6a",
-	"ghcfmKe13weTwf97Np3+6d+j53999uzj/ugvF396Np2O4a8Xz//6/N/Frz89f/7s2cdfjn8+P3l7wZ//",
-	"+6PI00v89e9nH9nbi/79PH/+1/8A91Lp8hpZfijVyK3Le5ZSlkq1ujNQjqEbDxfs9GmDJsQOdRmHaaho",
-	"3gFZY15eK14vdKKE6gCJHNrHvsOiJ3jouJV3eGWWxWirm5IrmeQpNONBuan5b+zOe33GfytWajssDNXO",
-	"eTyVDa8qRACqbnX4yxq57LYfGpYSOfscWVBIbRaK6V8T+0On8Szso9VMnYHTVIe1qw/1BkFjB14T58r3",
-	"bjZwt+CroNPpqkucNoSpW6RvfpN+WUYuOv2/qRTcSNyR5uDHxbuCx5RP1tNX2RA1jDA8jwOtmkClpNkX",
-	"OTztkLc9RJ+3e+pCzLm9PHGXI45DnIOnYdbBUw1uh3IBGjVFN/iwCKdwAfra2L/Cj4dTAVa+FahgpMxW",
-	"qJ0UgSGnwZzbh9ZyF4Qm2ZI6Zx8Vsef6zmXk8G8q3qwETXnk4XCQeKcBmTNqcsXIghpW7R67tOOkaW6s",
-	"vTkmRwZ8hlIkKzKzuI4+wmJ6YEp1eFdOq0slis2ZYsLuiBQWr40VZIKcyPjMAqbWWrd3YY0HIs21ISk1",
-	"0bKGR7VhMhmPAxtA5NxuAbPTKLxwVVjYXQEwpPQS3DDUlJhEryhPLKCmggvNY0ZoZeduJFZY0o2ugAZP",
-	"teg2Smk2umQrXe2l3cp1k9LMdoq6W3fQdmNx9URUr2YgGDRYfDhz7vqUfrYKNqGpzAVo+pFMs9yU+nIR",
-	"Lg5HK9aFPGtscy+lgi7YqOh3VJLS3iCACj6W8r3v26mPKTV2Dk2jtTvnSQ6NmqIjrolMuXGehCrlDgkH",
-	"xyvNEwhiEYc0fI70zzVhn62dxE2yIqWhOhXSLJm65hocF1RYAykBfRw2f+SFAYTmxuVUIgyRsc8RY7Eb",
-	"7WERrZ+fIqOWHYacZCC8ap5lbWRWNZjDsRolP68C/dnHhYsJftScHWNStU6tTMyssFCcGjYVgQ/QYzBj",
-	"tmHC3Y7bzhf8igmnZI3JwVREMk0x8kUi6rR/zUzpNygkg5GAMUomKHDZZxdIxoi8dxQWXpuoK/TXz1OD",
-	"q7rRUcM+W5M84EqC5/XOsO0Neh13/txTKhYhRevopPreD+BjMUcn3vOr8P2zw6M3p3bvYLTnU2EJxbJW",
-	"D7a5kml9fw2IZa6JkFXdrVvxqE2pEta2s6FxrJjWdqaC1OZCwLFkljI34AQ3KdWXa3yIZepP26fokwrW",
-	"+hUd+O3XQ9CyZqzMRpCKeISqGDeVfou3fZyOt3NNIZZ8a89UbRY7x9TOMfXtHFM3+yQQWRsuiVSKhbQL",
-	"X1IUeE7wOe/EYiZzETHVk5L1kqo4aL2fuTeF18j/rkewycnZ8ZvXI2vTdcgiTP7pkkj4tspXuwcjGhs7",
-	"EdrO9ezPl6oqXjmNjdlSwwYrxr8IxmVuiKV73wKf12EQSuCoqD3QTndsoK5lElUyLfCjuy23tr/VCLXr",
-	"/SKkB9YD0RCqCmZqGGpyfXOyFDSrLVLOAE02ypeKDL9iZ12e4oPq66Z7F5VVUQREn4GDEJwcz+8a/CqW",
-	"0o5+wbA+9kVCoa9wArChPAmBFV9YlnPFY6bJPE8SgpvgR80zbRSjabFUalX0LKFcEMM+m+CIS6lN2Nvy",
-	"N/fGL9a3rOQv+YGcPqOsCA+nMaVM6+DeHeMLNLOMotUjFYTOrH4WtCsqcWupTMCqkMqUcWtl+sy6R0aJ",
-	"YjRehdgXjVdtnQpak0yGKTnUu7VImIhZXOBaaLB2Kz92pYfOkCyqVV7bts8FYzHYMGXeJlo0XBe9zNjc",
-	"Wqh5tlA09o7vVhy30qk1EBOEgMtKDExuvC6i0h0iMdLQpKq89gZxF99yjKpgHlXC6kS+foZ0g7297kin",
-	"DDbrl4/tMl2+bVY22WJSNrkhJ5v8zlOyybYyskk7IZvU8rHJU0/HdslfmyZl42fjx5STVmSA3ZD7VR1S",
-	"Kr7glnaanieYzO1S1OrzuIPy52GwuQrYtTuRTLOEmZCWfuhfFTKCo66Cacr/kjNyTTUpehhX5YWlDMhq",
-	"C2uEjIaHxBfVAbWhadZSyBDKf9SYju/EXr/BY6YNFx2nA96UL/0kQC9s5y4GEW5Bs8Am/kwzTXhsaXjO",
-	"C3NHMfC32E9IzCzBo1pdpLEnchFO0UcufwrZh9YAOech7H4XaFX4F1FOwIaCT95pbgVVwQRcfmNvyALu",
-	"hRWBYmSPlsVxRmp6EBXA9eL2uoE/0tmDuOCoI4aKnRRBAKH7v+6eRTck1yCKWvyiwpl2+sO96g+FI7vX",
-	"kd2w9hhwTO/UkgdRS3pQ8aHfxUMfhbP9hDMcAmkuzsJsc1KXnFo9oly3bJQTU0Fel4eONm+ymoCsKPGV",
-	"KJaAMASwVZC85XJ0ccnbEkAAuAFi6A3eWn7RtqFbOpFvAnv12DLOvXMbQstttlUM5DdN2ltWesJJMXZr",
-	"jwSDM4Ef8FRzeSDbZ9pN9vZyzdQEc97+z8v9/XHlf5Offqxa39VTF1pfSxXXO1VSmkFHvp7fx5ta98Dj",
-	"XlJ1a/J0J0gfuSDdidDHLEJPgoeROg4gNURP48gxVQln2rxx2n7JSV7tv/ph9PLV6IeX569+mPz0l8lP",
-	"f/nf3tZD2HbiIuaRJaa61ZRxo8BAathPdG78/rtzWtZENfSSiTWmVP2AWGtm2Giry+2xYafO+rqJwbp2",
-	"/fyazqTbOTZ3js3vz7HpKGVjz6b7bhw6iXm3o8NIjutPxu8OC+8OC+8OC2/tsPBGMYEql6iGASobejMe",
-	"VrjEFkMBnpndIhbQyc9qwYB+WlslDaGvP7gy81p6aTHdBlfcRojYjdnLYq203Y4j2CtdO4XrcRuwXuPe",
-	"2bGP0Y5923HLQ/39DWYQ5t/tzJ+d+fMdmT9IGWD2INjtX3hoq3EpyrjrzmWH+3XWusHJjva1LKD1aUNF",
-	"XB4j1nmWSeUdT5V56TE55YulIUJeE27+qPFIbfY5AhqABNQx+Zu8Zlfu/JkLaGd6SLIFNKJiReCAmbOP",
-	"blbcOs+A36SiOYBvopq97YK/PyNb3YHgkXerQKm8Rh3lCVvPqCADr3kTTikZu4zQdccn20kj0FepKFXz",
-	"T52u1DmDcQEQ8rbxym9p49th+QBPD1hckjLRhKd4UbJZBjRdxQ2PaBIOC8KXf6N6GcRyeHviLNi7BQbX",
-	"XGW0A/cDgLs4QNl5Nni3C/e/C+0Hdim7bXlc2xJq4rPVP0AOe0DWv683qFvP9Zzw4noyTIhn4/LaDs0M",
-	"Cnx3UOiTu9JsnDEVSUHHkUz33GfFNWcjIz8R0OmKdD4nF9tb4O4vO0moOLXmYutIbO09alHFjRxeSa80",
-	"8oqqS3QsFJzWGje5p8PByY1rNj/h3uv2ePhnKs7fv3k/IQdx7HSmXLN5nuDxbT0mpak0JFZlHZKcx3/t",
-	"4axpHB1Maebv2aBGpjy6yaeULWnofLbDrxP7tnm0Dj7pxLKOREarhR6Y/n4wQ9WCmU7z8bz62tuo/iCI",
-	"keR6yd31K8UEnXE48ydEMMG2ByH7HiqTaYORiZiLRYM86+r9BpQcPmJ0M7bv6O4x0d0jwuGmJdllcZWW",
-	"VtiV7GQ6F4SSy//Ua66f3MytjOOudyeXbe7mRvYm8M5f9Ti9x84xufMaPyav8VulZCCeCo8tUDMpNGvf",
-	"wtepeYTG+KXgpy6AcCTmcm1+qI8IWSgGLsqDl+fhBNfiXk+4chPKN9WykT4OFtmrwXCwyH4YXPSvvNQ8",
-	"4F6ZQ2jEiz5gOO2+uCQAiypFdvi+AlnPWX7Mk4RXl4iHV6uJv4PJIOfC/PlHCPxyfXnmzsH2+wLv4Xi9",
-	"Mqz3MH3SkAvwHBTr+zocRDSjETer3+laD/3yWhjnXwwr+x1Cs/LuS1D4BCbF0CRx166sY9Xtb19Tzf6H",
-	"myWE4QMXslRu8nRfNEoTthzFWLLKJcpfBCf8Omi73DzWw5VBTNtz2aiMW7PIV5am7UyN/hXFXBGwlIt3",
-	"TCzMsnpHx8adNSqHNVzo+Mp7G8oKQOfvzvbOzt4R+NpfoDYI1hrrgbI1tLsj+sLNQn2smKdRmi5L01EF",
-	"57az51uoh9ne2Ftwix6ogWdfK7Uht8LZhpt+fnJ83HOFrhLW3dmiHbIl9SznaD2kGXflBSslDTN+CSX7",
-	"toMx4RM1xdM78DKNp9YqM49TLm7dYx/xe3J83Ab3WcaivvwKij5sCSnvFRnRZqkhY3BBm1V3DegcAaFX",
-	"SOJW3zfKy/dHbw4POy6wfItObmLb+GuK1I3X9FsD7yhgdUIvcH+nu9PTNX0TNIS1zpn6cPquo59iNkjb",
-	"68PoxZyq/YY0vDN/y1knKBaJnNGk+zo0yeOoBOe6na0AvjnfSiehWSJJ7Ar6PpWCvqFTv4uuz9eXsO1V",
-	"k7aPYliJlzXoN1eKiTU+WAs5bFN6WTurGwx7uLV9L7cJaiwZEeyzad6LVUzMopILF0At036+Xx/LSajY",
-	"kKR8jEIXw2a2kyY9ueDHQWRaKU3rWIab1znVlyGEz0MxlB79BX0y64BykFn2GzqJDPFSIUcy855CdwU/",
-	"+GUVXyxYOB6CDvKCGdS2qjUHAEALc9cF7W7GwmbOdPCEBG6bH75xUAJfEkP1ZSvrs9KrN0zx1PpwIKQ5",
-	"dX+64+qDYivfNi9rXIu1unpKPHBQv6pYrj2Z3XOwE6ZSroucsM6ysIFrw+tftr3ePc38WxRb7OQlXsJ7",
-	"VhL0kM7zJDmUacrNXcqUZ0ra6YSPe27kTtB3KpjeAFt1WmXvw+qiQxDlEjzwNOMpjZZWdq7G2eXCPtDj",
-	"lBk6vno5tuL+mKHzvK0I2DeVwgDe046BKr0SZskMjyolAaBiyJJesSHhIkpyoDws5EJFTK6o4jLXRX4H",
-	"+gDG5KCMZqR0BR1gWocUwAi+vIeWdjpD4if2NXjju+EiZ6HbM/AN9O8KrricEVdRyECl25QbIkXjbklA",
-	"f6KYyZVgMUaryuPHRSVodyXvkmqSSoVMpky0xHNTGNHhmsiM/pqzIvDlr1gykoAqTCjWPPCRGB8/qwRt",
-	"7BZgzihoZBAqxKKtirMrvH8ThDAkycwrKZ8F3A8RKlieNJLC17+Cvuy0XNwnk1pz+6W/jxdXWr+R2K47",
-	"WlKxYDGRCkFgltTKjzm7JikXuQUXbK5leSxGkPit91FJLATgoY3XiuW6qBNQ7CSC0hcgwFu0Ipp4SDlI",
-	"417OudKmiO4MSS4SpjVZyRzno1jEeAFKIy+ZwEAZFYRBZMhJsY5ySSlWqDoyLD2UuQjEfttt2neY6nym",
-	"7Xbbd4BybvawHRhELy5iB+ry94L57fcLhHv+iy89CnkdOibgabObhLDWLIGjkxouFBWtm1XdzP2kNMnF",
-	"pZDXArAXwWu78VuRsLnBC0qhgS8GEudgmGumOE34b2XBiWKivLwzjjxjHPB/xiJqrQ5usFKBIdEyF5dw",
-	"vW/51rh8MAwxa9foebkedzuAkIiXzTXhQooqFLdaiY+3yiQGZYoKcvVy/PInEkt/sX5lDMR9y/XhjtZc",
-	"V9JJQpjygmnDU6iz+aJWus4SbpLgJTJjcggmdRGQt+MqBoy0q2+8ihZ4hHI/2GcamXHjktc//zhYV4ug",
-	"U36foVMZC7yUV92VbOSPupIOULUXyrB26ya62cp5KSCxILbWccqFu4PQsTekbMeRxuQfwA9AQM0YMS4P",
-	"iBacuNIlnBwADkVykcoYqtCAyeuZC858TE5kluOFGFBgjBG90oalY2JVR7gJ/t6j45EUaPdFq5GrmzKi",
-	"Ih4V7DxaBTPZWDJ/x0VAYfZvMBPhw+m7ZgJCsS+91j8VU/Hm7cnp28OD87dvSBkTRiqDcjZWitMFbRWD",
-	"EeTl+NW+xWBmlfU6u+EajDiBUhNul07lFfOfvfSf9Uws6qUu4cmNQ8tzuu41hpfl5eGp3/16FhzU1uGu",
-	"PzKnPMlVTWmKqLYgsvic5onhWcJQEmExDiYiS71MYeJUQxu28Alb5Qi6gtMUKSTUoPzGgkOwBzDa0FKI",
-	"tT9gh7nR5L/P3v+9yfqOIcUEJBKJJTLLTGoz55/LMjDW9hJMA9UZxHRmdT9rKuCifmNKjriI2WdLsOS/",
-	"ILUN9BCaZYxWdQqJkRSAo+0AyhfYyWsS55AAiIlxlglYcDZgOCbvneoN+PkWPe96MhWETMEqnQ7IqIJs",
-	"xUPHSL2rxYMQPwRh8nH/YtyjB1RJcPJFNT3XxXSw0cXuB2SZp1SMrOkKCl7ldXGxNq2IGADCmJBKeUKn",
-	"hDpCB844wmJMFO5W76zMTHUwy4w4Ktp4UkeO9ReaMkszs6oVK6qRU6Ffb53MXSGAf1696qJ1XyoAc7ac",
-	"ml14pUhJlUhhxwf/18tazy5RkTbSM4zq5wGuUdHwLDWfAvRLoqbkrGpZFQl+11ALsyC6Qr/RzJQqA4hG",
-	"vhBwFwUSD8zaqS9lHUgfLvZXMkA1oaJ3NI+c/kG1zv1NslSsylYe32BzLd+7ogmPh1YHgQIOfpCAjQdU",
-	"HuZuh8gBkKgcQ/LGmNsqqrWMOIisomQEAs0DE3nxmPzdMrIkqb1FbuT3CvtkseM8tZKd6/x7G4uagKNl",
-	"oWToZnsLBXhVAXWT24dA4Czy6lrH/Q/u2lHtmy0MSt4LomXqPZHcwzzm8zlTZfaiM2pYXA7xCxfxt05G",
-	"FJ1hDUibuDN8yLPr0qJBtsPFInHdo43ojyA5v038vINzG7U6mBuowyxFqGzO0bxShLG86JsLovETX6mi",
-	"9BxDaaIitxt9EfGYnNkddeoL5qOi96Saewr8x9BLhsV4wSIwjFCwbMjI+W6lLjoydelV9LmU1ySRAqol",
-	"XlNuilnSS59B2+x+3K+iRc4DyP/h6E1zN8ed21Tsd9dWNfE3nFyTa6ZGi5zHbK+wqZT+Q85DWHlHMbhG",
-	"/vlzFyZXwglsqGJMk6QQHuKPxrdAj5b3Pu2y1u87az2SoZN3Z/ligZzzb+fnJ35vbFtHYtw7aIdkn/Ci",
-	"KmBPGqnUVdqSDKzoYbvU+S2nzt/Boqge0gSHNuu8mamepH9ntCiCFncyQK6Xq8bMoUY7WmfTwX+hHjgd",
-	"uIXewTIhB15TjxKq0P9FBZKfgyKQ3yy3DJOhm1NeMaWslslN11HEddWVahF3joqV1TomZDo4yyFvxNqi",
-	"qrrSe0dHq02Ac8pNvt9ZK82iXHGzgpt7UFS8ZlQxdZDj8WZAHghlwuOyW7uGwVfbBw8ewPgDsV1g4MA+",
-	"moqDJKlSMPHRx4OTI19tk3yyH0nlvB8TgpMh03x//4cIYgfwJ/tElmA4o0JHCZg4LrjABVaLG0G1uKmY",
-	"inOotWXfOaVAzpy3frZy8Q9/ujkyiWtqOZb55JQJ+OGLdtm34IZR3Bp3vIgg6UgxJlwgnxvINzzB09LF",
-	"apEaK8HGyeDleH+87w6RCprxwWTww3h//MpdnQi7suei6SMP7QUzHbkIFp4LP1t/4AMMSu/k8wlAUUKh",
-	"rHAZvnIk6k/swUoKPD+KB5PBz8yEj+CAvxoMaJjwq/19HzZkGLSp5MHt/csxFgeNGzhXeEBAvqb8Beqb",
-	"50lJnRawP25xMnjIKTD4B6E7hv/pIYY/8hqUc3ww13A40HmaUrUaTAaH9aNQhi70YPKxcphocGE/2BO1",
-	"vLb1qIZKjoYQbyu/DSu6A505AgjhlBU6lVS6e8SketZobwyqAfHYrUlUZ+xB+TMTTDkHE2Refx45zlKU",
-	"tfd5wpXv6zDf+1L8/XUPswFHjmR77IdLCUiSRiIhWAVtuNdyKrHScZETOfkYSh+CiXkh2E5WhBvOqVn6",
-	"9PPKQmup6pghWW5bU1xd3CMa1Be9GS7suIknBMhnbSBZhRRcrq2vd3sBRU71OtTFww0aStlfN3oGQf7i",
-	"hQ8nvHgBAYVPnz7Zf77Y/yNkWujC08HEPyyjDlY/0z94UpoOhvUGgKLYypFs0eTr0A9g1axG5xZxfee1",
-	"TstsXHyNv1/W2hRpxtgEf/7z0v6utCoyZN048LPVClNs3QryUcSEUTQZvZwOqqv4WsDtVgCkv+WK3SMM",
-	"of+1YCzylddC0s3wnzSCaN4/cQVrYNpoXwVuE3AtRorHiGpc5bFxUtC7X0usGrQV3hFYtMvJD/CT89YK",
-	"iwQECDD76oTNdX19KCmwEwC3UCfxMFoLc9dIgG51qKno9NeJ8N1XFCwJM2yNiMEGOkBxpT/eRxA/2W4/",
-	"tdWmN9DHxtS+KaFvROPDR6Wp/Rhyje5oaR0tIVJtREs9XQAhNI94C8+97b/gV0zgM0CFAAH8zMwO+x/c",
-	"TtlJqM2p6mdmNiKpjJpouYaoMNSwkfgg70XiMlKKFi4HxOeK+ABGQLMMHHzcUdv2ddnu86X9dFnYEL3J",
-	"Xu803afERxA/Hl7T9YfpRt797wo8weGzTZwpHYWyvdAv0bUq+Dst3XA15w34UpX4Hztv6ChdHeYLXXD+",
-	"5sZu71V0sYJX+y8ffjKH7iyBYxA4j1cPP48DV5NrxxNb1n8HxreY4w1MsZPT3YI73tYh0EW8HaodxFNv",
-	"4Jdo1j1Ofjnc5HC4gwXk3VgeNpe5iF1C8bFzGn/0juKLoipfaOE+Wey+1NGjOdHMDN2hlUIhZTHJMzw5",
-	"CtlGDe3015ypVTmNKGFU5FlT825No7xy4j4NwQ1zCnca3m39Lxtxs54OmHtgKz8zs+Mp98hTLh6zJrYj",
-	"2dK585i0D19y8u7Gma9buxXrzFdY/D7Ms6KeZE/7zIP6sRloa9bxDSy0NbN5WBNtzUR2Nlp/G00VPMGz",
-	"SQ/YDflkwfNuwyi3Zqd5It62ofZYWOdmWpUvkn0nteq0xhefgl61s5G+lY20npvc1kraAlG3zaQdRT9d",
-	"S+kWKtGOcteYSuvJNstNz0D4fVAuBtx2xPsAxPs0TDIXN9+ZZJubZPM82fHCViz/cdlEGx3saU5dtx1F",
-	"jWuF2+d+GtikH4d76GEIeXfg5w4HflrIVyGYonazA/Tmh35aVLkZZgcdoN+J57O3fH1srs5HIlD7SdJk",
-	"dc8ezp1r806uzZu4UX85vpn83vvixT/4NPcqiXq3FesulqU3DgMF5PtrN50nZTrdzWRabytVd+txh4Z3",
-	"2soWtRVPU98iQNziEdWA8a2ZhO8Ebpii7fd3cMIE+Mipn/KOkTwhRuJ2bcdJtslJVEkK38JhsLXg6baD",
-	"pjvWsEtl3YVpH1+Y9ibL6LZx2q3GZ3fM4ylEYndUuZ0Q7I2u014x2O0q/cHI644sH3mM9XbO30cQVN2x",
-	"kq1FML+d6xPdGeUyN7ig1NctLD/uTKTYqqJxWE52x9uegMpR2a8dx9hO/ldUJYFvyznqNXz73m1cftVZ",
-	"fXjLTKMyzx3XeApco9iwHdfYFteo0cCW2Mao2uttOEjGjdqAdZxILsyIi9E5T6FErbxiUCZuLh+IlZzY",
-	"Ce94yBPgIbBTO+5xK+5xA609tN7BxIKLW8Zb3bd3SsZ468b/HnItca27kOM2Qo6swJsWuSCY+1KL72gD",
-	"YtnLs4WiMRtlCRV9KSdjAkrtInClIq4TXb9ltJrLORUHRX3OZDUk3BCaaBmoL+E7d+XrsMw4lEQTrKhY",
-	"nDE1lyplMZkKV6TOymk6t7IIZ4O1+Qsg+7n6uWC546uX45fj/aErkm65V5piXXEjSVEf3a7c6g2t9Y6x",
-	"+gwWLncPbWusyhuzTLEIq2WLsjZ6pdry1cvxq/F+WKP4gN2d2H35PXOU6jp3rORWcthjXoa44rnIe4eu",
-	"+qH4xx7NMiWvaNLjjoyCZQTEcEFoNxx9eAKEfAAQYY+OmO/jktViiQceDQI4fYpDwzaUjLpmkTSRoG8A",
-	"Y8c4NgszIJavA/uDcpIy42nTXAU38+1Y8E7lehrGO/OTfSpWt4PuTtDfzV1X7Ps6i+EWZ7zvTkn1BIPv",
-	"nJjuLzGgm44ed17Ajv63lRbQiwVsR1SnUnAjLWKPuNCGimgzL1v5PSm+t1ozbTkKgv614+Lzo2L076OS",
-	"YWDlO5fbHVxuIUSsUFAJ7s2PNge6Rgs19KYsnQ5Ypskni1WfHH/WzIyn4jXVLCYS7V//fmmtTiudDb9i",
-	"5JKtsERyJMWcL3JXjFswFutaX2d5tCRUDwmfY1cTkqXpp6HtUJBP9m/orPqlNeG4NaBdEebaGN2ns9so",
-	"+/uvlddeM8JifZGR4268+HaHtwPbt2M2tz29HKD8bm7TLaqD4ndDcX3b80Qh5rVhKb3bcQTPDMIwfJhC",
-	"R8ebjP19Vdb7Eed6v8OHOKSQBlMUHuOhnAayCrqO4Ht6ue5EgT8zczfyO/6eyG8nRne0HXa8bSTJNykz",
-	"eCfqRpfATr5+a20f92G9tp/epO1/k9KBOz71++FTzkF430ZHxlTKteZS9PABhtJ7is+LXNxcM4UpPlyT",
-	"KFeKCZOsSCIXCwivgyPlxdvPNM0SNnkxFQda5ykemJ/LJJHXdrWnrw8OSSYTHq2GEKmw3WryiSY88rGL",
-	"mZx9mkwF1PnPhkTJhE1idjUsXZB6SBSj8ZC8aLRoOkyH5MWQvNjrbOZTF2vtZnK2tsliSGC6ZY9uspaF",
-	"WIBC7gFCtbH8JmDduv1qv0wFIdNBpdV0MCEf7VPi/7H/mQ7gu+lgWH1WgqfxwsKq8ejFdIA/L4Y9e2+C",
-	"tt1h/ffeHYbwMN9gDPvPxVR8dZA8EPFNoK+iWX/Az+Ts/mYdTDHTTJ1UyPk+s7waQ+2cSrfL9LKcMqtt",
-	"mefsB7lZMmHcxMg0399/9Wdin0rFf8PlXNge9zyr3+g0Fs1oxM0K0yyvKE/oLAFnNHblVbpf8hlTAtxH",
-	"/mBBGPfKhuUtRm5W94iGa0bdYeTmbs7yrqRi6zw6lpB2WKcZoGyP1ECudV7EVf77f86JkZdMAGe1KgFq",
-	"Nng1jkW5t6jB2E9dGl8RugBySXNtyJK6C3Y+JXLBxSdA6BlPuFl1xzLO3JTvKWFO148cdhgOsIb6sazt",
-	"2geZsms3HL8GWAfNOf8ErZYdvfSmFxblipvVYPLxoko9Hm8/HJF3Fidvxcs1M4aLxQaqOKTTuq881/ZT",
-	"AU0/STDEF+LaZ364e+TRxRi9MWwNkCsT9sD9mQmmaIJHlBCKV0x53tQfiO6jJgxtM8SBEGP5B350hMej",
-	"7g2GbpjNQFgAzX/dDbM6xL8MXjOqmLIIajfgq90CAAF6n3KVDCaDvauXA/vG9dmEsYXfyiwtd1csgWRr",
-	"I5s6ReU+e2enVuRM2+PU3WfzQFqlx9ZZtVv1Wx4Ga3brU6DuMFtSuajTdV8UjLhLt+U9wq5XX750g05f",
-	"N6P3ta6Iv6Gub5elH6LsquLE6NsNrXNU0GJr7LTovA/vbY9aJRCVukFmMjed/LUcsUZcd0A28r6Suu36",
-	"Lh99vfj6/wMAAP//RYvOkHA6AQA=",
+	"H4sIAAAAAAAC/+y9C3MbN5Yw+ldwOVs1iYekZCeZnVHV1lxZdjLaiWOVJM/eu6G/COw+JDHqBjoAWjKT",
+	"8X//CgePfqGppl6WE+7Wbiw2ngfnjXMOfh0lIi8EB67V6ODXUUElzUGDxL8SwTXjJZh/p6ASyQrNBB8d",
+	"jM7FJXAiQZeSQ0qumV4RvQJSSLhiolSkoEsgWpAlaPzA4YMmgsNoPGJmgJ9LkOvReMRpDqODaqbxSCUr",
+	"yKmZUq8L801pyfhy9PHjeJTROWRnkEGiheyu6i3P1iRjyk7JNOSqWlpOdbJifElwEDUmjOPv/yjnIDlo",
+	"UPYLUW58shAyp3pMYLqcEuBX/1VIkY410Pz/+a+fac9Omku8YTssZ7q7jTf0A8vLnPAyn4MkYuG2ooWD",
+	"eN/UOFx9ypxxM9Lo4PnYT8+4hiVInF8JqV+uuwv4lkGWmulMgxos5+sxSSjnQpM5kETkcxZO/wJnvyBC",
+	"kgt/mhc9C3Xz1lf6HxIWo4PRH/YqfNyzX9Xe90zpM9vFr/qtTCGGAObnyMIZHxOqEuCpQYD5mqSwoGWm",
+	"p+TonrZjF7Ttjmyvj7gr+8n0PDw5/gdEjuXw5JhcwpowbjHT/DoeFVIUIDUD1enRwbnxCD4UTII6RLSz",
+	"w4wORinVMNEsN/TX6cLS6EhMqRLSbQa6jG3qfAXEbWxKjjVhqmIrwtCz4AmMyfUKLLkiABRJJFAN6bQ7",
+	"z8fxSMLPJZOQjg5+NKuvrfV9aC7m/4JEm2VZcJsjMatDhLnp/NwJfQyjUSkp/v2SJpdlcaaFpEvkmzRN",
+	"mdkqzU5qB7WgmYJxCxS2L1G288ZjplkmriH9geagCpr4sy8kJAYsowMty874ZouGm/DQi7hxDMmUCohe",
+	"MUXmjWWMxhVIOifa3v28TC5B/4BkEWl+E3IuhEzghOrVmV5nTuognQaAuS5zITKg3PThfZOFXXa/jkcf",
+	"JksxMT9O1CUrJqKwRzQpBOMapIUfYtIyutjhI9h+v46AGz7840h9NRqP6C+lhBoyVqsuZRbdzRVItlif",
+	"f3/WgIo95TZQWhSAEGqcjesSI4YG/qqtiKLRNYYdRyvKl3BClboWMj0xqgYO2kTtfEGPRBrROQ5JIlIg",
+	"Cyly5AS01CvgmiXUCGtaFGPDrQUHg+OmgYREXIFcYz81JacOKIQtyJtvDwlTBDidZ5AaUY9dSoVcvItM",
+	"cO3XHT2eYtNHM6hH05x++B74Uq9GB89f/OUm5hV61mZoLiZ2hkfIGy2P6oNyixTbkDYTGyGJArH20YPW",
+	"cezRuFfCHEfG/SEoNAoSwVNF6EKDJNcrlqzq4xI3yJScO5bP4Qqk/9kcoJHaCrQRAEH6MK7//PUoqur0",
+	"AKmBsxWsbs+0awp0h2cnCSjlJHsHbJ8FR++K7iQTZRp2b1vvGYWJMg6ScBpXBR5SEmzEZTsFrsvjciVv",
+	"8c9XP5zZzxatyErrQh3s7V0GO2HKxF4qEmX2mUCh1Z5hM1cMrveuhbxkfDkxOuTEIpvaw9PZ+0PK1QSt",
+	"gwn+MDKkQvMiQ3hfq0kKVzFQ3V0EKUgk6D7Ee5oCqiKW+vo3CK5XVNM5VXCUlUrHbINWA8IUHvcZSq8g",
+	"AFLXKrGtlOFI0y4pF+yfIFWceZ4cu28O6ew8V/Y3g4J2RsQ+1HYLCQq4pp7BUk7svqYzfgbS9CRqJcos",
+	"JYngVyA1irYlZ7+E4dA6NPNkVIPSBDGA04xc0ayEMaE8nfGcrokEMzIpeW0IbKOmM/5GSKt3HgS0XzI9",
+	"vfwL4nwi8rzkTK+RwCWbl1pItZfCFWR7ii0nVCYrpiHRpYQ9WrAJLpebfalpnv5BghKlTBD3u9YB42kX",
+	"mv9gPDVHRT3l4loroJmfzLZPX5+dEz++BayFYdVU1cBpIMH4AqRtGnQK4ClSD/6RZAy4Jqqc50ybg/q5",
+	"BKUNpKczXhmOZZGiKTLjx5wc0RyyI6rg4aFpIKgmBmxReOagqcHmGrVW1KIKSG4kkbMCkgYOp6BQf1Ka",
+	"amSfrQ7TuJ3yjiu6gCPBF2xZStqjc/S0JAt0RpTKyjTgqpTmgKkOChtJKHcGIUnqfRUp+YJpJG7juykT",
+	"HLFUMB11udJ4ZOVkd21OxjuO4aVpAQlbGO0zZqA5xbI71mv7weL0IqNLuyvzoxtZRddWMB1haifH56d+",
+	"XY2te+FmsZlxolleacSd5c7relBc2r9sN/Hz1mVpo5Ex2vGsgPh1erDE9MbbQMyMGwVXWWSCpsdcg7yi",
+	"2VkM29+1mxDe0U/noK/BeR7mjGdiqYgdWsX1zLpc8zuKiSvDtdMyiyjjozP/ye44czqeR7vQsabGRU/K",
+	"NWyjrf+5gS7TR8KIo1NLujWuMuNeActEIKb7wQ4zv9/vaLjK2LeV7lB1LU1b1nwkipiJNTptNgjjB5Rz",
+	"55PYz9bXSxlv2TdfvYjgXYVO/dgUuIQUfMNOWijcxYLqKMZecQujxRC9aVBsQSFGdp2hOI8LKvstYBJF",
+	"1Y04BcBw/LkQWmlJC6MjUMLhmjitrg/Ze2Z7Wfvapib7I56WQWPrnngkYkKZiDvFn9U0hpgF1avubMa0",
+	"8hOYFl57dNtasAz2UibxEmM9vRWa4MTRg507dcHuJg6OVy87jWIAefXSn6lfevcouiC5UZSi1JwwPmlI",
+	"zSbL7LreqY6jalj5u/Mjg6UOX3BQo0yik8MYPIW2B5pTfUBmoxf7+3+e7D+f7L84f/7Nwf7XB/vf/O9s",
+	"FD1lb8QFw8uupu0vOF8XYTGmiwGj3910NA42oOtsbYmIGdh1qMRcLMCXjEOMZZvf/Tq8xUVs8xv0KnsE",
+	"3TGtzujHdEO1z6sDtkT2mnFHp+4TYU3l1xlyHgOPTr2rxd80znjJU5DZ2vAds3aqhTTWwYKU3O0O0jGB",
+	"K5Cg9MQ3Idcsy5zTBogyWO7nonYJtcHM//7w9vz1AXlnzA9rBjFFHLTWpBBoBSpNs8xqhMbmyYCiGk2R",
+	"SKjUfhtJpcFHZFuRsYRGhZr90pVm7gRC14gU23Q5aSb1tmJkVveJUKde+sYEb+0UMkWgyaq1DHsIznM4",
+	"7vQyo5mPLC+EQgHXwr2iNP+hfP12MTr48dfuqjt+kfdtCjw6eeeBZf4ZluC4aY4X8cg8NUjT4f98MZv9",
+	"6d+TL//2xRc/7k/++v5PX8xmU/zXsy//9uW/w19/+vLLL7748R9vvjs/ef2effnvH3mZX9q//v3Fj/D6",
+	"/fBxvvzyb/+B7qXK5TUx/FDIiduX9yzlkAu5vjNQ3uAwHi520M8bNDF2qKpLwZaKZj+0mJdrfoPQSTKq",
+	"IiRyZH72A4aR8EfHrbzDqwCpmNLANbkSWZljMxaVm4r9Anc+6zP2S9ipGTAYqr3r+FwOvK4QIaj61eFf",
+	"N8hld/zYsJLIxYfEgEIovZSgfs7MHypP53EfrQJ5hk5TFdeu3jUbRI0d/EycK9+72czI7lPU6XTVJ05b",
+	"wtRt0je/Sb+sbi56/b+54EwLeyKdgJrwLfCY6pfN9FU1tBpGHJ5vIq3aQKWkPRY5Ou2RtwNEn7d7mkLM",
+	"ub08cVczTmOcg+Vx1sFyhW6HagPKaopu8nG4TmEc9bWp/2Q7j2ccrXwqnZEyX1vtJFwMOQ3m3PzIFKGc",
+	"0KxYUefsozz1XN+5jBz+zfirNac5SzwcjNcwcX5CoLqUQJZUQ314O6SZJ89LbexNjDNJKLfxJXMgCqyP",
+	"MCxPTfu9K6f1rRIJC5DAzYkIDgS4NoKMkxORGvfptNFadU9hgwciL5W2UWsNPGpMU4h0GjkAIhbmCMAs",
+	"I3jh6rAwp4JgyOklumGorjCJXlGWGUDNOOOKpUBo7eRuJFbc0o2ugBZPNeg2yWkxuYS1qo/SbeWGyWkx",
+	"8jF0Gy5ttxZXn4nq1b4IRg3W/jh37vrcxRHSXJQcNX0TtVHqSl8O18Xx24pNV54NtrmXU06XMAnjTipS",
+	"2htFUMHfpfzez+3UwaF9cozfeHKe5KxREwZiioicaedJqFPumDDtAx9RDXRIwxaW/pky8RUZS5jO1qQy",
+	"VGdc6BXIa6bQcUG5MZAy1Mfx8CdeGODV3LRaigsVhQ8JQOpme1xEG+anKKhhhzEnmfm96VlWWhR1gzl+",
+	"VyPFh0io44n5ObiY8I+Gs2NK6tapkYmFERaSUQ0zHulgPQZzMA0zVgtjWrIr4E7JmpLDGTeXjfbmiyTU",
+	"af8KdOU3CJJBC8QYKTIrcOGDu0i2N/LeURi8Nknf1d8wT43d1Y2OGvhQCBVzJeHvzcFs2xv0Oub8uaeU",
+	"L2OK1vFJ/bufwN/FHJ94z6+03784On51as4OZ/tyxrWwrNWDzfgim+erUSwzRbio6279ikdjSbVrbbMa",
+	"mqYSlDIr5aSxFoKOJb0SpUYnuM6putzgQ6xCf7o+RR9UsNGv6MBvemO0uMFP39EsxiNUzbipjRu+DnE6",
+	"3s41ZbHkU3umGqvYOaZ2jqlP55i62SdhkbXlksgFXwqz8RXF7yMn+Jx3YjkXJU9ADqRktaIyjVrvZ+6L",
+	"X4xv2brBJidnb169nBibrkcW2eCfPolkv9b5av9kRNnGToR2Yz2H86W6ilctY2u21LLBwvzvo/cyN9yl",
+	"e98CWzRhEAvgqKk92E71HKBqRBJV3Nh1utt2G+dbv6F2o7+P6YHNi2i8qnofddtSXaqbg6WwWWOTYo5o",
+	"slW8VKLZFZz1eYoP65/b7l2rrPJwIfoFOgjRyfHlXS+/wla6t184bciyi119xQOANWVZDKz2g2E5VywF",
+	"RRZllhF7CH7WslBaAs3DVqkilBQZZZxo+KCjM66E0nFvy9/dF79Z37IWv+QncvqMNCI8HsaUg1LRs3tj",
+	"P1gzS0taz+8hdG70s6hdUQ1dCBnJFjwRUlf31lIPWfWAiBIJNF3H2BdN112dClsbb5QaOrqxSICnkAZc",
+	"i03WbeXnro3QeyVr1SqvbZvfOUCKNkwVt2ktGqbCKHNYCGk+LyVNveO7c49bG5QZN4qFANV9i5tuulHp",
+	"vyLRQtOsrrwOBnEf33KMKjCPOmH1It8wQ7rF3l72hFNGmw2Lx3aRLp82KpvcY1A2uSEmm/zGQ7LJfUVk",
+	"k25ANmnEY5PPPRzbBX9tG5Rtu02fUkxaiAC7IfarPqWQbMkM7bQ9T7iY24WoNddxB+XPw2B7FbDvdIy/",
+	"NwMd09KP/KcgI5jVVWyY8r/EnFxTRcIIjRS5jQnaLqk6MqX9UJ9QaZoXHYXMQvmPLj/bib1hk6egNOM9",
+	"2QGvqo9+EagXdmMXowi3pEXkEL+jhSIsBa7ZggVzRwL6W0wXkoIheKtWhzB2EwQetX8slz/F6ENjgJyz",
+	"GHZ/H2kV/Iv4zR4o+uSd5haoChfg4hsHQxZxL64IhJk9WoZ0RnOLeiNRIVzf31438PnFA4jLNHVXxXZQ",
+	"ByDr/m+6Z60bkikURR1+UeNMO/3hQfWH4MgelD8e1x4jjumdWvIoaskAKj7yp3jkb+G6mea9BRqChdnl",
+	"pC44tZ6i3LRspBNTUV5Xqni5ncG7iciKCl+JhAyFIYKthuQdl6OFyK0JIALcCDEMBm/9y71Dt3Ii3wT2",
+	"etqyXXvvMcS2224rAeU3zbpHVnnCSZi7c0YcMCfwnc1qrhKyfaTdwd5eqUAe2Ji3//f5/v609n8H33xd",
+	"t77j9SCqQaUQejTeXCDiptYD8HiQVL03eboTpE9ckO5E6FMWoSfRZKSeBKSW6GlSHVCZMVD6FdUtTvJi",
+	"/8VXk+cvJl89P3/x1cE3fz345q//O9h6iNtOjKcsobptNRVMSzSQWvaTrfNSL0ZiTFRNL4FvMKWaCWKd",
+	"ldlG97rdAQd26qyvmxisazfMr+lMup1jc+fY/P05Nh2lbO3ZdP2msUzMu6UOW3LcnBm/SxbeJQvvkoXv",
+	"LVl4qzuBOpeoXwPUDvRmPKxxiXu8CvDM7BZ3Ab38rHEZMExrq4UhDPUH11beCC8Ny21xxfu4InZzDrJY",
+	"a23vxxHsla6dwvW0DVivce/s2Kdox77uqfLQ/H6DGWTj73bmz878+R2ZP5Yy0OyxYDf/sklbraIo074C",
+	"4A73m6x1i8yOblkW1PqUpjyt0ohVWRRCesdTbV2mqDJbrjTh4pow/UdlU2qLDwnSAAagTsnfxTVcufwz",
+	"d6FdqDEpltiI8jXBBDNnH92suPXmgN+kojmAb6Oave6Dv8+RrZ9ANOVdGXIqG9RRZdh6RoUReC3gkkoy",
+	"9hmhm9Inu0EjOFalKNXjT52u1LuCaQAIed365I+01Xdc/WCzBwwuCZEpwnJbKFmvuttKJNMsoVn8WhB7",
+	"/p2qVRTL8esJ1fGvW10MbihltAP3I4A7JFD2QXt3Co9wCt0fzFZ2x/K0jiXWxEerv8MY9oisf9ts0LSe",
+	"mzHhfiwXEA9TV1eDKaJAW4HvEoUuXEmzaQEyEZxOE5HvuW6hzNlEiwuCOl0I53NysXsErn7ZSUb5KSy6",
+	"2zhufLdaVKjI4ZX0WiOvqLpAx6DgdPa4TZ2O8NoEzqu3z3AfVD0e/zPj529fvT0gh2nqdKZSwaLMbPq2",
+	"mpLKVBoTo7KOScnSv43Gg8IyqjViJQ7XgGqRs+Qmn1KxorH8bIdfJ+ZrO7UOu/RiWU8go9TbvTmkqVyC",
+	"7jUfz+ufvY3qE0G0qL1MERbojMO5zxDpe4Solyhri+mC0T5R1SLPpnq/BSXHU4xuxvYd3T0luntCONy2",
+	"JPssrsrSiruSnUxnnFBy+Re1ofzkdm5lO+9md3LV5m5uZG8C7/xVT9N7bM955zV+Ul7j11LGXu7Enw1Q",
+	"C8FVeIqnkGKeQU5csrB/kUcsyOm3R+Q//7L/n2PiHDdUkQusgmMr4+y5rn/6lxL8wjzXgRAOpDKXlCcr",
+	"Irh53TGFi7Hjkgx51DyDMZEUGb1eGYLj5MIuot4yB8pt4bhVmVNuahtFru5SuAlhcfP45llIjI4kKZsp",
+	"JhJoapaHlZoobz71YLfceFcobd6k/FERf15TvC914HAIBnmho296oZjrCQwIYhhx1kpEn7xiX+2o1jY2",
+	"lgLl68YaGyuKkj9XmvIEhi2gZ+rGlHtXz/eqN7P2nNmy56E18aGtWyV4nxmAGjx0mDIlr8LzXWMsveS/",
+	"ELMjoNH8cQlUxXOhze9EgvPEzte1l2vH3gLzFJOIHGrMpmppblgaoDDFLzKW6L7EoZh0//v5+Ym3Eg2C",
+	"V0dg6bc+wdf7+7HsZM109LWClZCGD+Y5lesWYo8Jm8LUTYTAKFayZkLUVtXY4Uua+ppkm6MhGobL6XFN",
+	"o3VJYmsvAjyYTd8pOcyu6drwH6NhH8wzyi8vxo12rJZnhkdnuU5jmbXew3Sxim3EUruQh+U0WTEOFduo",
+	"n5Zb3MGME/KMXMxp+pOjoQsyaZCU4XU0M9wXUiIksgv/zpxj1UkpJYowjcXKcMQrmrEUWdRPC8oySM24",
+	"vsZni2oNhDh2MCwdyAW2unAjldw89igk+8UO0uqJ66leg4TUdXMj/pSELAHle/uQe3dOLlyfOLvEvA6J",
+	"oGKKXEvBl25A3+4n+xBhWEvojzU750ASfOoy9bUEMrFcGtxh3A1Ek0SUXP+UieSyGsb9ambVkBdCUsnM",
+	"i9bYyMfQCkFyc2diQWqGZly1hk2ZMscdG9h/MlvNaHKpAjDNhkhCCzpnGdMM/KALIecsTYHXYRfAXj03",
+	"WIA0KFKzNJjw++VC/7QQJa+dntOfUgF2JFSw/T5sOYWf8Ddl0Sb0CLVGsEQ+ijHX3o7h1504ztaZErNj",
+	"3QEZY9CiboZuQcRDs8MmCpkxakzUKsqQNsaNIa4W4idzWp6yVBt7zVok1a7UV8DckocrIrv7FArgKfBk",
+	"7d4j77L1sVl8rWPYgVX2fwLDL3A0TkoOHwq7BfyZiATBkM54LbKrxhJG41GHnEfjUZ0w8XXsDsHVni71",
+	"VGOf96vhf+0Hj542Xtvi3Wg8CvhjmjaQYzQeJZUIcwsYjUcdyNvVeuBg2zpgooW0KwA7BeqYL8TGTCMf",
+	"W2T08UjJZfx4Hk+VChXisXg7PgTaiGv/cbQsTLLRsvjKLHbodW+7VFJtDbEZY/eqHTCc9pfAi8Cibtv1",
+	"3KJG8ueK8g3LMlbfoi2DUk8hGx2MSvvqq1Gdmbo8cxVVhvWwFd1erjUMnmZIQlsAz2HYn8mupwVNmF7/",
+	"Rvd65LfXwTj/YVw77xia1d7576DVt9as6Lzsj4XV0U8zbwSlOlvClyE49xULomTefI6/62I1P2+cm/H6",
+	"3FQlLpg2OltVLP64ZtjQLHN1CjeZit2+L6mC/2F6Zag3VsEwdCDejGo9LN+JrLBvvLrM0vfRBb+MOvtv",
+	"nuvxHrHPu2vZ6t3j9qu4RZ53D3P4E7zu1dyc8fD69+0Haz2124o5sZ/89Vz1ZOb592d7Z2ffE+ztKw6P",
+	"oo/zdijzBrS7I/piKc4hbv/P4y3nIs8nNZy7nzMP6H77h6C7B3sLbjEANWyxmNpj6vfC2cbbdj9582bg",
+	"Dt3TsXdni2bKjnA3nKPzIy2Ye4+75gAomH1S/34wJp6CHn69Ay9TIJuD0jRnfDS+L7yMaBknb950wW0i",
+	"J4fyK3wl7Z6Q8kGR0Tr5G8gY3ZDyl1yD7kS6/WNCL0jiztg3ysu3x6+Ojnoqvr+27lVi2vi6nvLGd60Y",
+	"cH0ccTrjKDVXv7s8OX4VdR0rVYJ8d/p9zzhhNZa2N8edhjXVx40psqcvD4/OfGngrif35eFRf+Xg3uK8",
+	"2I0pAgYzEkjR5SvL+MO2hchYElGjTfIYDmQbYLX4s3868RgDoLtz2ibkwnV52TM9inB00uc0BXctqbRD",
+	"/K0elTrmiS2/DSm+prj2lbOANIBsHyKyyxr+bHOAYrWCmw57K5qsd4yR1CksJKjVGSgzd8W62pWmsdW5",
+	"uAQejyir763ROrabfrT1VLzMxJxm/QgsWJpUnGATAGo8o73O2iCxVVpu3khwrbH2aBDigmaqY0yEamc4",
+	"BCnMGOAuftqFmRNQysnsDoY+pCUzb6xxKyNmXiaXoOMJled47SzKNOzett4LhYtI331cY6DIMpA/mau5",
+	"M73OoK/C07Kvuy210wdqZ0nFmERlFA2xaSwO1YmwQqENDHsTkjwx3t3LN89rAULOcGru0b8HNAejm4S4",
+	"g3c+h9zW9XBl8f3Vj22mprfgsHEaD7GrLdXAzvfPTbvzawoRT70vDY4HhJj5UW4TYLgCwuGDbsM7LMyQ",
+	"ut2pifaY8WF3fz6uMqN8S5bn4wVVmNYEEnRQ2QUiHmJ1rOGxN25d51RdxhhSGYtnHDBe1Ku9CSiHhdHs",
+	"YlXBMHaZi4kofNSOew7PnISWbLmEeGyiDVYLzLpxVJ01IAA6mLspgPZmLGznL8ew0R2bn74VLWE/Ek3V",
+	"ZScDszaq93nZCnJ4+XLq/ulKx43CUb5uP5ywEWtVvWJbRJnJF/RoRbMM+LJPZPnPRIIuJbfX6ZQUEq6Y",
+	"KFV1sbYCjve4rHoqLRrOsaDxO3R3AxxiKGoXzEIaA2RMhCSC16IfQuWHNB7HVjfJNxaBy+mH4AF48ZfB",
+	"sD0BmTMV0tF6hVNE5jR7dgPuBjpMey6d/NwxPt/LOr3C6Tln9ErNVAE+EnnO9O0dYzimWU680tRWjtl4",
+	"ZPcWrpA62OrLqkYf1zcdgygTGPxHC+biP+R6WlwuzQ9qmoOm06vnU6N9vgFNu3D3X2pvEvoQLasYqDXX",
+	"K9Asqb1GiIEPK3oFY8J4kpXIaOwbspSbKFKJ1OlTS3CtakoOwxAYKGkGsBkljq5+fYstzXLGxC/sY/Sx",
+	"Oc14CbHCnfYLju/eenXBUu4xY423ODnTRPDWsxb2nsdzGhsoW1U+Q2CYDu41oBU19w7S8tQqx9OWbLHB",
+	"pEwRUdCfSwgxt766sxYEnQqE2ucWq3g20Y4XpdrOmFoDIWO2lQQtGVxZ+xd1DrM3sahWUsH9yELFHBK+",
+	"EOmf3saxzLJcRGAhlGKmJ1vUd9p8DGlVi6cQ0oIAwyYpWcA1yRkvDbjwcA0LhNSCxB+9D4i2gUGBryMH",
+	"L1V4ojCcpAWlf/vQBlYlNPOQsp/dDcCCSaVDYNqYlDwDpchalHY9EhJgAZTa2MWW5VPuIiOc0O55qTm3",
+	"j2Mfa8iPRMkjYefdNt3nU1Q5V+a4uXYo51aPx2HjTcMbcEhdPvDKH7/fID4xGHp6FPImXUrwzsIckoW1",
+	"ggyrNikMg2pjf1i5X5QiJb/k4poj9lrwmmH8UWSw0PZtFGzg3yFNSwMvokAymrFfqrcuw0JZVa6efAEM",
+	"8X8OGMRJWAjxSlYlNzcyRFRftUtFC24kbPRltR9XmJALi5ftPdmNMHWXnfhQb5GlPmD46vn0+TckFf5N",
+	"v9ocFvcZ1/Z5mFLVFIgYpjwDpZnxBPPls8ar+YZws8zWr52SVoCzmVcCMtK+se0rOMgjpPsDPtBET1vv",
+	"y/z569GmZxB75feZvZ6ztmRVZb9iI39UtUyEunlURdR3iuDP187fa4gV7VCZM+6eP7CdHKdxHGlK/on8",
+	"wEfmaZeCRAMnrg1pztpyKFLyXKQuXpMml5652JVPyYkoSluLE982B6LWSkNuXr+lKT5C9+CB+cH0TtYT",
+	"92TrhPJ0Eth5Eo3lVpAtvmc8Yh/4LzYJ4t3p9+3ch3Aug/ZvXmB/9frk9PXR4fnrV/VgNaQyfEnXSHG6",
+	"pJ13aDl5Pn2xbzAYqIIWu2EKbVZupeYckVtcge/23HcbmNM0SF2yRSOODM/pe1IJP1bvluX+9JsJePis",
+	"L3PjYRBnKRtKU0IVKIvPeZlpVmRgJZF1YwPHQDmQNmerpQ3H4/TPA+iqQG2fvUK1ld8+YJ4pO9vYUIix",
+	"R/CEmVbkv8/e/tBmfW/o2i0dSCossyyE0gv2oXqB1piaHBRSnbaYDkb3M6aC3dQvIMWE8RQ+GIIlGOxj",
+	"U2doUQCt6xTC3kkjHM0AZku4eEXSEnMPF7b3il4ZcLZgOCVvneqN+Pna3mEqDIKeoRE+G7kQTQux8KNo",
+	"Bjp7ENqOKEx+3H8/HTCCVUns4sND/m6I2WirlINDm3lSxXfXPvuztnLS/YFAmBJyXtGaU0IdoSNnnNh3",
+	"oCk+6wZyuk2ewmE36Hzgoo4d6w+aMqajNN5JbpBT0K/vnczdG4Q/Xb3oo3XXwqWLOTU7OOFIRZWWwt4c",
+	"/v9e1s7XNTlioOwYRr17hGvUNDz0AyP0K6Km5KxuWYXcwmsze0V0Qb9RoCuVAUUjW3Isg2mJB1ft1Jec",
+	"apc/6gNvfDVIfMg4jG7NI6d/UKVK/4gN5euqlcc3PNxxFfRvHG08raJ7IjYeUnmcuyHvVY6oHEPyxpg7",
+	"KqqUSBiKrBBBboHmgWl58ZT8YOPaG18tN/JnZcfEcHkz73Q0HubO3FrURBwtSynKIg4F/FQDdZvbx0Dg",
+	"LPL6XqfDa4aZWc2Xe5iUvOVEibyWO2BhnrLFAmTlh3NGDaTVFCZz81PnQfLeWzaOGQp3hQ/54rqyaCzb",
+	"YXyZueGtjeirnzi/TfplD+fWcn240CDPIBE89mKveTWzgATV33H1xhjjRNkuPrGlketRSyu3voh0Ss5E",
+	"7hi8T4W13pN62ivyH00vAYV6hhaBBkLRsiET56oWKgykm9IrjLkS1yQTRpUU5JoyHVZJL33mVnv46bDH",
+	"NEsWQf53x6/apzntPaYq+6TnqNr4Gw9TLBXIybJkKewFm0qqP5QshpV3FIMb5J/dmnXVOIFtTimhWRaE",
+	"B/+j9i2sR8t7n3YJ8w+dMJ/EswLL5dJyTkyVdGdjbzmEy0+3nGdM9o3HzzkvBtJI7Unne5KBNT1sl7V/",
+	"z1n7d7Ao6vWhmKr4//Sm+gB3RotwaXEnA+R6tW6t3KX9mc3NRt9aPXA2chu9g2VCDr2mnmRUWv8X5aST",
+	"Pz0vdZUIaS4RJUuBMD3dJiv7LFK2i1nFymgdpkj8WYlhTMYWlfWdPjg6qgISdE6F/OwhZV4UJKVkeo1F",
+	"g62oeAlUgjwsbWU1RB7TaY4/V8OaPYw+mjFYNGPvD+Swus7FNNXDLKtTMPG3j4cnx/7+mFwcumRH7HNA",
+	"7GLIrNzf/yrBuwP8J1yQFRrOVqGjBE0cd7nAuH2ofoIP1RsfxPkK3DenFIi589bP1+7+wxdWS3TmmkpQ",
+	"oC+cMoF/+PfCzVd0w0jGtSIs3CCpRAJwF7dg0+9HJ7ZQW9itpcbaZePB6Pl0f7rv6ldxWrDRweir6f70",
+	"hXu1AU9lz6Vvqr1fjTr6EVHgEtb4cQm6JwzDwNbeEZr1mb9MH3sPVhWJcmNXGqm3oS/MXBeGRgKyH6fu",
+	"AvPw5PgfZv7xqBavdfBjexn1+Ao3D2aGYqS8Xvlw/wP7n/rtrI3oc/CKxF2+H4+8yY5QeLG/7y8qwV4T",
+	"1cuV/Muxsmq8TbzSbs5s0+J4W8wjkZvyVAEu5vy+vscV2DIukcnfcdU7/dcPP/2hwxXDR22+8Mfx6JvH",
+	"2PixVxGdZwdcw/HIVbNwiBnQ3FAhXRqkHDV5EfKTF38mDWaDGSeFUJtICelGEUo4XPtpBlOR5UO+VbjO",
+	"t01suspFVR1g7dqbGVh1Q+7v7pNw/+ppAFlSEpQif1ftqhpcU5m68jlNUra5RBbfPzEto2h4KdL1veFS",
+	"fXMu9jSCWOcVa6zHnn58cP6y4y2fE2+xyOQx5RbM5cPEKRkTbyX55KsgzD+O+yX93q8s/WiZUwYaNrAp",
+	"CVfiEhoyP8KVTM2NW2sAr3ANn5ptjDtuteAvqo4pMhFLt5qmq2l8HbP9d4T7NAn3FMnh4QnXxfdOvEG0",
+	"WS1feoPCdbN3Pv4e3qeMJBlVeO8SIswYr/eKifTvQMfLqjygQItP+PnIt6cjZZrlbTyqVvAdvTcdasX1",
+	"bkY1qzwqX0ujmRGVU06XluE7GzWGU0axriVfPSAmNVNkB2NQA4hv3J54fcUelN8BB+nugG8g71r/Jsz3",
+	"fg3//rhn88cmjmQHW+TmArSZeqaCt0KCKjNtQzcKumS8ur26wEjaC+QUFz5i8+LAOCoyZ0z8fxMXfQoT",
+	"zEMM3pJWFUFXwmI84wVV6MigqjYm0cJwqSratTBRmja4DkP7NpQqk0BTXD3G+YnSTKIEoTiGjUQWWWqi",
+	"V0GG0Sj3u5vOuCkXw/jSbVph1Rq3a+UryVzYNGWwwRRZVpWOGRMl3Gs4ziBKRD5Hn08DikLWNtyH+I00",
+	"yEEeD8QMD+5ufmFc9cFO2+o/MVKqlreH2xwNaOiBMKQtxrieucjWIR3s6Q1tiUc7elD/TvNEt+I045El",
+	"JlxTm9IiRG9+xigUQ2I309e48eaDDXGzcbQSmhE5/WjxcSdPmx6hFputCQOLCMRhwhAfkK1l4p1AzZGR",
+	"fz975mPenj3DqLeLCyxW+Kv5fyaUzV/YzEYH/scqNM5cIqivvDCZjcbNBsgjbCsntEKTj2M/gSogaQ1u",
+	"UMQP3hi0ymC2n+3fzxttQmq2bWL//OkS1o1WIavYzYN/dlrZtGS3g3KSANeSZpPns1F9Fx8D3G4FQPpL",
+	"KeEBYYjjbwRjyPHeCEm3wp98bUa7gw0wbbWvA7cNuB5PX4PzPSFR9qAewFgdgx5HYHOHIUoeo6At6Xd9",
+	"Bx8fS1LtDKpbu+06mLtBAvQbBG1Vf7hVYL8N89/ZBipCcds66Lam9m0J/W7uusfmL5+pD+/J0JJFqq1o",
+	"aaATLIbmCevgufd+LdkVcHIRUCFCAN+B3mH/Y9yV7yTUHanqO9BbkVRh0gU2EJUrE7ON+CBveWZ/qFq4",
+	"RAWf0OCj7CKaZaRY1I7a7l+X7a/JNUyXxQNR25z1TtP9nPiIxY/H13Tbj/pMbF9EkK2cKe1KOX4rjLfQ",
+	"tS74ey3dV82Xmezut+FLdeJ/6rwhvtkevtAH509u7A7eRR8reLH//PEXY9EtJY5B2HW8ePx1HCYJFObI",
+	"djyxbf33YHyHOd7AFHs53S24420dAn3E26Pa2RuzzfzSmnVPk1+OtynY5mCBySGGh2Esist6feOcxj96",
+	"R/F7P0p04z6j6cEilRZ49eoqKwSFFFJSFrgvmxLT0k5/LkGuq2UkGVBeFm3Nu7OMqkznQxqCWya+7TS8",
+	"2/pftuJmAx0wD8BWvgO94ykPyFPeP2VNbEeylXPnKWkfZmQh4R6MMzfS/Vhnp3aw34l55nc71D7zoH5q",
+	"BtqGfXwCC23Dah7XRNuwkJ2NNtxGk4EneDbpAbslnww87zaM8t7sNE/E922oPRXWuZ1W5aBxN7XqtMEX",
+	"Pwe9amcjfSobaTM3ua2VdA9E3TWTdhT9+VpKt1CJdpS7wVTaTLZFqQdehD8E5doLtx3xPgLxfh4mWfUC",
+	"2s4k29IkW5TZjhd27vKflk20VWpbe+mq6yiqP/Vzx7y3C58TMA2fHj3bzaXuYusZjxd4XbAMYdFIjSNb",
+	"ZMYdzPhFI//qwqwHsdSKsFoVcFec0QGS8eXYQwvwUaHzdeEFna0adTHjZmF+NC3co4+FzW15Qil5LT6j",
+	"noK4/e2m4nV0CQwhs8+iRISjquqs2kBdi26Y4T4mMF1OSfEhGZNC5encnHchlF5KUD9nPRedFb6OttJ5",
+	"blpnI5zYV2bEBfqnuWKrCSXWnoTF8ZlVqXpamYEdlKhJVg9n4gC9fXZge/TNInDYTcnv5IpksCL+1O5E",
+	"nojmPUzlztYPfBWyuwO50x3ITdxouMK/naK/96u3E2wNqlpE7231f3fprW66L94ZArc2BBAi83ow2AWp",
+	"6/NDrYNgDDD1GdoCLx2uflYOuLs53jZ73OqkvLNpBto0LW5VWQjegkGZDimkHujbGwkDl4COmrS5knjy",
+	"UmsJ85viQj9dKNnOaLlHo8VjyqcIKOuoCvUAs1vrCn4QLFBJu9+HX9rs1InH9Cs6zYF8lorDqcfcneaw",
+	"0xxurzkE3vWQqsPjRzTsJPZ9SmxZ8ZpPcZF3b0GN9x3MuOO9uxSzXfjk0wufvMkRedv4yXuNm9wxj88h",
+	"QnJHlfcTGnnjTeWg2Eh6rzQZjYjckeUTj3283V3rEwh23LGSe4ss/HQ3jdZtWG1zi6czrqhkAp949J37",
+	"qPp+FY2jarE73vYZqBy189pxjPvJy0jqJPBpOYcEfKebZtuwjlqv8ArWAzON2jp3XONz4BrhwHZc4764",
+	"RoMG7oltTOqj3oaDFEzLLVjHiWBcTxifnLMc8Gl7vN9ifCEeiZWcmAXveMhnwEPwpHbc41bc4wZae2y9",
+	"w+Yg3C6uwfXdulZO/Zb6tZv/ScR/PzD12L3urhzv48oRAt50yMWCeSi1+IG2IJa9slhKmsKkyCgfSjkF",
+	"8NREiFjgCkncIK0b9Wb24GGaMjMczbL1GINwMiUiLx/6wWliWleBMJpwsNEqcyAFyIWQOaRkxl2wC+Xu",
+	"JW23GhyjArJfq1+Ljde7ej59Pt3H5eAb3onIc+CpnadUQLTfudEbOvt1T4CLLA3T4jOmNswnhUJCgoFM",
+	"ZnG+dre97fPTv5juxzWKd3a4E3Muv2WOUt/njpXcSg57zCssrngu8tahq3os/rFHi0KKK5oNqF0XWEZE",
+	"DAdCuzEl+ckT8iFCBJ4cMT/E4wdhi4ceDSI4fWqnxmOoGHXDImkjwdALjB3j2O6awWL5JrA/KiepIp62",
+	"jVVwK78fC96pXJ+H8Q5+sZ+L1e2guxP0d3PXhXPfZDHcovbS3SmpGWDwOyemhwsM6Kejpx0XsKP/+woL",
+	"GMQC7kdU54IzLQxiTxhXmvJkOy9b1Z+E/oRxQqOOgt3z+k/tef034fyOw/Hv3tj/7N/Yjxzr7qH937jT",
+	"OcaKazKkQonta+lEhrY+mtgXr5E4MlfkQmOtM6uhKNDTGX9JFaREWA+Q/+7YNySaXQG5hLXlZ4ngC7Ys",
+	"LdjRU6waY52VyYpQNTaohEMdkCLPL8ZmQE4uzL9xsHpP48RgqeeYtDlHfzmgLln99l9x7+7ZwmLz85dv",
+	"+vHi01ULihzfTlu9bbmcCOX3c5t+ZTWqgG6psN42oy7GvLZ85P12HMEzgzgMH+cJ3jfbzP37evP96/2v",
+	"H376GIfkQtsgnaeYltZCVk43EfxAP++dKPA70Hcjvze/J/LbidEdbcddz1tJ8m0ewL8TdVun2E6+fmpt",
+	"357DZm0/v0nb/ySP2u/41G+HTzkX+UMbHQXInCnFBB/gBY8FuIXuIRodvcgY5MYUSUopgWtTskYslxhg",
+	"go6UZ68/0LzI4ODZjB8qVebW3b0Qxu1sdnv68vCIFCJjyXqMnmIzrCIXNGOJv72bizmWkrq4mPFiTKTI",
+	"4CCFq3HlhFdj9F6PybNWi/aVwZg8G5Nne73NfPBuo91czDc2WY4JLrca0S3WsBADUIy+sVBtbb8NWLdv",
+	"v9tfZ5yQ2ajWajY6ID+aX4n/j/mf2Qj7zUbj+m8VeFofDKxaPz2bjeyf78cDR2+Dtjtg8++9O0zhYb7F",
+	"HOY/72f8o4PkIU9vAn0dzYYDfi7mD7fqaJClAnlSrWv0kHGOral2TqXbxToqkHV0q3H2w1KvgGu3MDIr",
+	"9/df/JmYX4Vkv+CPo/dmxD3P6rfKR6QFTZhe20Bjf/1AwlBepauV4fOpNXHcqxpWdbzcqh4QDTfMusPI",
+	"7d2cVbWwcHQeHStIO6xTgCg7yAMp4UpcOgvpv//nnGi8PCsVpEFncGoCccMiXiYZUGl7+Z8TIS6ZuYk5",
+	"dIOmbrDqajgMS/maqHKujM3A7eUMKK3GpkAkN1cnTJMVtZfd8KEwGjpZg44huPUHnbktf8Z+vieDa6+t",
+	"nkreHZPvxVKUemvWd+OFHlOqDPd5FdoZ1DCqqNWobVEyg2J+RYcnxy6AOlyZIZvOS2XwxZU2u8jEkvEL",
+	"ZKRzljG9ns748YK8+faQMEWAG36aNlTiMeHCrYEpu7pmA05zm/lRUKWuhUynxJhxRn2d8Yt8QU+dIXnh",
+	"Yj0IzYQPqjDhFvmCHq1olgFfwgVWSU1Wdt14dcg13sURLUIA+YxrsQS9Alk9ooPDiBQuKtjQ6kQMYRXF",
+	"mAjXQ3CoIlFcvlwibBR6z51inY4eIHRbNZPfewx4PNNmgvD92umFNHvXzPauH00XZw+JWgmpJxm7ChwN",
+	"Daju4dWOHH0u3x4iuEfjtr9kPKphTIRBgTY8kBIFieApWdDEnC3mG9g+iJsGwBloH9aOWD8ad8rwGVgt",
+	"JKhVT7jEIVGMLzOYYPZCoEMx15Rxm9xkqJQi1wrywc964aXNnpvlwuBubMvaT9/xXvlfrJPms3KLfPXw",
+	"0yO7c1wHhaIXiIYdeSRMVpQvIY0ejO9sT8Ys+8VfH2fZNElEydHXoCEvhKSSobcBa0/aJDotBMmNPrCg",
+	"zLBlRGNCtemg1VMQjJCUkun16ODH9/1ikvFbGQjtMxqQ0GSP2upevpth89TAlWYB6Ba8VyDZYo0CVQeP",
+	"TyXFZvy1wyb/kyKJzc/3KEUVuYYss/GIhhOESRH5cnDxVOFn6xia8eZrBR5cCrRhWWpKjswEHl1D77pC",
+	"6qATTB63taj4wtWeeDA+jPxqTrLZC+1BXZNjoYB7HYoDhNtT02E7iJAKsLp6HzLYpT1/DDJOhJSQ6Epr",
+	"E7KC9Y753RPzs5RQR+Lb8z6nOAxgffDBMz9KXK+axtK2JtB/gr812hqeR5NVawDH9EobCZitiTBhheQU",
+	"rERtz+e5lIuFNrr6JfCO6aAMArp9xrjWqR30YbXu5iSbuVZjlw+sd+/U0gdTSx+F017RjKXjoI4KSSQg",
+	"/TSR6OkyMUcYnkBvycSsOjP8gs4qNraXV2y8coT3f1lmA39jvtwzP90Dem7DHIMxfgOMawv2wP0OOEia",
+	"2dI9TSjuyTlNtgMl3kUGeDZNcIb6r+oDpun6GABtzLNzft/qOqZxzLdxSG7MUUVWZlMMzG12LWqoMe+U",
+	"HHLCLONzmq31yhgBAdUDTZXH3KOlT9AquRZlsoLUuiQvHHp6r+G4yR1MN7uYNOSnMPfYE6jGVB7PfVpb",
+	"kdEE0uZQl1Bob4659mTFlBZy7V+IknCFdXT9Z6cWuadFMEwKF+TlbBgfSQ5NTIsu/Sm7Hap7iBIR7Yk2",
+	"az1t5Hq8qKAnzRqOG5hup30k46nJ1a9BAslFakP1FOMJ1Mo/OVx9ihFCd+Va/UFDRlBOwsgdGbrngDI4",
+	"fRaN9hb1B/WksY2x0buxsgqTSk+jiZx1tP6nX8kjkdGuVtwd0vYCCjwm5noC3krvc53aGrRpZjcZk0AO",
+	"F49t0cgHQ8d/eo60jQIdDsL37teYm/r2r6OXQCVIcwhG/TbRuBYENiK5lNnoYLR39RyzfN2YbRgb+K31",
+	"ysh1CRlqHFq040yOfJXMEC5cfYy8htc/ZrtMZ23E9qfbjVuVyGwPa7/cabWk9j6kG979crdhq/eq3aj2",
+	"h60GfdnO6GwMRfy7XUOHrGJTq6Fqga1Dh6FNroGeuQbLCIMP4S/dWesEInM3yVyUute6rmas970LspG3",
+	"tYJWbuzqp4/vP/7fAQBuaIYVAYoBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -18,13 +18,29 @@ package config
 
 import (
 	"crypto/aes"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
 
 	"github.com/kelseyhightower/envconfig"
+
+	"github.com/percona/everest/pkg/accounts/ldap"
+	"github.com/percona/everest/pkg/audit"
+	"github.com/percona/everest/pkg/ratelimit"
+	"github.com/percona/everest/pkg/tlsconfig"
+	"github.com/percona/everest/pkg/tracing"
 )
 
 const (
 	// AES256BitKeySize is the size (bytes) of a 256-bit key.
 	AES256BitKeySize = 2 * aes.BlockSize
+
+	// AccountsBackendKubernetes stores the accounts in a Kubernetes Secret.
+	AccountsBackendKubernetes = "kubernetes"
+	// AccountsBackendLDAP stores the accounts in an LDAP directory.
+	AccountsBackendLDAP = "ldap"
 )
 
 //nolint:gochecknoglobals
@@ -52,8 +68,31 @@ type EverestConfig struct {
 	APIRequestsRateLimit int `default:"100" envconfig:"API_REQUESTS_RATE_LIMIT"`
 	// CreateSessionRateLimit allowed amount of API requests per second to the /session method
 	CreateSessionRateLimit int `default:"1" envconfig:"CREATE_SESSION_RATE_LIMIT"`
+	// RateLimit configures how the API requests are limited, e.g. RATE_LIMIT_STORE.
+	RateLimit ratelimit.Config `envconfig:"RATE_LIMIT"`
 	// VersionServiceURL contains the URL of the version service.
 	VersionServiceURL string `default:"https://check.percona.com" envconfig:"VERSION_SERVICE_URL"`
+	// AccountLockoutThreshold is the number of consecutive failed login attempts
+	// after which an account is locked. 0 disables the lockout.
+	AccountLockoutThreshold int `default:"5" envconfig:"ACCOUNT_LOCKOUT_THRESHOLD"`
+	// AccountLockoutDuration is the time for which an account stays locked.
+	AccountLockoutDuration time.Duration `default:"15m" envconfig:"ACCOUNT_LOCKOUT_DURATION"`
+	// AccountsBackend selects where the Everest accounts are stored.
+	// One of "kubernetes" or "ldap".
+	AccountsBackend string `default:"kubernetes" envconfig:"ACCOUNTS_BACKEND"`
+	// LDAP configures the LDAP accounts backend, e.g. LDAP_URL.
+	LDAP ldap.Config `envconfig:"LDAP"`
+	// RBACHistorySize is the number of previous versions of the RBAC settings that are kept for rollback.
+	RBACHistorySize int `default:"10" envconfig:"RBAC_HISTORY_SIZE"`
+	// Audit configures the audit log of mutating API requests, e.g. AUDIT_SINKS.
+	Audit audit.Config `envconfig:"AUDIT"`
+	// Tracing configures the export of OpenTelemetry traces, e.g. TRACING_ENABLED.
+	Tracing tracing.Config `envconfig:"TRACING"`
+	// TLS configures serving the API over HTTPS, e.g. TLS_ENABLED.
+	TLS tlsconfig.Config `envconfig:"TLS"`
+	// BasePath is the path prefix the UI, the API and the health checks are served under,
+	// e.g. /everest for serving Everest at https://tools.corp/everest/. Empty serves them at the root.
+	BasePath string `envconfig:"BASE_PATH"`
 }
 
 // ParseConfig parses env vars and fills EverestConfig.
@@ -70,6 +109,33 @@ func ParseConfig() (*EverestConfig, error) {
 	if c.TelemetryInterval == "" {
 		c.TelemetryInterval = TelemetryInterval
 	}
+	if c.AccountsBackend != AccountsBackendKubernetes && c.AccountsBackend != AccountsBackendLDAP {
+		return nil, fmt.Errorf("unsupported accounts backend %q", c.AccountsBackend)
+	}
+	if c.AccountLockoutThreshold > 0 && c.AccountLockoutDuration <= 0 {
+		return nil, errors.New("account lockout duration must be positive")
+	}
+	c.BasePath, err = normalizeBasePath(c.BasePath)
+	if err != nil {
+		return nil, err
+	}
 
 	return c, nil
 }
+
+// normalizeBasePath returns the base path with a leading slash and without a trailing one,
+// or an empty string if the base path is the root.
+func normalizeBasePath(p string) (string, error) {
+	p = strings.TrimSpace(p)
+	if p == "" {
+		return "", nil
+	}
+	if strings.ContainsAny(p, ":*?#") {
+		return "", fmt.Errorf("invalid base path %q", p)
+	}
+	p = path.Clean("/" + p)
+	if p == "/" {
+		return "", nil
+	}
+	return p, nil
+}
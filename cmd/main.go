@@ -30,6 +30,7 @@ import (
 	"github.com/percona/everest/api"
 	"github.com/percona/everest/cmd/config"
 	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/tracing"
 )
 
 const (
@@ -58,6 +59,11 @@ func main() {
 
 	tCtx, tCancel := context.WithCancel(context.Background())
 
+	shutdownTracing, err := tracing.Setup(tCtx, c.Tracing)
+	if err != nil {
+		l.Fatalf("Failed setting up tracing: %+v", err)
+	}
+
 	server, err := api.NewEverestServer(tCtx, c, l)
 	if err != nil {
 		l.Fatalf("Error creating Everest Server\n: %s", err)
@@ -94,6 +100,9 @@ func main() {
 	} else {
 		l.Info("Everest shut down")
 	}
+	if err := shutdownTracing(ctx); err != nil {
+		l.Error(errors.Join(err, errors.New("could not flush traces")))
+	}
 
 	l.Info("Exiting")
 }
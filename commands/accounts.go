@@ -33,9 +33,12 @@ func newAccountsCmd(l *zap.SugaredLogger) *cobra.Command {
 	cmd.AddCommand(accounts.NewCreateCmd(l))
 	cmd.AddCommand(accounts.NewListCmd(l))
 	cmd.AddCommand(accounts.NewDeleteCmd(l))
+	cmd.AddCommand(accounts.NewUnlockCmd(l))
 	cmd.AddCommand(accounts.NewSetPwCommand(l))
 	cmd.AddCommand(accounts.NewResetJWTKeysCommand(l))
 	cmd.AddCommand(accounts.NewInitialAdminPasswdCommand(l))
+	cmd.AddCommand(accounts.NewAPIKeysCmd(l))
+	cmd.AddCommand(accounts.NewMFACmd(l))
 
 	return cmd
 }
@@ -0,0 +1,36 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accounts
+
+import (
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/commands/accounts/apikeys"
+)
+
+// NewAPIKeysCmd returns a new api-keys command.
+func NewAPIKeysCmd(l *zap.SugaredLogger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "api-keys",
+		Long:  "Manage API keys of Everest user accounts",
+		Short: "Manage API keys of Everest user accounts",
+	}
+	cmd.AddCommand(apikeys.NewCreateCmd(l))
+	cmd.AddCommand(apikeys.NewListCmd(l))
+	cmd.AddCommand(apikeys.NewRevokeCmd(l))
+	return cmd
+}
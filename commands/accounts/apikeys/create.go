@@ -0,0 +1,71 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apikeys
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// NewCreateCmd returns a new command for creating API keys.
+func NewCreateCmd(l *zap.SugaredLogger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create",
+		Example: "everestctl accounts api-keys create --username ci --description pipeline --expires-in 720h",
+		Short:   "Create a new API key for an Everest user account",
+		Long:    "Create a new API key for an Everest user account that has the apiKey capability",
+		Run: func(cmd *cobra.Command, args []string) { //nolint:revive
+			initCreateViperFlags(cmd)
+
+			kubeconfigPath := viper.GetString("kubeconfig")
+			username := viper.GetString("username")
+			description := viper.GetString("description")
+			expiresIn := viper.GetDuration("expires-in")
+
+			ctx := context.Background()
+			cli, err := newCLI(ctx, l, kubeconfigPath)
+			if err != nil {
+				l.Error(err)
+				os.Exit(1)
+			}
+
+			if err := cli.CreateAPIKey(ctx, username, description, expiresIn); err != nil {
+				l.Error(err)
+				os.Exit(1)
+			}
+		},
+	}
+	initCreateFlags(cmd)
+	return cmd
+}
+
+func initCreateFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("username", "u", "", "Username of the account")
+	cmd.Flags().String("description", "", "Description of the API key")
+	cmd.Flags().Duration("expires-in", 0, "Duration after which the API key expires. The key never expires if not set")
+}
+
+func initCreateViperFlags(cmd *cobra.Command) {
+	viper.BindPFlag("username", cmd.Flags().Lookup("username"))       //nolint:errcheck,gosec
+	viper.BindPFlag("description", cmd.Flags().Lookup("description")) //nolint:errcheck,gosec
+	viper.BindPFlag("expires-in", cmd.Flags().Lookup("expires-in"))   //nolint:errcheck,gosec
+	viper.BindEnv("kubeconfig")                                       //nolint:errcheck,gosec
+	viper.BindPFlag("kubeconfig", cmd.Flags().Lookup("kubeconfig"))   //nolint:errcheck,gosec
+}
@@ -0,0 +1,73 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apikeys
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	accountscli "github.com/percona/everest/pkg/accounts/cli"
+)
+
+// NewListCmd returns a new command for listing API keys.
+func NewListCmd(l *zap.SugaredLogger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Example: "everestctl accounts api-keys list --username ci",
+		Short:   "List the API keys of an Everest user account",
+		Long:    "List the API keys of an Everest user account",
+		Run: func(cmd *cobra.Command, args []string) { //nolint:revive
+			initListViperFlags(cmd)
+			o := &accountscli.APIKeysListOptions{}
+			if err := viper.Unmarshal(o); err != nil {
+				os.Exit(1)
+			}
+
+			kubeconfigPath := viper.GetString("kubeconfig")
+			username := viper.GetString("username")
+
+			ctx := context.Background()
+			cli, err := newCLI(ctx, l, kubeconfigPath)
+			if err != nil {
+				l.Error(err)
+				os.Exit(1)
+			}
+
+			if err := cli.ListAPIKeys(ctx, username, o); err != nil {
+				l.Error(err)
+				os.Exit(1)
+			}
+		},
+	}
+	initListFlags(cmd)
+	return cmd
+}
+
+func initListFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("username", "u", "", "Username of the account")
+	cmd.Flags().Bool("no-headers", false, "If set, hide table headers")
+}
+
+func initListViperFlags(cmd *cobra.Command) {
+	viper.BindPFlag("username", cmd.Flags().Lookup("username"))     //nolint:errcheck,gosec
+	viper.BindPFlag("no-headers", cmd.Flags().Lookup("no-headers")) //nolint:errcheck,gosec
+	viper.BindEnv("kubeconfig")                                     //nolint:errcheck,gosec
+	viper.BindPFlag("kubeconfig", cmd.Flags().Lookup("kubeconfig")) //nolint:errcheck,gosec
+}
@@ -0,0 +1,68 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apikeys
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// NewRevokeCmd returns a new command for revoking API keys.
+func NewRevokeCmd(l *zap.SugaredLogger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "revoke",
+		Example: "everestctl accounts api-keys revoke --username ci --id 0b5c8a7e-2f1d-4a6b-9c3e-7d8f9a0b1c2d",
+		Short:   "Revoke an API key of an Everest user account",
+		Long:    "Revoke an API key of an Everest user account",
+		Run: func(cmd *cobra.Command, args []string) { //nolint:revive
+			initRevokeViperFlags(cmd)
+
+			kubeconfigPath := viper.GetString("kubeconfig")
+			username := viper.GetString("username")
+			id := viper.GetString("id")
+
+			ctx := context.Background()
+			cli, err := newCLI(ctx, l, kubeconfigPath)
+			if err != nil {
+				l.Error(err)
+				os.Exit(1)
+			}
+
+			if err := cli.RevokeAPIKey(ctx, username, id); err != nil {
+				l.Error(err)
+				os.Exit(1)
+			}
+		},
+	}
+	initRevokeFlags(cmd)
+	return cmd
+}
+
+func initRevokeFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("username", "u", "", "Username of the account")
+	cmd.Flags().String("id", "", "ID of the API key")
+}
+
+func initRevokeViperFlags(cmd *cobra.Command) {
+	viper.BindPFlag("username", cmd.Flags().Lookup("username"))     //nolint:errcheck,gosec
+	viper.BindPFlag("id", cmd.Flags().Lookup("id"))                 //nolint:errcheck,gosec
+	viper.BindEnv("kubeconfig")                                     //nolint:errcheck,gosec
+	viper.BindPFlag("kubeconfig", cmd.Flags().Lookup("kubeconfig")) //nolint:errcheck,gosec
+}
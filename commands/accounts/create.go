@@ -28,6 +28,7 @@ import (
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 
+	"github.com/percona/everest/pkg/accounts"
 	accountscli "github.com/percona/everest/pkg/accounts/cli"
 	"github.com/percona/everest/pkg/kubernetes"
 )
@@ -45,6 +46,10 @@ func NewCreateCmd(l *zap.SugaredLogger) *cobra.Command {
 			kubeconfigPath := viper.GetString("kubeconfig")
 			username := viper.GetString("username")
 			password := viper.GetString("password")
+			capabilities := []accounts.AccountCapability{}
+			for _, c := range viper.GetStringSlice("capabilities") {
+				capabilities = append(capabilities, accounts.AccountCapability(c))
+			}
 
 			k, err := kubernetes.New(kubeconfigPath, l)
 			if err != nil {
@@ -59,7 +64,7 @@ func NewCreateCmd(l *zap.SugaredLogger) *cobra.Command {
 			cli := accountscli.New(l)
 			cli.WithAccountManager(k.Accounts())
 
-			if err := cli.Create(context.Background(), username, password); err != nil {
+			if err := cli.Create(context.Background(), username, password, capabilities...); err != nil {
 				l.Error(err)
 				os.Exit(1)
 			}
@@ -72,11 +77,14 @@ func NewCreateCmd(l *zap.SugaredLogger) *cobra.Command {
 func initCreateFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("username", "u", "", "Username of the account")
 	cmd.Flags().StringP("password", "p", "", "Password of the account")
+	cmd.Flags().StringSlice("capabilities", []string{string(accounts.AccountCapabilityLogin)},
+		"Comma-separated list of account capabilities (login, apiKey)")
 }
 
 func initCreateViperFlags(cmd *cobra.Command) {
-	viper.BindPFlag("username", cmd.Flags().Lookup("username"))     //nolint:errcheck,gosec
-	viper.BindPFlag("password", cmd.Flags().Lookup("password"))     //nolint:errcheck,gosec
-	viper.BindEnv("kubeconfig")                                     //nolint:errcheck,gosec
-	viper.BindPFlag("kubeconfig", cmd.Flags().Lookup("kubeconfig")) //nolint:errcheck,gosec
+	viper.BindPFlag("username", cmd.Flags().Lookup("username"))         //nolint:errcheck,gosec
+	viper.BindPFlag("password", cmd.Flags().Lookup("password"))         //nolint:errcheck,gosec
+	viper.BindPFlag("capabilities", cmd.Flags().Lookup("capabilities")) //nolint:errcheck,gosec
+	viper.BindEnv("kubeconfig")                                         //nolint:errcheck,gosec
+	viper.BindPFlag("kubeconfig", cmd.Flags().Lookup("kubeconfig"))     //nolint:errcheck,gosec
 }
@@ -46,7 +46,7 @@ func NewInitialAdminPasswdCommand(l *zap.SugaredLogger) *cobra.Command {
 				os.Exit(1)
 			}
 			if secure {
-				l.Error("Cannot retrieve admin password after it has been updated.")
+				l.Error("Cannot retrieve admin password after it has been used or updated.")
 				os.Exit(1)
 			}
 			admin, err := k.Accounts().Get(ctx, common.EverestAdminUser)
@@ -0,0 +1,64 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mfa
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// NewEnableCmd returns a new command for enabling MFA.
+func NewEnableCmd(l *zap.SugaredLogger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "enable",
+		Example: "everestctl accounts mfa enable --username user1",
+		Short:   "Enable MFA for an Everest user account",
+		Long:    "Enable TOTP based multi-factor authentication for an Everest user account and print its recovery codes",
+		Run: func(cmd *cobra.Command, args []string) { //nolint:revive
+			initEnableViperFlags(cmd)
+
+			kubeconfigPath := viper.GetString("kubeconfig")
+			username := viper.GetString("username")
+
+			cli, err := newCLI(l, kubeconfigPath)
+			if err != nil {
+				l.Error(err)
+				os.Exit(1)
+			}
+
+			if err := cli.EnableMFA(context.Background(), username); err != nil {
+				l.Error(err)
+				os.Exit(1)
+			}
+		},
+	}
+	initEnableFlags(cmd)
+	return cmd
+}
+
+func initEnableFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("username", "u", "", "Username of the account")
+}
+
+func initEnableViperFlags(cmd *cobra.Command) {
+	viper.BindPFlag("username", cmd.Flags().Lookup("username"))     //nolint:errcheck,gosec
+	viper.BindEnv("kubeconfig")                                     //nolint:errcheck,gosec
+	viper.BindPFlag("kubeconfig", cmd.Flags().Lookup("kubeconfig")) //nolint:errcheck,gosec
+}
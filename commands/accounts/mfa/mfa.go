@@ -0,0 +1,44 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mfa holds commands for managing multi-factor authentication.
+package mfa
+
+import (
+	"errors"
+	"net/url"
+
+	"go.uber.org/zap"
+
+	accountscli "github.com/percona/everest/pkg/accounts/cli"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+// newCLI returns an accounts CLI that manages the accounts stored in Kubernetes.
+func newCLI(l *zap.SugaredLogger, kubeconfigPath string) (*accountscli.CLI, error) {
+	k, err := kubernetes.New(kubeconfigPath, l)
+	if err != nil {
+		var u *url.Error
+		if errors.As(err, &u) {
+			l.Error("Could not connect to Kubernetes. " +
+				"Make sure Kubernetes is running and is accessible from this computer/server.")
+		}
+		return nil, err
+	}
+
+	cli := accountscli.New(l)
+	cli.WithAccountManager(k.Accounts())
+	return cli, nil
+}
@@ -0,0 +1,154 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package audit holds commands for audit command.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/pkg/audit"
+)
+
+const tailCmdExamples = `
+Examples:
+# Print the last 20 events of the audit log file
+$ everestctl audit tail --file /var/log/everest/audit.log --lines 20
+
+# Follow the deletions made by alice
+$ everestctl audit tail --file /var/log/everest/audit.log --follow --user alice --action delete
+
+# Follow the events written by the stdout sink of the Everest server
+$ kubectl logs -n everest-system deploy/everest-server -f | everestctl audit tail --file - --follow
+`
+
+// NewTailCmd returns a new tail command.
+func NewTailCmd(l *zap.SugaredLogger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tail",
+		Long:    "Print the last events of the Everest audit log" + "\n" + tailCmdExamples,
+		Short:   "Print the last events of the Everest audit log",
+		Example: "everestctl audit tail --file /var/log/everest/audit.log --follow",
+		Run: func(cmd *cobra.Command, args []string) { //nolint:revive
+			initTailViperFlags(cmd)
+
+			path := viper.GetString("file")
+			lines := viper.GetInt("lines")
+			follow := viper.GetBool("follow")
+			filter := audit.Filter{
+				User:     viper.GetString("user"),
+				Resource: viper.GetString("resource"),
+				Action:   viper.GetString("action"),
+			}
+			outputJSON, _ := cmd.Flags().GetBool("json")
+			printFn := func(e audit.Event) {
+				printEvent(os.Stdout, e, outputJSON)
+			}
+
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			if err := tail(ctx, path, lines, follow, filter, printFn); err != nil {
+				l.Error(err)
+				os.Exit(1)
+			}
+		},
+	}
+	initTailFlags(cmd)
+	return cmd
+}
+
+// tail prints the last events read from the file at path, or from the standard input if path is "-".
+// If follow is set, it keeps printing the events appended afterwards.
+func tail(ctx context.Context, path string, lines int, follow bool, filter audit.Filter, printFn func(audit.Event)) error {
+	if path == "-" {
+		if follow {
+			// The standard input is read until it is closed, so there is no need to keep the last events.
+			return audit.Scan(os.Stdin, filter, printFn)
+		}
+		events, err := audit.ReadLast(os.Stdin, lines, filter)
+		if err != nil {
+			return err
+		}
+		for _, e := range events {
+			printFn(e)
+		}
+		return nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close() //nolint:errcheck
+	events, err := audit.ReadLast(f, lines, filter)
+	if err != nil {
+		return err
+	}
+	for _, e := range events {
+		printFn(e)
+	}
+	if !follow {
+		return nil
+	}
+	offset, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	return audit.Follow(ctx, path, offset, filter, printFn)
+}
+
+func printEvent(w io.Writer, e audit.Event, outputJSON bool) {
+	if outputJSON {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return
+		}
+		fmt.Fprintln(w, string(data))
+		return
+	}
+	fmt.Fprintf(w, "%s %s %s %s %s %d %s %s\n",
+		e.Time.Format(time.RFC3339), e.User, e.Action, e.Resource, e.Object,
+		e.Status, e.Outcome, time.Duration(e.LatencyMs)*time.Millisecond)
+}
+
+func initTailFlags(cmd *cobra.Command) {
+	cmd.Flags().String("file", "/var/log/everest/audit.log", `Path to the audit log file, or "-" for reading the standard input`)
+	cmd.Flags().IntP("lines", "n", 10, "Number of events to print, all events are printed if 0")
+	cmd.Flags().BoolP("follow", "f", false, "Keep printing the events as they are written")
+	cmd.Flags().String("user", "", "Only print the events of this user")
+	cmd.Flags().String("resource", "", "Only print the events for this resource, e.g. database-clusters")
+	cmd.Flags().String("action", "", "Only print the events for this action, e.g. delete")
+}
+
+func initTailViperFlags(cmd *cobra.Command) {
+	viper.BindPFlag("file", cmd.Flags().Lookup("file"))         //nolint:errcheck,gosec
+	viper.BindPFlag("lines", cmd.Flags().Lookup("lines"))       //nolint:errcheck,gosec
+	viper.BindPFlag("follow", cmd.Flags().Lookup("follow"))     //nolint:errcheck,gosec
+	viper.BindPFlag("user", cmd.Flags().Lookup("user"))         //nolint:errcheck,gosec
+	viper.BindPFlag("resource", cmd.Flags().Lookup("resource")) //nolint:errcheck,gosec
+	viper.BindPFlag("action", cmd.Flags().Lookup("action"))     //nolint:errcheck,gosec
+}
@@ -46,6 +46,7 @@ func NewRootCmd(l *zap.SugaredLogger) *cobra.Command {
 	rootCmd.AddCommand(newAccountsCmd(l))
 	rootCmd.AddCommand(newSettingsCommand(l))
 	rootCmd.AddCommand(newNamespacesCommand(l))
+	rootCmd.AddCommand(newAuditCmd(l))
 
 	return rootCmd
 }
@@ -62,13 +62,15 @@ func NewConfigureCommand(l *zap.SugaredLogger) *cobra.Command {
 func initOIDCFlags(cmd *cobra.Command) {
 	cmd.Flags().String("issuer-url", "", "OIDC issuer url")
 	cmd.Flags().String("client-id", "", "ID of the client OIDC app")
+	cmd.Flags().String("groups-claim", "", "Name of the token claim that holds the groups of the user, e.g. 'groups' or 'realm_access.roles'")
 }
 
 func initOIDCViperFlags(cmd *cobra.Command) {
-	viper.BindEnv("kubeconfig")                                     //nolint:errcheck,gosec
-	viper.BindPFlag("kubeconfig", cmd.Flags().Lookup("kubeconfig")) //nolint:errcheck,gosec
-	viper.BindPFlag("issuer-url", cmd.Flags().Lookup("issuer-url")) //nolint:errcheck,gosec
-	viper.BindPFlag("client-id", cmd.Flags().Lookup("client-id"))   //nolint:errcheck,gosec
+	viper.BindEnv("kubeconfig")                                         //nolint:errcheck,gosec
+	viper.BindPFlag("kubeconfig", cmd.Flags().Lookup("kubeconfig"))     //nolint:errcheck,gosec
+	viper.BindPFlag("issuer-url", cmd.Flags().Lookup("issuer-url"))     //nolint:errcheck,gosec
+	viper.BindPFlag("client-id", cmd.Flags().Lookup("client-id"))       //nolint:errcheck,gosec
+	viper.BindPFlag("groups-claim", cmd.Flags().Lookup("groups-claim")) //nolint:errcheck,gosec
 }
 
 func parseOIDCConfig() (*oidc.Config, error) {
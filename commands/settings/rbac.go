@@ -17,5 +17,6 @@ func NewRBACCmd(l *zap.SugaredLogger) *cobra.Command {
 	}
 	cmd.AddCommand(rbac.NewValidateCommand(l))
 	cmd.AddCommand(rbac.NewCanCommand(l))
+	cmd.AddCommand(rbac.NewTestCommand(l))
 	return cmd
 }
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -48,6 +49,9 @@ $ everestctl settings rbac can bob delete database-cluster-backups prod-namespac
 # Check if user 'alice' can perform all/any actions on all backups in all namespaces
 $ everestctl settings rbac can alice '*' database-cluster-backups '*'
 
+# Show the policies and roles that allow or deny user 'bob' to delete 'cluster-1' in namespace 'prod-namespace'
+$ everestctl settings rbac can bob delete database-clusters prod-namespace/cluster-1 --explain
+
 NOTE: The asterisk character (*) holds a special meaning in the unix shell.
 To prevent misinterpretation, you need to add single quotes around it.
 `
@@ -91,17 +95,22 @@ func NewCanCommand(l *zap.SugaredLogger) *cobra.Command {
 				os.Exit(1)
 			}
 
+			if viper.GetBool("explain") {
+				explanation, err := rbac.Explain(cmd.Context(), policyFilepath, k, args...)
+				if err != nil {
+					l.Error(err)
+					os.Exit(1)
+				}
+				printExplanation(args[0], explanation)
+				return
+			}
+
 			can, err := rbac.Can(cmd.Context(), policyFilepath, k, args...)
 			if err != nil {
 				l.Error(err)
 				os.Exit(1)
 			}
-
-			if can {
-				fmt.Fprintln(os.Stdout, "Yes")
-				return
-			}
-			fmt.Fprintln(os.Stdout, "No")
+			printCan(can)
 		},
 	}
 	initCanFlags(cmd)
@@ -110,10 +119,33 @@ func NewCanCommand(l *zap.SugaredLogger) *cobra.Command {
 
 func initCanFlags(cmd *cobra.Command) {
 	cmd.Flags().String("policy-file", "", "Path to the policy file to use")
+	cmd.Flags().Bool("explain", false, "Print the policies and roles that the decision is based on")
 }
 
 func initCanViperFlags(cmd *cobra.Command) {
 	viper.BindEnv("kubeconfig")                                       //nolint:errcheck,gosec
 	viper.BindPFlag("kubeconfig", cmd.Flags().Lookup("kubeconfig"))   //nolint:errcheck,gosec
 	viper.BindPFlag("policy-file", cmd.Flags().Lookup("policy-file")) //nolint:errcheck,gosec
+	viper.BindPFlag("explain", cmd.Flags().Lookup("explain"))         //nolint:errcheck,gosec
+}
+
+func printCan(can bool) {
+	if can {
+		fmt.Fprintln(os.Stdout, "Yes")
+		return
+	}
+	fmt.Fprintln(os.Stdout, "No")
+}
+
+func printExplanation(subject string, explanation rbac.Explanation) {
+	printCan(explanation.Allowed)
+	if len(explanation.Matches) == 0 {
+		fmt.Fprintln(os.Stdout, "No matching policies")
+		return
+	}
+	fmt.Fprintln(os.Stdout, "Matching policies:")
+	for _, match := range explanation.Matches {
+		chain := strings.Join(append([]string{subject}, match.Roles...), " -> ")
+		fmt.Fprintf(os.Stdout, "  p, %s (%s)\n", strings.Join(match.Policy, ", "), chain)
+	}
 }
@@ -0,0 +1,102 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rbac
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/pkg/output"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+const testCmdExamples = `
+Examples:
+# Run the tests defined in 'tests.yaml' against the policy in 'policy.csv'
+$ everestctl settings rbac test --policy-file policy.csv --tests-file tests.yaml
+
+The tests file holds a table of requests along with the expected result:
+
+tests:
+  - description: devteam cannot delete clusters in prod
+    subject: role:devteam
+    action: delete
+    resource: database-clusters
+    object: prod/cluster-1
+    allowed: false
+`
+
+// NewTestCommand returns a new command for testing an RBAC policy file.
+func NewTestCommand(l *zap.SugaredLogger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "test",
+		Long:    `Run a table of allow/deny assertions against an RBAC policy file.` + "\n" + testCmdExamples,
+		Short:   "Run a table of allow/deny assertions against an RBAC policy file",
+		Example: "everestctl settings rbac test --policy-file policy.csv --tests-file tests.yaml",
+		Run: func(cmd *cobra.Command, args []string) { //nolint:revive
+			initTestViperFlags(cmd)
+
+			policyFilepath := viper.GetString("policy-file")
+			testsFilepath := viper.GetString("tests-file")
+			if policyFilepath == "" || testsFilepath == "" {
+				l.Error("Both --policy-file and --tests-file must be set")
+				os.Exit(1)
+			}
+
+			tests, err := rbac.ReadPolicyTests(testsFilepath)
+			if err != nil {
+				l.Error(err)
+				os.Exit(1)
+			}
+			results, err := rbac.TestPolicy(policyFilepath, tests)
+			if err != nil {
+				l.Error(err)
+				os.Exit(1)
+			}
+
+			failed := 0
+			for _, r := range results {
+				if r.Passed() {
+					fmt.Fprint(os.Stdout, output.Success("%s", r.Test))
+					continue
+				}
+				failed++
+				fmt.Fprint(os.Stdout, output.Failure("%s: expected allowed=%t, got allowed=%t",
+					r.Test, r.Test.Allowed, r.Allowed))
+			}
+			fmt.Fprintf(os.Stdout, "%d passed, %d failed\n", len(results)-failed, failed)
+			if failed > 0 {
+				os.Exit(1)
+			}
+		},
+	}
+	initTestFlags(cmd)
+	return cmd
+}
+
+func initTestFlags(cmd *cobra.Command) {
+	cmd.Flags().String("policy-file", "", "Path to the policy file to test")
+	cmd.Flags().String("tests-file", "", "Path to the YAML file with the tests to run")
+}
+
+func initTestViperFlags(cmd *cobra.Command) {
+	viper.BindPFlag("policy-file", cmd.Flags().Lookup("policy-file")) //nolint:errcheck,gosec
+	viper.BindPFlag("tests-file", cmd.Flags().Lookup("tests-file"))   //nolint:errcheck,gosec
+}
@@ -21,13 +21,16 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/rodaine/table"
 	"go.uber.org/zap"
 
 	"github.com/percona/everest/pkg/accounts"
+	"github.com/percona/everest/pkg/session"
 )
 
 const (
@@ -37,6 +40,7 @@ const (
 // CLI provides functionality for managing user accounts via the CLI.
 type CLI struct {
 	accountManager accounts.Interface
+	sessionManager *session.Manager
 	l              *zap.SugaredLogger
 }
 
@@ -52,6 +56,11 @@ func (c *CLI) WithAccountManager(m accounts.Interface) {
 	c.accountManager = m
 }
 
+// WithSessionManager sets the session manager for the CLI.
+func (c *CLI) WithSessionManager(m *session.Manager) {
+	c.sessionManager = m
+}
+
 func (c *CLI) runCredentialsWizard(username, password *string) error {
 	if *username == "" {
 		pUsername := survey.Input{
@@ -118,7 +127,7 @@ func (c *CLI) SetPassword(ctx context.Context, username, password string) error
 		return errors.New("invalid credentials")
 	}
 
-	if err := c.accountManager.SetPassword(ctx, username, password, true); err != nil {
+	if err := c.accountManager.SetPassword(ctx, username, password); err != nil {
 		return err
 	}
 	c.l.Infof("Password updated for user '%s'", username)
@@ -126,7 +135,8 @@ func (c *CLI) SetPassword(ctx context.Context, username, password string) error
 }
 
 // Create a new user account.
-func (c *CLI) Create(ctx context.Context, username, password string) error {
+// If no capabilities are provided, the account is created with the login capability only.
+func (c *CLI) Create(ctx context.Context, username, password string, capabilities ...accounts.AccountCapability) error {
 	if err := c.runCredentialsWizard(&username, &password); err != nil {
 		return err
 	}
@@ -138,10 +148,23 @@ func (c *CLI) Create(ctx context.Context, username, password string) error {
 		c.l.Error(msg)
 		return errors.New("invalid credentials")
 	}
+	if err := validateCapabilities(capabilities); err != nil {
+		return err
+	}
 
 	if err := c.accountManager.Create(ctx, username, password); err != nil {
 		return err
 	}
+	if len(capabilities) > 0 {
+		account, err := c.accountManager.Get(ctx, username)
+		if err != nil {
+			return err
+		}
+		account.Capabilities = capabilities
+		if err := c.accountManager.Update(ctx, username, account); err != nil {
+			return errors.Join(err, errors.New("failed to set account capabilities"))
+		}
+	}
 	c.l.Infof("User '%s' has been created", username)
 	return nil
 }
@@ -162,6 +185,31 @@ func (c *CLI) Delete(ctx context.Context, username string) error {
 	return c.accountManager.Delete(ctx, username)
 }
 
+// Unlock an account that was locked after too many failed login attempts.
+func (c *CLI) Unlock(ctx context.Context, username string) error {
+	if username == "" {
+		if err := survey.AskOne(&survey.Input{
+			Message: "Enter username",
+		}, &username,
+		); err != nil {
+			return err
+		}
+	}
+	if username == "" {
+		return errors.New("username is required")
+	}
+	account, err := c.accountManager.Get(ctx, username)
+	if err != nil {
+		return err
+	}
+	account.Unlock()
+	if err := c.accountManager.Update(ctx, username, account); err != nil {
+		return err
+	}
+	c.l.Infof("User '%s' has been unlocked", username)
+	return nil
+}
+
 // ListOptions holds options for listing user accounts.
 type ListOptions struct {
 	NoHeaders bool     `mapstructure:"no-headers"`
@@ -172,6 +220,8 @@ const (
 	columnUser         = "user"
 	columnCapabilities = "capabilities"
 	columnEnabled      = "enabled"
+	columnLocked       = "locked"
+	columnMFA          = "mfa"
 )
 
 // List all user accounts in the system.
@@ -180,7 +230,7 @@ func (c *CLI) List(ctx context.Context, opts *ListOptions) error {
 		opts = &ListOptions{}
 	}
 	// Prepare table headings.
-	headings := []interface{}{columnUser, columnCapabilities, columnEnabled}
+	headings := []interface{}{columnUser, columnCapabilities, columnEnabled, columnLocked, columnMFA}
 	if len(opts.Columns) > 0 {
 		headings = []interface{}{}
 		for _, col := range opts.Columns {
@@ -201,6 +251,7 @@ func (c *CLI) List(ctx context.Context, opts *ListOptions) error {
 		return err
 	}
 
+	now := time.Now()
 	// Return a table row for the given account.
 	row := func(user string, account *accounts.Account) []any {
 		row := []any{}
@@ -212,6 +263,10 @@ func (c *CLI) List(ctx context.Context, opts *ListOptions) error {
 				row = append(row, account.Capabilities)
 			case "enabled":
 				row = append(row, account.Enabled)
+			case "locked":
+				row = append(row, account.IsLocked(now))
+			case "mfa":
+				row = append(row, account.MFAEnabled())
 			}
 		}
 		return row
@@ -235,6 +290,19 @@ func validateCredentials(username, password string) (bool, string) {
 	return true, ""
 }
 
+func validateCapabilities(capabilities []accounts.AccountCapability) error {
+	supported := []accounts.AccountCapability{
+		accounts.AccountCapabilityLogin,
+		accounts.AccountCapabilityAPIKey,
+	}
+	for _, c := range capabilities {
+		if !slices.Contains(supported, c) {
+			return fmt.Errorf("unsupported capability '%s'", c)
+		}
+	}
+	return nil
+}
+
 func validateUsername(username string) bool {
 	// Regular expression to validate username.
 	// [a-zA-Z0-9_] - Allowed characters (letters, digits, underscore)
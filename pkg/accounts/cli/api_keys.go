@@ -0,0 +1,98 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rodaine/table"
+)
+
+// APIKeysListOptions holds options for listing API keys.
+type APIKeysListOptions struct {
+	NoHeaders bool `mapstructure:"no-headers"`
+}
+
+// CreateAPIKey issues a new API key for the given user and prints it.
+// Passing a value of `0` for expiresIn creates a key that never expires.
+func (c *CLI) CreateAPIKey(ctx context.Context, username, description string, expiresIn time.Duration) error {
+	if username == "" {
+		return errors.New("username is required")
+	}
+	if expiresIn < 0 {
+		return errors.New("expiry duration cannot be negative")
+	}
+	token, key, err := c.sessionManager.CreateAPIKey(ctx, username, description, int64(expiresIn.Seconds()))
+	if err != nil {
+		return err
+	}
+	c.l.Infof("API key '%s' has been created for user '%s'", key.ID, username)
+	c.l.Info("Make sure to copy the key now, since it cannot be retrieved again")
+	fmt.Fprintln(os.Stdout, token)
+	return nil
+}
+
+// ListAPIKeys lists the API keys issued for the given user.
+func (c *CLI) ListAPIKeys(ctx context.Context, username string, opts *APIKeysListOptions) error {
+	if username == "" {
+		return errors.New("username is required")
+	}
+	if opts == nil {
+		opts = &APIKeysListOptions{}
+	}
+	keys, err := c.sessionManager.ListAPIKeys(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	tbl := table.New("id", "description", "issued at", "expires at")
+	tbl.WithHeaderFormatter(func(format string, vals ...interface{}) string {
+		if opts.NoHeaders { // Skip printing headers.
+			return ""
+		}
+		// Otherwise print in all caps.
+		return strings.ToUpper(fmt.Sprintf(format, vals...))
+	})
+	for _, k := range keys {
+		expiresAt := k.ExpiresAt
+		if expiresAt == "" {
+			expiresAt = "never"
+		}
+		tbl.AddRow(k.ID, k.Description, k.IssuedAt, expiresAt)
+	}
+	tbl.Print()
+	return nil
+}
+
+// RevokeAPIKey revokes the API key with the given ID.
+func (c *CLI) RevokeAPIKey(ctx context.Context, username, id string) error {
+	if username == "" {
+		return errors.New("username is required")
+	}
+	if id == "" {
+		return errors.New("API key ID is required")
+	}
+	if err := c.sessionManager.RevokeAPIKey(ctx, username, id); err != nil {
+		return err
+	}
+	c.l.Infof("API key '%s' has been revoked", id)
+	return nil
+}
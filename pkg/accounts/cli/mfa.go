@@ -0,0 +1,77 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/percona/everest/pkg/totp"
+)
+
+// totpIssuer is the issuer shown for Everest accounts in authenticator apps.
+const totpIssuer = "Everest"
+
+// EnableMFA enrolls the given user in TOTP based MFA and prints the secret along with the recovery codes.
+func (c *CLI) EnableMFA(ctx context.Context, username string) error {
+	if username == "" {
+		return errors.New("username is required")
+	}
+	account, err := c.accountManager.Get(ctx, username)
+	if err != nil {
+		return err
+	}
+	if account.MFAEnabled() {
+		return fmt.Errorf("MFA is already enabled for user '%s', disable it first for enrolling again", username)
+	}
+	secret, recoveryCodes, err := account.EnableMFA()
+	if err != nil {
+		return err
+	}
+	if err := c.accountManager.Update(ctx, username, account); err != nil {
+		return errors.Join(err, errors.New("failed to enable MFA"))
+	}
+
+	c.l.Infof("MFA has been enabled for user '%s'", username)
+	c.l.Info("Add the secret to an authenticator app and store the recovery codes in a safe place, " +
+		"since they cannot be retrieved again")
+	fmt.Fprintf(os.Stdout, "Secret: %s\n", secret)
+	fmt.Fprintf(os.Stdout, "URI: %s\n", totp.URI(totpIssuer, username, secret))
+	fmt.Fprintln(os.Stdout, "Recovery codes:")
+	for _, code := range recoveryCodes {
+		fmt.Fprintf(os.Stdout, "  %s\n", code)
+	}
+	return nil
+}
+
+// DisableMFA removes the second factor of the given user.
+func (c *CLI) DisableMFA(ctx context.Context, username string) error {
+	if username == "" {
+		return errors.New("username is required")
+	}
+	account, err := c.accountManager.Get(ctx, username)
+	if err != nil {
+		return err
+	}
+	account.DisableMFA()
+	if err := c.accountManager.Update(ctx, username, account); err != nil {
+		return errors.Join(err, errors.New("failed to disable MFA"))
+	}
+	c.l.Infof("MFA has been disabled for user '%s'", username)
+	return nil
+}
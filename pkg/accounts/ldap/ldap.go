@@ -0,0 +1,379 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ldap provides an implementation of the accounts interface backed by an LDAP directory.
+// The passwords of the accounts are verified by binding to the directory as the user.
+package ldap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	goldap "github.com/go-ldap/ldap/v3"
+	"gopkg.in/yaml.v2"
+
+	"github.com/percona/everest/pkg/accounts"
+	"github.com/percona/everest/pkg/session"
+)
+
+// objectClasses of the entries created for new accounts.
+var objectClasses = []string{"top", "person", "organizationalPerson", "inetOrgPerson"}
+
+// Config holds the configuration of the LDAP accounts backend.
+type Config struct {
+	// URL of the LDAP server, e.g. `ldaps://ldap.example.com:636`.
+	URL string `envconfig:"URL"`
+	// BindDN is the DN of the service account used for managing the accounts.
+	BindDN string `envconfig:"BIND_DN"`
+	// BindPassword is the password of the service account.
+	BindPassword string `envconfig:"BIND_PASSWORD"`
+	// UsersDN is the DN of the entry that holds the user accounts.
+	UsersDN string `envconfig:"USERS_DN"`
+	// UserAttribute is the attribute that holds the username.
+	// It is used as the RDN of the user entries.
+	UserAttribute string `default:"uid" envconfig:"USER_ATTRIBUTE"`
+	// AccountAttribute is the attribute that holds the Everest specific
+	// settings of an account, such as its capabilities and MFA secret.
+	// It must be an attribute dedicated to Everest that the directory allows
+	// only the service account to read and write.
+	AccountAttribute string `envconfig:"ACCOUNT_ATTRIBUTE"`
+}
+
+// conn is the subset of the LDAP client used by the accounts backend.
+type conn interface {
+	Bind(username, password string) error
+	Search(req *goldap.SearchRequest) (*goldap.SearchResult, error)
+	Add(req *goldap.AddRequest) error
+	Modify(req *goldap.ModifyRequest) error
+	Del(req *goldap.DelRequest) error
+	PasswordModify(req *goldap.PasswordModifyRequest) (*goldap.PasswordModifyResult, error)
+	Close() error
+}
+
+type ldapClient struct {
+	cfg      Config
+	dial     func() (conn, error)
+	denylist *session.DenylistStore
+}
+
+// Option is a function that modifies the LDAP accounts backend.
+type Option func(*ldapClient)
+
+// WithDenylist sets the store used for revoking the sessions of
+// accounts that are deleted or have their password changed.
+func WithDenylist(d *session.DenylistStore) Option {
+	return func(c *ldapClient) {
+		c.denylist = d
+	}
+}
+
+// New returns an implementation of the accounts interface that
+// manages Everest accounts in an LDAP directory.
+//
+//nolint:ireturn
+func New(cfg Config, opts ...Option) (accounts.Interface, error) {
+	if cfg.URL == "" || cfg.UsersDN == "" {
+		return nil, errors.New("LDAP URL and users DN must be set")
+	}
+	if cfg.AccountAttribute == "" {
+		return nil, errors.New("LDAP account attribute must be set")
+	}
+	return newClient(cfg, func() (conn, error) {
+		l, err := goldap.DialURL(cfg.URL)
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	}, opts...), nil
+}
+
+func newClient(cfg Config, dial func() (conn, error), opts ...Option) *ldapClient {
+	if cfg.UserAttribute == "" {
+		cfg.UserAttribute = "uid"
+	}
+	c := &ldapClient{
+		cfg:  cfg,
+		dial: dial,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// connect opens a new connection to the directory, bound as the service account.
+func (c *ldapClient) connect() (conn, error) {
+	l, err := c.dial()
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to connect to LDAP server"))
+	}
+	if c.cfg.BindDN != "" {
+		if err := l.Bind(c.cfg.BindDN, c.cfg.BindPassword); err != nil {
+			l.Close() //nolint:errcheck,gosec
+			return nil, errors.Join(err, errors.New("failed to bind to LDAP server"))
+		}
+	}
+	return l, nil
+}
+
+func (c *ldapClient) userDN(username string) string {
+	return fmt.Sprintf("%s=%s,%s", c.cfg.UserAttribute, goldap.EscapeDN(username), c.cfg.UsersDN)
+}
+
+// Get returns an account by username.
+func (c *ldapClient) Get(_ context.Context, username string) (*accounts.Account, error) {
+	l, err := c.connect()
+	if err != nil {
+		return nil, err
+	}
+	defer l.Close() //nolint:errcheck
+
+	res, err := l.Search(c.searchRequest(c.userDN(username), goldap.ScopeBaseObject))
+	if goldap.IsErrorWithCode(err, goldap.LDAPResultNoSuchObject) {
+		return nil, accounts.ErrAccountNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	if len(res.Entries) == 0 {
+		return nil, accounts.ErrAccountNotFound
+	}
+	return c.accountFromEntry(res.Entries[0])
+}
+
+// List returns a list of all accounts.
+func (c *ldapClient) List(_ context.Context) (map[string]*accounts.Account, error) {
+	l, err := c.connect()
+	if err != nil {
+		return nil, err
+	}
+	defer l.Close() //nolint:errcheck
+
+	res, err := l.Search(c.searchRequest(c.cfg.UsersDN, goldap.ScopeSingleLevel))
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]*accounts.Account, len(res.Entries))
+	for _, entry := range res.Entries {
+		account, err := c.accountFromEntry(entry)
+		if err != nil {
+			return nil, err
+		}
+		result[entry.GetAttributeValue(c.cfg.UserAttribute)] = account
+	}
+	return result, nil
+}
+
+// Create a new user account.
+func (c *ldapClient) Create(ctx context.Context, username, password string) error {
+	// Ensure that the user does not already exist.
+	_, err := c.Get(ctx, username)
+	if err != nil && !errors.Is(err, accounts.ErrAccountNotFound) {
+		return errors.Join(err, errors.New("failed to check if account already exists"))
+	} else if err == nil {
+		return accounts.ErrUserAlreadyExists
+	}
+
+	if password == "" {
+		return errors.New("password cannot be empty")
+	}
+
+	account := &accounts.Account{
+		Enabled:       true,
+		Capabilities:  []accounts.AccountCapability{accounts.AccountCapabilityLogin},
+		PasswordMtime: time.Now().Format(time.RFC3339),
+	}
+	raw, err := marshalAccount(account)
+	if err != nil {
+		return err
+	}
+
+	l, err := c.connect()
+	if err != nil {
+		return err
+	}
+	defer l.Close() //nolint:errcheck
+
+	dn := c.userDN(username)
+	req := goldap.NewAddRequest(dn, nil)
+	req.Attribute("objectClass", objectClasses)
+	req.Attribute(c.cfg.UserAttribute, []string{username})
+	req.Attribute("cn", []string{username})
+	req.Attribute("sn", []string{username})
+	req.Attribute(c.cfg.AccountAttribute, []string{raw})
+	if err := l.Add(req); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultEntryAlreadyExists) {
+			return accounts.ErrUserAlreadyExists
+		}
+		return errors.Join(err, errors.New("failed to create LDAP entry"))
+	}
+	// Let the directory hash the password according to its own policy.
+	if _, err := l.PasswordModify(goldap.NewPasswordModifyRequest(dn, "", password)); err != nil {
+		return errors.Join(err, errors.New("failed to set password"))
+	}
+	return nil
+}
+
+// SetPassword sets a new password for an existing user account.
+// The password is hashed by the directory.
+func (c *ldapClient) SetPassword(ctx context.Context, username, newPassword string) error {
+	account, err := c.Get(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	l, err := c.connect()
+	if err != nil {
+		return err
+	}
+	defer l.Close() //nolint:errcheck
+
+	if _, err := l.PasswordModify(goldap.NewPasswordModifyRequest(c.userDN(username), "", newPassword)); err != nil {
+		return errors.Join(err, errors.New("failed to set password"))
+	}
+	account.PasswordMtime = time.Now().Format(time.RFC3339)
+	if err := c.updateAccount(l, username, account); err != nil {
+		return err
+	}
+	// Sessions created with the old password should no longer be valid.
+	if c.denylist != nil {
+		if err := c.denylist.RevokeUser(ctx, session.Subject(username, accounts.AccountCapabilityLogin)); err != nil {
+			return errors.Join(err, errors.New("failed to revoke user sessions"))
+		}
+	}
+	return nil
+}
+
+// Update an existing user account.
+// The password of the account is managed by the directory, so it is not updated.
+func (c *ldapClient) Update(ctx context.Context, username string, account *accounts.Account) error {
+	if _, err := c.Get(ctx, username); err != nil {
+		return err
+	}
+
+	l, err := c.connect()
+	if err != nil {
+		return err
+	}
+	defer l.Close() //nolint:errcheck
+
+	return c.updateAccount(l, username, account)
+}
+
+func (c *ldapClient) updateAccount(l conn, username string, account *accounts.Account) error {
+	raw, err := marshalAccount(account)
+	if err != nil {
+		return err
+	}
+	req := goldap.NewModifyRequest(c.userDN(username), nil)
+	req.Replace(c.cfg.AccountAttribute, []string{raw})
+	if err := l.Modify(req); err != nil {
+		return errors.Join(err, errors.New("failed to update LDAP entry"))
+	}
+	return nil
+}
+
+// Delete an existing account.
+func (c *ldapClient) Delete(ctx context.Context, username string) error {
+	l, err := c.connect()
+	if err != nil {
+		return err
+	}
+	defer l.Close() //nolint:errcheck
+
+	if err := l.Del(goldap.NewDelRequest(c.userDN(username), nil)); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultNoSuchObject) {
+			return accounts.ErrAccountNotFound
+		}
+		return errors.Join(err, errors.New("failed to delete LDAP entry"))
+	}
+	if c.denylist != nil {
+		if err := c.denylist.RevokeUser(ctx, username); err != nil {
+			return errors.Join(err, errors.New("failed to revoke user sessions"))
+		}
+	}
+	return nil
+}
+
+// Verify the credentials of an account by binding to the directory as the user.
+func (c *ldapClient) Verify(ctx context.Context, username, password string) error {
+	if _, err := c.Get(ctx, username); err != nil {
+		return err
+	}
+	if password == "" {
+		// An empty password results in an unauthenticated bind, which always succeeds.
+		return accounts.ErrIncorrectPassword
+	}
+
+	l, err := c.dial()
+	if err != nil {
+		return errors.Join(err, errors.New("failed to connect to LDAP server"))
+	}
+	defer l.Close() //nolint:errcheck
+
+	if err := l.Bind(c.userDN(username), password); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			return accounts.ErrIncorrectPassword
+		}
+		return err
+	}
+	return nil
+}
+
+// IsSecure returns true if the password of the account is stored securely.
+// Passwords are always hashed by the directory.
+func (c *ldapClient) IsSecure(ctx context.Context, username string) (bool, error) {
+	if _, err := c.Get(ctx, username); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (c *ldapClient) searchRequest(baseDN string, scope int) *goldap.SearchRequest {
+	return goldap.NewSearchRequest(
+		baseDN, scope, goldap.NeverDerefAliases, 0, 0, false,
+		"(objectClass=*)",
+		[]string{c.cfg.UserAttribute, c.cfg.AccountAttribute},
+		nil,
+	)
+}
+
+// accountFromEntry returns the account stored in the given entry.
+// Entries that were not created by Everest hold no account settings,
+// so they are treated as disabled accounts until an administrator enables them.
+func (c *ldapClient) accountFromEntry(entry *goldap.Entry) (*accounts.Account, error) {
+	account := &accounts.Account{}
+	raw := entry.GetAttributeValue(c.cfg.AccountAttribute)
+	if raw == "" {
+		return account, nil
+	}
+	if err := yaml.Unmarshal([]byte(raw), account); err != nil {
+		return nil, errors.Join(err, fmt.Errorf("failed to parse account of %s", entry.DN))
+	}
+	return account, nil
+}
+
+func marshalAccount(account *accounts.Account) (string, error) {
+	// The password is managed by the directory.
+	a := *account
+	a.PasswordHash = ""
+	a.PasswordHistory = nil
+	raw, err := yaml.Marshal(a)
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
@@ -0,0 +1,225 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ldap
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/everest/pkg/accounts"
+)
+
+const (
+	testUsersDN  = "ou=users,dc=example,dc=org"
+	testBindDN   = "cn=admin,dc=example,dc=org"
+	testPassword = "admin-password"
+	testAttr     = "everestAccount"
+)
+
+// directory is an in-memory stand-in for an LDAP server.
+type directory struct {
+	mu        sync.Mutex
+	entries   map[string]map[string][]string
+	passwords map[string]string
+}
+
+func newDirectory() *directory {
+	return &directory{
+		entries:   map[string]map[string][]string{testUsersDN: {"ou": {"users"}}},
+		passwords: map[string]string{testBindDN: testPassword},
+	}
+}
+
+// fakeConn is a connection to the in-memory directory.
+type fakeConn struct {
+	d *directory
+}
+
+func (c *fakeConn) Bind(username, password string) error {
+	c.d.mu.Lock()
+	defer c.d.mu.Unlock()
+	if pw, found := c.d.passwords[username]; !found || pw != password {
+		return goldap.NewError(goldap.LDAPResultInvalidCredentials, errors.New("invalid credentials"))
+	}
+	return nil
+}
+
+func (c *fakeConn) Search(req *goldap.SearchRequest) (*goldap.SearchResult, error) {
+	c.d.mu.Lock()
+	defer c.d.mu.Unlock()
+	if _, found := c.d.entries[req.BaseDN]; !found {
+		return nil, goldap.NewError(goldap.LDAPResultNoSuchObject, errors.New("no such object"))
+	}
+	res := &goldap.SearchResult{}
+	for dn, attrs := range c.d.entries {
+		switch req.Scope {
+		case goldap.ScopeBaseObject:
+			if dn != req.BaseDN {
+				continue
+			}
+		case goldap.ScopeSingleLevel:
+			_, parent, _ := strings.Cut(dn, ",")
+			if parent != req.BaseDN {
+				continue
+			}
+		}
+		res.Entries = append(res.Entries, goldap.NewEntry(dn, attrs))
+	}
+	return res, nil
+}
+
+func (c *fakeConn) Add(req *goldap.AddRequest) error {
+	c.d.mu.Lock()
+	defer c.d.mu.Unlock()
+	if _, found := c.d.entries[req.DN]; found {
+		return goldap.NewError(goldap.LDAPResultEntryAlreadyExists, errors.New("entry already exists"))
+	}
+	attrs := make(map[string][]string, len(req.Attributes))
+	for _, a := range req.Attributes {
+		attrs[a.Type] = a.Vals
+	}
+	c.d.entries[req.DN] = attrs
+	return nil
+}
+
+func (c *fakeConn) Modify(req *goldap.ModifyRequest) error {
+	c.d.mu.Lock()
+	defer c.d.mu.Unlock()
+	attrs, found := c.d.entries[req.DN]
+	if !found {
+		return goldap.NewError(goldap.LDAPResultNoSuchObject, errors.New("no such object"))
+	}
+	for _, change := range req.Changes {
+		attrs[change.Modification.Type] = change.Modification.Vals
+	}
+	return nil
+}
+
+func (c *fakeConn) Del(req *goldap.DelRequest) error {
+	c.d.mu.Lock()
+	defer c.d.mu.Unlock()
+	if _, found := c.d.entries[req.DN]; !found {
+		return goldap.NewError(goldap.LDAPResultNoSuchObject, errors.New("no such object"))
+	}
+	delete(c.d.entries, req.DN)
+	delete(c.d.passwords, req.DN)
+	return nil
+}
+
+func (c *fakeConn) PasswordModify(req *goldap.PasswordModifyRequest) (*goldap.PasswordModifyResult, error) {
+	c.d.mu.Lock()
+	defer c.d.mu.Unlock()
+	if _, found := c.d.entries[req.UserIdentity]; !found {
+		return nil, goldap.NewError(goldap.LDAPResultNoSuchObject, errors.New("no such object"))
+	}
+	c.d.passwords[req.UserIdentity] = req.NewPassword
+	return &goldap.PasswordModifyResult{}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func TestAccounts(t *testing.T) {
+	t.Parallel()
+	d := newDirectory()
+	c := newClient(Config{
+		BindDN:           testBindDN,
+		BindPassword:     testPassword,
+		UsersDN:          testUsersDN,
+		AccountAttribute: testAttr,
+	}, func() (conn, error) {
+		return &fakeConn{d: d}, nil
+	})
+
+	accounts.Tests(t, c)
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+	d := newDirectory()
+	c := newClient(Config{
+		BindDN:           testBindDN,
+		BindPassword:     testPassword,
+		UsersDN:          testUsersDN,
+		AccountAttribute: testAttr,
+	}, func() (conn, error) {
+		return &fakeConn{d: d}, nil
+	})
+	// Entries that were not created by Everest can be verified, but are disabled.
+	d.entries["uid=alice,"+testUsersDN] = map[string][]string{"uid": {"alice"}}
+	d.passwords["uid=alice,"+testUsersDN] = "alice-password"
+
+	testCases := []struct {
+		description string
+		username    string
+		password    string
+		err         error
+	}{
+		{
+			description: "correct password",
+			username:    "alice",
+			password:    "alice-password",
+		},
+		{
+			description: "incorrect password",
+			username:    "alice",
+			password:    "wrong-password",
+			err:         accounts.ErrIncorrectPassword,
+		},
+		{
+			description: "empty password",
+			username:    "alice",
+			password:    "",
+			err:         accounts.ErrIncorrectPassword,
+		},
+		{
+			description: "unknown user",
+			username:    "bob",
+			password:    "alice-password",
+			err:         accounts.ErrAccountNotFound,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+			err := c.Verify(context.Background(), tc.username, tc.password)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			account, err := c.Get(context.Background(), tc.username)
+			require.NoError(t, err)
+			require.False(t, account.Enabled)
+			require.False(t, account.HasCapability(accounts.AccountCapabilityLogin))
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	_, err := New(Config{URL: "ldaps://ldap.example.com:636", UsersDN: testUsersDN})
+	require.Error(t, err)
+	_, err = New(Config{URL: "ldaps://ldap.example.com:636", UsersDN: testUsersDN, AccountAttribute: testAttr})
+	require.NoError(t, err)
+}
@@ -0,0 +1,105 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accounts
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/percona/everest/pkg/totp"
+)
+
+const (
+	recoveryCodeCount  = 10
+	recoveryCodeLength = 10
+)
+
+// MFAEnabled returns true if the account requires a second factor for logging in.
+func (a Account) MFAEnabled() bool {
+	return a.TOTPSecret != ""
+}
+
+// EnableMFA enrolls the account in TOTP based MFA, replacing any previous enrollment.
+// Returns the new TOTP secret along with the recovery codes, which are only stored as hashes.
+func (a *Account) EnableMFA() (string, []string, error) {
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return "", nil, errors.Join(err, errors.New("failed to generate TOTP secret"))
+	}
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for range recoveryCodeCount {
+		b := make([]byte, recoveryCodeLength/2) //nolint:mnd
+		if _, err := rand.Read(b); err != nil {
+			return "", nil, errors.Join(err, errors.New("failed to generate recovery code"))
+		}
+		code := hex.EncodeToString(b)
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	a.TOTPSecret = secret
+	a.TOTPLastStep = 0
+	a.RecoveryCodes = hashes
+	return secret, codes, nil
+}
+
+// DisableMFA removes the TOTP secret and the recovery codes of the account.
+func (a *Account) DisableMFA() {
+	a.TOTPSecret = ""
+	a.TOTPLastStep = 0
+	a.RecoveryCodes = nil
+}
+
+// VerifyMFACode returns true if the given code is a valid TOTP code or an unused recovery code.
+// Accepted codes are recorded in the account so that they cannot be used again,
+// so the account must be stored after a successful verification.
+func (a *Account) VerifyMFACode(code string, now time.Time) (bool, error) {
+	if !a.MFAEnabled() {
+		return false, nil
+	}
+	code = strings.TrimSpace(code)
+	step, ok, err := totp.Validate(a.TOTPSecret, code, now)
+	if err != nil {
+		return false, err
+	}
+	if ok && step > a.TOTPLastStep {
+		a.TOTPLastStep = step
+		return true, nil
+	}
+
+	hash := hashRecoveryCode(strings.ToLower(code))
+	idx := slices.IndexFunc(a.RecoveryCodes, func(h string) bool {
+		return subtle.ConstantTimeCompare([]byte(h), []byte(hash)) == 1
+	})
+	if idx < 0 {
+		return false, nil
+	}
+	a.RecoveryCodes = slices.Delete(a.RecoveryCodes, idx, idx+1)
+	return true, nil
+}
+
+// hashRecoveryCode returns the hash of a recovery code.
+// Recovery codes are random, so they do not need a slow password hash.
+func hashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
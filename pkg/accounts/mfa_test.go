@@ -0,0 +1,82 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accounts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/everest/pkg/totp"
+)
+
+func TestVerifyMFACode(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+
+	a := &Account{}
+	ok, err := a.VerifyMFACode("123456", now)
+	require.NoError(t, err)
+	assert.False(t, ok, "MFA is not enabled")
+
+	secret, codes, err := a.EnableMFA()
+	require.NoError(t, err)
+	require.True(t, a.MFAEnabled())
+	require.Len(t, codes, recoveryCodeCount)
+	assert.NotContains(t, a.RecoveryCodes, codes[0], "recovery codes must be stored hashed")
+
+	t.Run("TOTP code cannot be replayed", func(t *testing.T) {
+		code, err := totp.Code(secret, totp.Step(now))
+		require.NoError(t, err)
+		ok, err := a.VerifyMFACode(code, now)
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		ok, err = a.VerifyMFACode(code, now)
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		// Codes of earlier steps are rejected once a later one was used.
+		code, err = totp.Code(secret, totp.Step(now)-1)
+		require.NoError(t, err)
+		ok, err = a.VerifyMFACode(code, now)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("recovery code can be used once", func(t *testing.T) {
+		ok, err := a.VerifyMFACode(codes[0], now)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Len(t, a.RecoveryCodes, recoveryCodeCount-1)
+
+		ok, err = a.VerifyMFACode(codes[0], now)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("invalid code", func(t *testing.T) {
+		ok, err := a.VerifyMFACode("not-a-code", now)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	a.DisableMFA()
+	assert.False(t, a.MFAEnabled())
+	assert.Empty(t, a.RecoveryCodes)
+}
@@ -0,0 +1,112 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accounts
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var (
+	// ErrPasswordPolicyViolation is returned when a password does not meet the password policy.
+	ErrPasswordPolicyViolation = errors.New("password does not meet the password policy")
+	// ErrPasswordExpired is returned when the password of an account is older than allowed by the password policy.
+	ErrPasswordExpired = errors.New("password expired")
+
+	//go:embed common_passwords.txt
+	commonPasswordsRaw string
+	commonPasswords    = strings.Fields(commonPasswordsRaw)
+)
+
+// PasswordPolicy holds the rules that the passwords of local accounts must follow.
+type PasswordPolicy struct {
+	// MinLength is the minimum number of characters of a password.
+	MinLength int `yaml:"minLength,omitempty"`
+	// RequireUppercase requires passwords to contain an uppercase letter.
+	RequireUppercase bool `yaml:"requireUppercase,omitempty"`
+	// RequireLowercase requires passwords to contain a lowercase letter.
+	RequireLowercase bool `yaml:"requireLowercase,omitempty"`
+	// RequireDigit requires passwords to contain a digit.
+	RequireDigit bool `yaml:"requireDigit,omitempty"`
+	// RequireSymbol requires passwords to contain a character that is not a letter or a digit.
+	RequireSymbol bool `yaml:"requireSymbol,omitempty"`
+	// RejectCommonPasswords rejects the passwords found in a built-in list of common passwords.
+	RejectCommonPasswords bool `yaml:"rejectCommonPasswords,omitempty"`
+	// RejectList holds additional passwords that are rejected, e.g. known breached passwords.
+	// Passwords are compared case-insensitively.
+	RejectList []string `yaml:"rejectList,omitempty"`
+	// HistorySize is the number of most recent passwords that cannot be reused,
+	// including the current one. A value of `0` allows reusing passwords.
+	HistorySize int `yaml:"historySize,omitempty"`
+	// MaxAge is the time after which a password expires and must be changed.
+	// A value of `0` disables password expiry.
+	MaxAge time.Duration `yaml:"maxAge,omitempty"`
+}
+
+// Validate returns an error listing the rules of the policy the given password violates.
+func (p PasswordPolicy) Validate(password string) error {
+	var violations []string
+	if p.MinLength > 0 && len([]rune(password)) < p.MinLength {
+		violations = append(violations, fmt.Sprintf("must be at least %d characters long", p.MinLength))
+	}
+	classes := []struct {
+		required bool
+		matches  func(r rune) bool
+		name     string
+	}{
+		{p.RequireUppercase, unicode.IsUpper, "an uppercase letter"},
+		{p.RequireLowercase, unicode.IsLower, "a lowercase letter"},
+		{p.RequireDigit, unicode.IsDigit, "a digit"},
+		{p.RequireSymbol, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}, "a symbol"},
+	}
+	for _, c := range classes {
+		if c.required && !strings.ContainsFunc(password, c.matches) {
+			violations = append(violations, "must contain "+c.name)
+		}
+	}
+	isRejected := func(rejected string) bool {
+		return strings.EqualFold(rejected, password)
+	}
+	if (p.RejectCommonPasswords && slices.ContainsFunc(commonPasswords, isRejected)) ||
+		slices.ContainsFunc(p.RejectList, isRejected) {
+		violations = append(violations, "is too common")
+	}
+	if len(violations) > 0 {
+		return fmt.Errorf("%w: password %s", ErrPasswordPolicyViolation, strings.Join(violations, ", "))
+	}
+	return nil
+}
+
+// IsExpired returns true if the password of the given account has expired at the given time.
+// Accounts without a password modification time are considered expired,
+// which allows forcing a password change on the next login.
+func (p PasswordPolicy) IsExpired(a Account, now time.Time) bool {
+	if p.MaxAge <= 0 {
+		return false
+	}
+	mtime, err := time.Parse(time.RFC3339, a.PasswordMtime)
+	if err != nil {
+		return true
+	}
+	return now.After(mtime.Add(p.MaxAge))
+}
@@ -0,0 +1,117 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accounts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordPolicyValidate(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		description string
+		policy      PasswordPolicy
+		password    string
+		valid       bool
+	}{
+		{
+			description: "empty policy",
+			policy:      PasswordPolicy{},
+			password:    "a",
+			valid:       true,
+		},
+		{
+			description: "too short",
+			policy:      PasswordPolicy{MinLength: 8},
+			password:    "short",
+			valid:       false,
+		},
+		{
+			description: "length counts characters",
+			policy:      PasswordPolicy{MinLength: 4},
+			password:    "пароль",
+			valid:       true,
+		},
+		{
+			description: "all character classes",
+			policy: PasswordPolicy{
+				RequireUppercase: true,
+				RequireLowercase: true,
+				RequireDigit:     true,
+				RequireSymbol:    true,
+			},
+			password: "Secret-123",
+			valid:    true,
+		},
+		{
+			description: "missing symbol",
+			policy:      PasswordPolicy{RequireSymbol: true},
+			password:    "Secret123",
+			valid:       false,
+		},
+		{
+			description: "missing uppercase letter",
+			policy:      PasswordPolicy{RequireUppercase: true},
+			password:    "secret-123",
+			valid:       false,
+		},
+		{
+			description: "common password",
+			policy:      PasswordPolicy{RejectCommonPasswords: true},
+			password:    "Password123",
+			valid:       false,
+		},
+		{
+			description: "common password allowed",
+			policy:      PasswordPolicy{},
+			password:    "password123",
+			valid:       true,
+		},
+		{
+			description: "rejected password",
+			policy:      PasswordPolicy{RejectList: []string{"Hunter2"}},
+			password:    "hunter2",
+			valid:       false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+			err := tc.policy.Validate(tc.password)
+			if tc.valid {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrPasswordPolicyViolation)
+		})
+	}
+}
+
+func TestPasswordPolicyIsExpired(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	policy := PasswordPolicy{MaxAge: time.Hour}
+
+	assert.False(t, policy.IsExpired(Account{PasswordMtime: now.Format(time.RFC3339)}, now))
+	assert.True(t, policy.IsExpired(Account{PasswordMtime: now.Add(-2 * time.Hour).Format(time.RFC3339)}, now))
+	// An empty modification time forces a password change.
+	assert.True(t, policy.IsExpired(Account{}, now))
+	assert.False(t, PasswordPolicy{}.IsExpired(Account{}, now))
+}
@@ -51,12 +51,29 @@ func Tests(t *testing.T, p Interface) {
 	require.NoError(t, err)
 
 	// Update password for user1.
-	err = p.SetPassword(ctx, "user1", "updated-password1", true)
+	err = p.SetPassword(ctx, "user1", "updated-password1")
 	require.NoError(t, err)
 	// Verify updated password.
 	err = p.Verify(ctx, "user1", "updated-password1")
 	require.NoError(t, err)
 
+	// Update user1.
+	user1, err = p.Get(ctx, "user1")
+	require.NoError(t, err)
+	user1.Capabilities = append(user1.Capabilities, AccountCapabilityAPIKey)
+	err = p.Update(ctx, "user1", user1)
+	require.NoError(t, err)
+	user1, err = p.Get(ctx, "user1")
+	require.NoError(t, err)
+	assert.True(t, user1.HasCapability(AccountCapabilityAPIKey))
+	// Password should not change on update.
+	err = p.Verify(ctx, "user1", "updated-password1")
+	require.NoError(t, err)
+
+	// Update a non-existent account.
+	err = p.Update(ctx, "user2", user1)
+	require.ErrorIs(t, err, ErrAccountNotFound)
+
 	// Delete user1.
 	err = p.Delete(ctx, "user1")
 	require.NoError(t, err)
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"slices"
+	"time"
 )
 
 // AccountCapability represents a capability of an account.
@@ -36,6 +37,10 @@ var (
 	ErrAccountDisabled = errors.New("account disabled")
 	// ErrUserAlreadyExists is returned when we try to create a user that already exists.
 	ErrUserAlreadyExists = errors.New("user already exists")
+	// ErrAPIKeyNotFound is returned when an API key is not found.
+	ErrAPIKeyNotFound = errors.New("API key not found")
+	// ErrAccountLocked is returned when the account is locked after too many failed login attempts.
+	ErrAccountLocked = errors.New("account locked")
 )
 
 const (
@@ -51,6 +56,40 @@ type Account struct {
 	Capabilities  []AccountCapability `yaml:"capabilities"`
 	PasswordMtime string              `yaml:"passwordMtime"`
 	PasswordHash  string              `yaml:"passwordHash"`
+	// PasswordHistory holds the hashes of the previous passwords, most recent first.
+	PasswordHistory []string `yaml:"passwordHistory,omitempty"`
+	APIKeys         []APIKey `yaml:"apiKeys,omitempty"`
+	// FailedLogins holds the number of consecutive failed login attempts.
+	FailedLogins int `yaml:"failedLogins,omitempty"`
+	// LockedUntil holds the time until which the account is locked, in RFC3339 format.
+	LockedUntil string `yaml:"lockedUntil,omitempty"`
+	// TOTPSecret holds the secret of the TOTP second factor. MFA is enabled if it is set.
+	TOTPSecret string `yaml:"totpSecret,omitempty"`
+	// TOTPLastStep holds the time step of the last accepted TOTP code, so that codes cannot be replayed.
+	TOTPLastStep int64 `yaml:"totpLastStep,omitempty"`
+	// RecoveryCodes holds the SHA-256 hashes of the unused MFA recovery codes.
+	RecoveryCodes []string `yaml:"recoveryCodes,omitempty"`
+	// ResourceVersion holds the version of the storage the account was read from, if the storage is versioned.
+	// Updating an account that was changed since it was read fails with a conflict.
+	ResourceVersion string `yaml:"-"`
+}
+
+// LockoutPolicy configures when accounts are locked after failed login attempts.
+type LockoutPolicy struct {
+	// Threshold is the number of consecutive failed login attempts after which the account is locked.
+	// A value of `0` disables the lockout.
+	Threshold int
+	// Duration is the time for which the account stays locked.
+	Duration time.Duration
+}
+
+// APIKey holds the metadata of an API key issued for an account.
+// The key itself is never stored.
+type APIKey struct {
+	ID          string `yaml:"id"`
+	Description string `yaml:"description,omitempty"`
+	IssuedAt    string `yaml:"issuedAt"`
+	ExpiresAt   string `yaml:"expiresAt,omitempty"`
 }
 
 // HasCapability returns true if the given account has the specified capability.
@@ -58,13 +97,44 @@ func (a Account) HasCapability(c AccountCapability) bool {
 	return slices.Contains(a.Capabilities, c)
 }
 
+// IsLocked returns true if the account is locked at the given time.
+func (a Account) IsLocked(now time.Time) bool {
+	if a.LockedUntil == "" {
+		return false
+	}
+	lockedUntil, err := time.Parse(time.RFC3339, a.LockedUntil)
+	if err != nil {
+		// Fail closed, the account can still be unlocked by an administrator.
+		return true
+	}
+	return now.Before(lockedUntil)
+}
+
+// RecordFailedLogin counts a failed login attempt at the given time.
+// Once the threshold of the policy is reached, the account is locked
+// and the counter starts over.
+func (a *Account) RecordFailedLogin(p LockoutPolicy, now time.Time) {
+	a.FailedLogins++
+	if p.Threshold > 0 && a.FailedLogins >= p.Threshold {
+		a.LockedUntil = now.Add(p.Duration).UTC().Format(time.RFC3339)
+		a.FailedLogins = 0
+	}
+}
+
+// Unlock unlocks the account and resets its failed login attempts.
+func (a *Account) Unlock() {
+	a.FailedLogins = 0
+	a.LockedUntil = ""
+}
+
 // Interface provides the methods for managing Everest user accounts.
 type Interface interface {
 	Create(ctx context.Context, username, password string) error
 	Get(ctx context.Context, username string) (*Account, error)
 	List(ctx context.Context) (map[string]*Account, error)
 	Delete(ctx context.Context, username string) error
-	SetPassword(ctx context.Context, username, newPassword string, secure bool) error
+	Update(ctx context.Context, username string, account *Account) error
+	SetPassword(ctx context.Context, username, newPassword string) error
 	Verify(ctx context.Context, username, password string) error
 	IsSecure(ctx context.Context, username string) (bool, error)
 }
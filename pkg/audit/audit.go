@@ -0,0 +1,190 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package audit records the mutating requests made to the Everest API.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// OutcomeSuccess is the outcome of a request that completed successfully.
+	OutcomeSuccess = "success"
+	// OutcomeFailure is the outcome of a request that failed.
+	OutcomeFailure = "failure"
+
+	// Kind is set on every event, so that audit events can be told apart from other log lines.
+	Kind = "everest.audit"
+
+	queueSize = 1024
+)
+
+// Supported sink types.
+const (
+	SinkStdout  = "stdout"
+	SinkFile    = "file"
+	SinkWebhook = "webhook"
+)
+
+// Event is a record of a request made to the Everest API.
+type Event struct {
+	Kind string    `json:"kind"`
+	Time time.Time `json:"time"`
+	// User is the name of the user that made the request.
+	User string `json:"user"`
+	// Resource, Action and Object identify the request in RBAC terms.
+	Resource string `json:"resource"`
+	Action   string `json:"action"`
+	Object   string `json:"object"`
+	Method   string `json:"method"`
+	Path     string `json:"path"`
+	// RequestDigest is the SHA-256 digest of the request body with the secrets redacted.
+	RequestDigest string `json:"requestDigest,omitempty"`
+	// Request is the request body with the secrets redacted.
+	Request json.RawMessage `json:"request,omitempty"`
+	// Status is the HTTP status code of the response.
+	Status  int    `json:"status"`
+	Outcome string `json:"outcome"`
+	// LatencyMs is the time it took to handle the request, in milliseconds.
+	LatencyMs int64 `json:"latencyMs"`
+}
+
+// Sink is a destination for audit events.
+type Sink interface {
+	// Write stores the given event.
+	Write(e Event) error
+	// Close releases the resources held by the sink.
+	Close() error
+}
+
+// Config holds the configuration of the audit log.
+type Config struct {
+	// Sinks is a comma-separated list of the sinks the audit events are written to.
+	// Supported values are "stdout", "file" and "webhook". The audit log is disabled if empty.
+	Sinks []string `envconfig:"SINKS"`
+	// FilePath is the path of the file written by the file sink.
+	FilePath string `default:"/var/log/everest/audit.log" envconfig:"FILE_PATH"`
+	// FileMaxSizeMB is the size in megabytes at which the file is rotated.
+	FileMaxSizeMB int `default:"100" envconfig:"FILE_MAX_SIZE_MB"`
+	// FileMaxBackups is the number of rotated files that are kept.
+	FileMaxBackups int `default:"5" envconfig:"FILE_MAX_BACKUPS"`
+	// WebhookURL is the URL the webhook sink posts the events to.
+	WebhookURL string `envconfig:"WEBHOOK_URL"`
+	// WebhookTimeout is the timeout of the requests made by the webhook sink.
+	WebhookTimeout time.Duration `default:"5s" envconfig:"WEBHOOK_TIMEOUT"`
+}
+
+// Logger writes audit events to a set of sinks.
+// Events are written in the background, so that logging an event never blocks a request.
+type Logger struct {
+	l      *zap.SugaredLogger
+	sinks  []Sink
+	events chan Event
+	wg     sync.WaitGroup
+}
+
+// New returns a new Logger that writes the events to the given sinks.
+func New(l *zap.SugaredLogger, sinks ...Sink) *Logger {
+	a := &Logger{
+		l:      l,
+		sinks:  sinks,
+		events: make(chan Event, queueSize),
+	}
+	a.wg.Add(1)
+	go a.run()
+	return a
+}
+
+// NewFromConfig returns a new Logger with the sinks defined in the given configuration.
+// Returns nil if no sinks are configured.
+func NewFromConfig(cfg Config, l *zap.SugaredLogger) (*Logger, error) {
+	sinks := make([]Sink, 0, len(cfg.Sinks))
+	for _, name := range cfg.Sinks {
+		switch strings.TrimSpace(name) {
+		case "":
+			continue
+		case SinkStdout:
+			sinks = append(sinks, NewStdoutSink())
+		case SinkFile:
+			s, err := NewFileSink(cfg.FilePath, int64(cfg.FileMaxSizeMB)*1024*1024, cfg.FileMaxBackups)
+			if err != nil {
+				return nil, errors.Join(err, errors.New("failed to create audit file sink"))
+			}
+			sinks = append(sinks, s)
+		case SinkWebhook:
+			if cfg.WebhookURL == "" {
+				return nil, errors.New("audit webhook URL is not set")
+			}
+			sinks = append(sinks, NewWebhookSink(cfg.WebhookURL, cfg.WebhookTimeout))
+		default:
+			return nil, errors.New("unknown audit sink " + name)
+		}
+	}
+	if len(sinks) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+	return New(l, sinks...), nil
+}
+
+// Log queues the given event for writing.
+// The event is dropped if the queue is full.
+func (a *Logger) Log(e Event) {
+	e.Kind = Kind
+	select {
+	case a.events <- e:
+	default:
+		a.l.Warnf("Audit log queue is full, dropping event [%s %s %s %s]", e.User, e.Resource, e.Action, e.Object)
+	}
+}
+
+// Close writes the queued events and closes the sinks.
+// The context limits the time spent waiting for the queued events to be written.
+func (a *Logger) Close(ctx context.Context) error {
+	close(a.events)
+	done := make(chan struct{})
+	go func() {
+		a.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	var err error
+	for _, s := range a.sinks {
+		err = errors.Join(err, s.Close())
+	}
+	return err
+}
+
+func (a *Logger) run() {
+	defer a.wg.Done()
+	for e := range a.events {
+		for _, s := range a.sinks {
+			if err := s.Write(e); err != nil {
+				a.l.Error(errors.Join(err, errors.New("failed to write audit event")))
+			}
+		}
+	}
+}
@@ -0,0 +1,140 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestRedact(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		description string
+		body        string
+		expected    string
+	}{
+		{
+			description: "empty body",
+			body:        "",
+			expected:    "",
+		},
+		{
+			description: "invalid JSON",
+			body:        "not json",
+			expected:    "",
+		},
+		{
+			description: "no secrets",
+			body:        `{"name":"db","replicas":3}`,
+			expected:    `{"name":"db","replicas":3}`,
+		},
+		{
+			description: "nested secrets",
+			body:        `{"name":"s3","accessKey":"AKIA","spec":{"Password":"pwd","items":[{"token":"t","id":1}]}}`,
+			expected:    `{"accessKey":"[REDACTED]","name":"s3","spec":{"Password":"[REDACTED]","items":[{"id":1,"token":"[REDACTED]"}]}}`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, string(Redact([]byte(tc.body))))
+		})
+	}
+}
+
+func TestLogger(t *testing.T) {
+	t.Parallel()
+	buf := &bytes.Buffer{}
+	a := New(zap.NewNop().Sugar(), NewWriterSink(buf))
+	a.Log(Event{User: "alice", Resource: "database-clusters", Action: "create", Object: "ns/db"})
+	a.Log(Event{User: "bob", Resource: "database-clusters", Action: "delete", Object: "ns/db"})
+	require.NoError(t, a.Close(context.Background()))
+
+	// Audit events are read back from a stream that mixes them with other lines.
+	r := strings.NewReader("plain log line\n" + buf.String() + `{"level":"info"}` + "\n")
+	events, err := ReadLast(r, 0, Filter{})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "alice", events[0].User)
+	assert.Equal(t, Kind, events[0].Kind)
+
+	events, err = ReadLast(strings.NewReader(buf.String()), 0, Filter{Action: "delete"})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "bob", events[0].User)
+
+	events, err = ReadLast(strings.NewReader(buf.String()), 1, Filter{})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "bob", events[0].User)
+}
+
+func TestFileSink(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "audit", "audit.log")
+	s, err := NewFileSink(path, 200, 2)
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, s.Write(Event{Kind: Kind, User: "alice", Time: time.Now()}))
+	}
+	require.NoError(t, s.Close())
+
+	for _, name := range []string{path, path + ".1", path + ".2"} {
+		info, err := os.Stat(name)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, info.Size(), int64(200))
+	}
+	_, err = os.Stat(path + ".3")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestFollow(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "audit.log")
+	s, err := NewFileSink(path, 0, 0)
+	require.NoError(t, err)
+	defer s.Close() //nolint:errcheck
+	require.NoError(t, s.Write(Event{Kind: Kind, User: "alice"}))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := make(chan Event)
+	go func() {
+		_ = Follow(ctx, path, info.Size(), Filter{}, func(e Event) {
+			events <- e
+		})
+	}()
+
+	require.NoError(t, s.Write(Event{Kind: Kind, User: "bob"}))
+	select {
+	case e := <-events:
+		assert.Equal(t, "bob", e.User)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+}
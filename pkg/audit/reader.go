@@ -0,0 +1,161 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"time"
+)
+
+const (
+	maxLineSize  = 1024 * 1024
+	pollInterval = 500 * time.Millisecond
+)
+
+// Filter selects audit events. Empty fields match any value.
+type Filter struct {
+	User     string
+	Resource string
+	Action   string
+}
+
+// Match returns true if the event matches the filter.
+func (f Filter) Match(e Event) bool {
+	return (f.User == "" || f.User == e.User) &&
+		(f.Resource == "" || f.Resource == e.Resource) &&
+		(f.Action == "" || f.Action == e.Action)
+}
+
+// ParseEvent parses a line written by one of the sinks.
+// Returns false if the line is not an audit event, so that audit events can be
+// read from a stream that mixes them with other log lines, e.g. the output of the server.
+func ParseEvent(line []byte) (Event, bool) {
+	line = bytes.TrimSpace(line)
+	if len(line) == 0 || line[0] != '{' {
+		return Event{}, false
+	}
+	var e Event
+	if err := json.Unmarshal(line, &e); err != nil || e.Kind != Kind {
+		return Event{}, false
+	}
+	return e, true
+}
+
+// ReadLast returns the last n events read from r that match the filter.
+// All the matching events are returned if n is not positive.
+func ReadLast(r io.Reader, n int, f Filter) ([]Event, error) {
+	events := []Event{}
+	err := Scan(r, f, func(e Event) {
+		events = append(events, e)
+		if n > 0 && len(events) > n {
+			events = events[1:]
+		}
+	})
+	return events, err
+}
+
+// Scan calls fn for every event read from r that matches the filter, until r is exhausted.
+func Scan(r io.Reader, f Filter, fn func(Event)) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLineSize)
+	for scanner.Scan() {
+		if e, ok := ParseEvent(scanner.Bytes()); ok && f.Match(e) {
+			fn(e)
+		}
+	}
+	return scanner.Err()
+}
+
+// Follow calls fn for every event that matches the filter and is appended to the file
+// at path after offset, until the context is done.
+// Rotation of the file is detected, in which case the new file is read from the start.
+func Follow(ctx context.Context, path string, offset int64, f Filter, fn func(Event)) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		file.Close() //nolint:errcheck,gosec
+	}()
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	reader := bufio.NewReader(file)
+	var partial []byte
+	for {
+		line, err := reader.ReadBytes('\n')
+		partial = append(partial, line...)
+		if err == nil {
+			if e, ok := ParseEvent(partial); ok && f.Match(e) {
+				fn(e)
+			}
+			offset += int64(len(partial))
+			partial = nil
+			continue
+		}
+		if !errors.Is(err, io.EOF) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(pollInterval):
+		}
+
+		rotated, err := isRotated(file, path, offset+int64(len(partial)))
+		if err != nil {
+			return err
+		}
+		if !rotated {
+			continue
+		}
+		// Drain what was left in the rotated file before switching to the new one.
+		if err := Scan(io.MultiReader(bytes.NewReader(partial), reader), f, fn); err != nil {
+			return err
+		}
+		file.Close() //nolint:errcheck,gosec
+		if file, err = os.Open(path); err != nil {
+			return err
+		}
+		reader.Reset(file)
+		offset = 0
+		partial = nil
+	}
+}
+
+// isRotated returns true if the file at path is not the open file anymore, or if it was truncated.
+func isRotated(file *os.File, path string, offset int64) (bool, error) {
+	current, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		// The file is being rotated, wait for it to be created again.
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	open, err := file.Stat()
+	if err != nil {
+		return false, err
+	}
+	return !os.SameFile(open, current) || current.Size() < offset, nil
+}
@@ -0,0 +1,93 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+)
+
+const redactedValue = "[REDACTED]"
+
+// sensitiveKeys holds the lower-cased suffixes of the JSON keys whose values are redacted.
+var sensitiveKeys = []string{
+	"password",
+	"secret",
+	"token",
+	"key",
+	"credentials",
+	"code",
+}
+
+// Digest returns the hex-encoded SHA-256 digest of the given request body.
+func Digest(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+// Redact returns a copy of the given JSON request body with the values of
+// the sensitive fields, such as passwords and keys, replaced.
+// Returns nil if the body is empty or is not valid JSON.
+func Redact(body []byte) json.RawMessage {
+	if len(body) == 0 {
+		return nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return nil
+	}
+	redacted, err := json.Marshal(redact(v))
+	if err != nil {
+		return nil
+	}
+	return redacted
+}
+
+func redact(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, val := range v {
+			if isSensitive(k) {
+				v[k] = redactedValue
+				continue
+			}
+			v[k] = redact(val)
+		}
+		return v
+	case []interface{}:
+		for i, val := range v {
+			v[i] = redact(val)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func isSensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, s := range sensitiveKeys {
+		if strings.HasSuffix(key, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -53,3 +53,14 @@ func TestHelm_RenderTemplates(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, crds, 2)
 }
+
+func TestNewValues(t *testing.T) {
+	t.Parallel()
+	values := NewValues(Values{ServerProbes: true})
+	assert.Equal(t, "/livez", values["server.livenessProbe.httpGet.path"])
+	assert.Equal(t, "/readyz", values["server.readinessProbe.httpGet.path"])
+
+	values = NewValues(Values{})
+	assert.NotContains(t, values, "server.livenessProbe.httpGet.path")
+	assert.NotContains(t, values, "server.readinessProbe.httpGet.path")
+}
@@ -6,6 +6,8 @@ import "github.com/percona/everest/pkg/kubernetes"
 type Values struct {
 	ClusterType        kubernetes.ClusterType
 	VersionMetadataURL string
+	// ServerProbes points the probes of the Everest server at its health check endpoints.
+	ServerProbes bool
 }
 
 // NewValues creates a map of values that can be used to render the Helm chart.
@@ -26,5 +28,11 @@ func NewValues(v Values) map[string]string {
 	if v.VersionMetadataURL != "" {
 		values["versionMetadataURL"] = v.VersionMetadataURL
 	}
+	if v.ServerProbes {
+		// The liveness probe only checks that the server handles requests, so that it is not
+		// restarted while one of its dependencies, e.g. the Kubernetes API, is unavailable.
+		values["server.livenessProbe.httpGet.path"] = "/livez"
+		values["server.readinessProbe.httpGet.path"] = "/readyz"
+	}
 	return values
 }
@@ -234,6 +234,7 @@ func (o *Install) setupHelmInstaller(ctx context.Context) error {
 	overrides := helm.NewValues(helm.Values{
 		ClusterType:        o.clusterType,
 		VersionMetadataURL: o.config.VersionMetadataURL,
+		ServerProbes:       true,
 	})
 	values := Must(helmutils.MergeVals(o.config.Values, overrides))
 	installer := &helm.Installer{
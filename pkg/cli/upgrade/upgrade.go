@@ -209,6 +209,7 @@ func (u *Upgrade) setupHelmInstaller(ctx context.Context) error {
 	overrides := helm.NewValues(helm.Values{
 		ClusterType:        u.clusterType,
 		VersionMetadataURL: u.config.VersionMetadataURL,
+		ServerProbes:       true,
 	})
 
 	// We will apply the existing configmap and secret values to the new helm chart.
@@ -21,22 +21,29 @@ func generateRandomPassword() (string, error) {
 	return hex.EncodeToString(b), nil
 }
 
-// CreateInitialAdminAccount creates the initial admin account
-// with a random plain-text password.
+// CreateInitialAdminAccount creates the initial admin account, or resets its password
+// if it already exists, using a random password.
+// Passwords are never stored in plain text, so the generated password is returned to the caller.
 func CreateInitialAdminAccount(
 	ctx context.Context,
 	c accounts.Interface,
-) error {
+) (string, error) {
 	pass, err := generateRandomPassword()
 	if err != nil {
-		return errors.Join(err, errors.New("could not generate random password"))
+		return "", errors.Join(err, errors.New("could not generate random password"))
 	}
 	// Check if the admin account exists?
-	if _, err := c.Get(ctx, EverestAdminUser); errors.Is(err, accounts.ErrAccountNotFound) {
+	_, err = c.Get(ctx, EverestAdminUser)
+	if errors.Is(err, accounts.ErrAccountNotFound) {
 		if createErr := c.Create(ctx, EverestAdminUser, pass); createErr != nil {
-			return errors.Join(createErr, errors.New("could not create admin account"))
+			return "", errors.Join(createErr, errors.New("could not create admin account"))
 		}
+		return pass, nil
+	} else if err != nil {
+		return "", err
+	}
+	if err := c.SetPassword(ctx, EverestAdminUser, pass); err != nil {
+		return "", errors.Join(err, errors.New("could not reset admin password"))
 	}
-	// Create the admin account.
-	return c.SetPassword(ctx, EverestAdminUser, pass, false)
+	return pass, nil
 }
@@ -53,6 +53,8 @@ const (
 	EverestAccountsSecretName = "everest-accounts"
 	// EverestJWTSecretName is the name of the secret that holds JWT secret.
 	EverestJWTSecretName = "everest-jwt"
+	// EverestSessionDenylistSecretName is the name of the secret that holds revoked sessions.
+	EverestSessionDenylistSecretName = "everest-session-denylist"
 	// EverestJWTPrivateKeyFile is the path to the JWT private key.
 	EverestJWTPrivateKeyFile = "/etc/jwt/id_rsa"
 	// EverestJWTPublicKeyFile is the path to the JWT public key.
@@ -71,6 +73,10 @@ const (
 	EverestTokenCookie = "everest_token"
 	// EverestRBACConfigMapName is the name of the Everest RBAC ConfigMap.
 	EverestRBACConfigMapName = "everest-rbac"
+	// EverestRBACHistoryConfigMapName is the name of the ConfigMap that holds the previous versions of the RBAC settings.
+	EverestRBACHistoryConfigMapName = "everest-rbac-history"
+	// EverestLoginThrottleConfigMapName is the name of the ConfigMap that holds the failed login attempts.
+	EverestLoginThrottleConfigMapName = "everest-login-throttle"
 	// KubernetesManagedByLabel is the label used to identify resources managed by Everest.
 	KubernetesManagedByLabel = "app.kubernetes.io/managed-by"
 	// ForegroundDeletionFinalizer is the finalizer used to delete resources in foreground.
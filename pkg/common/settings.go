@@ -1,19 +1,78 @@
 package common
 
 import (
+	"time"
+
 	"github.com/mitchellh/mapstructure"
 	"gopkg.in/yaml.v3"
+
+	"github.com/percona/everest/pkg/accounts"
 )
 
 // EverestSettings represents the everest settings.
 type EverestSettings struct {
 	OIDCConfigRaw string `mapstructure:"oidc.config"`
+	// PasswordPolicyRaw holds the password policy of the local accounts in YAML format.
+	PasswordPolicyRaw string `mapstructure:"passwordPolicy.config,omitempty"`
 }
 
 // OIDCConfig represents the OIDC provider configuration.
+// The provider is used for logging in to the Everest UI.
 type OIDCConfig struct {
 	IssuerURL string `yaml:"issuerUrl"`
 	ClientID  string `yaml:"clientId"`
+	// GroupsClaim is the name of the token claim that holds the groups of the user.
+	// Nested claims can be specified using a dot-separated path, e.g. `realm_access.roles`.
+	// If set, RBAC policies are evaluated against each of the groups as well.
+	GroupsClaim string `yaml:"groupsClaim,omitempty"`
+	// UserPrefix is prepended to the subject and to the groups of the tokens to form the RBAC user and groups.
+	UserPrefix string `yaml:"userPrefix,omitempty"`
+	// OIDCTokenValidation holds the rules for validating the tokens of the provider.
+	OIDCTokenValidation `yaml:",inline"`
+	// AdditionalIssuers holds other OIDC providers whose tokens are accepted by the Everest API.
+	AdditionalIssuers []OIDCIssuer `yaml:"additionalIssuers,omitempty"`
+}
+
+// OIDCIssuer represents the configuration of an OIDC provider trusted by the Everest API.
+type OIDCIssuer struct {
+	IssuerURL   string `yaml:"issuerUrl"`
+	ClientID    string `yaml:"clientId"`
+	GroupsClaim string `yaml:"groupsClaim,omitempty"`
+	// UserPrefix is prepended to the subject and to the groups of the tokens to form the RBAC user and groups.
+	// It prevents subjects and groups issued by different providers from colliding in RBAC policies.
+	UserPrefix          string `yaml:"userPrefix,omitempty"`
+	OIDCTokenValidation `yaml:",inline"`
+}
+
+// OIDCTokenValidation holds the rules for validating the tokens issued by an OIDC provider.
+type OIDCTokenValidation struct {
+	// Audience is the expected audience of the tokens. Defaults to the client ID.
+	Audience string `yaml:"audience,omitempty"`
+	// Algorithms is the list of accepted signing algorithms.
+	// Defaults to the algorithms advertised by the provider.
+	Algorithms []string `yaml:"algorithms,omitempty"`
+	// ClockSkew is the tolerated difference between the clocks of Everest
+	// and the provider, when validating the time based claims of the tokens.
+	ClockSkew time.Duration `yaml:"clockSkew,omitempty"`
+	// RequiredClaims maps the names of claims to the values they must have,
+	// e.g. `email_verified: "true"`.
+	RequiredClaims map[string]string `yaml:"requiredClaims,omitempty"`
+}
+
+// Issuers returns the configuration of all trusted OIDC providers,
+// starting with the provider used by the Everest UI.
+func (c *OIDCConfig) Issuers() []OIDCIssuer {
+	issuers := make([]OIDCIssuer, 0, len(c.AdditionalIssuers)+1)
+	if c.IssuerURL != "" {
+		issuers = append(issuers, OIDCIssuer{
+			IssuerURL:           c.IssuerURL,
+			ClientID:            c.ClientID,
+			GroupsClaim:         c.GroupsClaim,
+			UserPrefix:          c.UserPrefix,
+			OIDCTokenValidation: c.OIDCTokenValidation,
+		})
+	}
+	return append(issuers, c.AdditionalIssuers...)
 }
 
 // Raw converts the OIDCConfig struct to a raw YAML string.
@@ -35,6 +94,16 @@ func (e *EverestSettings) OIDCConfig() (OIDCConfig, error) {
 	return oidc, nil
 }
 
+// PasswordPolicy returns the password policy from the raw string.
+func (e *EverestSettings) PasswordPolicy() (accounts.PasswordPolicy, error) {
+	var policy accounts.PasswordPolicy
+	err := yaml.Unmarshal([]byte(e.PasswordPolicyRaw), &policy)
+	if err != nil {
+		return accounts.PasswordPolicy{}, err
+	}
+	return policy, nil
+}
+
 // ToMap converts the EverestSettings struct to a map struct.
 func (e *EverestSettings) ToMap() (map[string]string, error) {
 	result := make(map[string]string)
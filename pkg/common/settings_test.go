@@ -130,3 +130,30 @@ func TestOIDCConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestOIDCConfigIssuers(t *testing.T) {
+	t.Parallel()
+	rawConfig := `issuerUrl: https://keycloak.example.com
+clientId: everest
+groupsClaim: groups
+additionalIssuers:
+  - issuerUrl: https://contractors.example.com
+    clientId: everest-contractors
+    userPrefix: "contractors:"
+`
+	settings := EverestSettings{OIDCConfigRaw: rawConfig}
+	config, err := settings.OIDCConfig()
+	require.NoError(t, err)
+	assert.Equal(t, []OIDCIssuer{
+		{
+			IssuerURL:   "https://keycloak.example.com",
+			ClientID:    "everest",
+			GroupsClaim: "groups",
+		},
+		{
+			IssuerURL:  "https://contractors.example.com",
+			ClientID:   "everest-contractors",
+			UserPrefix: "contractors:",
+		},
+	}, config.Issuers())
+}
@@ -0,0 +1,172 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package healthz provides health check endpoints modelled after the ones of kube-apiserver.
+//
+// Every endpoint runs a set of named checks. It responds with `ok` if all of them pass,
+// or with the status of each check if any of them fails or if the `verbose` query parameter is set.
+// Checks can be skipped with the `exclude` query parameter, and each check is also served on its own
+// path, e.g. `/readyz/ping`.
+package healthz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// checkTimeout is the time after which a check that has not returned is considered failed.
+const checkTimeout = 5 * time.Second
+
+// Checker is a named health check.
+type Checker interface {
+	// Name returns the name of the check, which is used in the responses and in the path of the check.
+	Name() string
+	// Check returns an error if the check fails.
+	Check(ctx context.Context) error
+}
+
+type namedCheck struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+func (c namedCheck) Name() string {
+	return c.name
+}
+
+func (c namedCheck) Check(ctx context.Context) error {
+	return c.check(ctx)
+}
+
+// NamedCheck returns a Checker with the given name that runs the check function.
+func NamedCheck(name string, check func(ctx context.Context) error) Checker { //nolint:ireturn
+	return namedCheck{name: name, check: check}
+}
+
+// Ping returns a Checker that always passes, which shows that the server is handling requests.
+func Ping() Checker { //nolint:ireturn
+	return NamedCheck("ping", func(context.Context) error { return nil })
+}
+
+// Router registers the endpoints, e.g. *echo.Echo or *echo.Group.
+type Router interface {
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// Install registers the endpoint with the given name, e.g. `readyz`, that runs all the checks,
+// along with an endpoint for each of the checks.
+func Install(r Router, name string, l *zap.SugaredLogger, checks ...Checker) {
+	path := "/" + name
+	r.GET(path, handler(name, l, checks))
+	for _, c := range checks {
+		r.GET(path+"/"+c.Name(), handler(name, l, []Checker{c}))
+	}
+}
+
+type result struct {
+	name string
+	err  error
+}
+
+func handler(name string, l *zap.SugaredLogger, checks []Checker) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		excluded := make(map[string]bool)
+		for _, n := range c.QueryParams()["exclude"] {
+			excluded[strings.TrimSpace(n)] = true
+		}
+		_, verbose := c.QueryParams()["verbose"]
+
+		var out strings.Builder
+		toRun := make([]Checker, 0, len(checks))
+		for _, check := range checks {
+			if excluded[check.Name()] {
+				fmt.Fprintf(&out, "[+]%s excluded: ok\n", check.Name())
+				delete(excluded, check.Name())
+				continue
+			}
+			toRun = append(toRun, check)
+		}
+		if len(excluded) > 0 {
+			fmt.Fprintf(&out, "warn: some health checks cannot be excluded: no matches for %s\n",
+				strings.Join(slices.Sorted(maps.Keys(excluded)), ", "))
+		}
+
+		failed := false
+		for _, r := range run(c.Request().Context(), toRun) {
+			if r.err != nil {
+				failed = true
+				l.Error(errors.Join(r.err, fmt.Errorf("%s check %q failed", name, r.name)))
+				// The reason is only logged, since the health endpoints do not require authentication.
+				fmt.Fprintf(&out, "[-]%s failed: reason withheld\n", r.name)
+				continue
+			}
+			fmt.Fprintf(&out, "[+]%s ok\n", r.name)
+		}
+
+		c.Response().Header().Set("X-Content-Type-Options", "nosniff")
+		if failed {
+			fmt.Fprintf(&out, "%s check failed\n", name)
+			return c.String(http.StatusInternalServerError, out.String())
+		}
+		if !verbose {
+			return c.String(http.StatusOK, "ok")
+		}
+		fmt.Fprintf(&out, "%s check passed\n", name)
+		return c.String(http.StatusOK, out.String())
+	}
+}
+
+// run runs the checks concurrently and returns their results in the order of the checks.
+func run(ctx context.Context, checks []Checker) []result {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	results := make([]result, len(checks))
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		results[i].name = check.Name()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i].err = runCheck(ctx, check)
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// runCheck runs the check and fails it if it does not return before ctx is done.
+func runCheck(ctx context.Context, check Checker) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- check.Check(ctx)
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return errors.Join(ctx.Err(), errors.New("check timed out"))
+	}
+}
@@ -0,0 +1,106 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthz
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestInstall(t *testing.T) {
+	t.Parallel()
+	e := echo.New()
+	Install(e, "readyz", zap.NewNop().Sugar(),
+		Ping(),
+		NamedCheck("kubernetes", func(context.Context) error { return nil }),
+		NamedCheck("informers", func(context.Context) error { return errors.New("not synced") }),
+	)
+
+	testCases := []struct {
+		description string
+		target      string
+		code        int
+		body        string
+	}{
+		{
+			description: "failing check",
+			target:      "/readyz",
+			code:        http.StatusInternalServerError,
+			body:        "[+]ping ok\n[+]kubernetes ok\n[-]informers failed: reason withheld\nreadyz check failed\n",
+		},
+		{
+			description: "failing check excluded",
+			target:      "/readyz?exclude=informers",
+			code:        http.StatusOK,
+			body:        "ok",
+		},
+		{
+			description: "verbose",
+			target:      "/readyz?exclude=informers&verbose",
+			code:        http.StatusOK,
+			body:        "[+]informers excluded: ok\n[+]ping ok\n[+]kubernetes ok\nreadyz check passed\n",
+		},
+		{
+			description: "unknown check excluded",
+			target:      "/readyz?exclude=informers&exclude=etcd&verbose",
+			code:        http.StatusOK,
+			body: "[+]informers excluded: ok\n" +
+				"warn: some health checks cannot be excluded: no matches for etcd\n" +
+				"[+]ping ok\n[+]kubernetes ok\nreadyz check passed\n",
+		},
+		{
+			description: "single passing check",
+			target:      "/readyz/kubernetes",
+			code:        http.StatusOK,
+			body:        "ok",
+		},
+		{
+			description: "single failing check",
+			target:      "/readyz/informers",
+			code:        http.StatusInternalServerError,
+			body:        "[-]informers failed: reason withheld\nreadyz check failed\n",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tc.target, nil))
+			assert.Equal(t, tc.code, rec.Code)
+			assert.Equal(t, tc.body, rec.Body.String())
+		})
+	}
+}
+
+func TestRunCheckTimeout(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	block := make(chan struct{})
+	defer close(block)
+	err := runCheck(ctx, NamedCheck("stuck", func(context.Context) error {
+		<-block
+		return nil
+	}))
+	assert.ErrorIs(t, err, context.Canceled)
+}
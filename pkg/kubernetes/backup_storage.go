@@ -27,8 +27,8 @@ import (
 )
 
 // ListBackupStorages returns list of managed backup storages.
-func (k *Kubernetes) ListBackupStorages(ctx context.Context, namespace string) (*everestv1alpha1.BackupStorageList, error) {
-	return k.client.ListBackupStorages(ctx, namespace, metav1.ListOptions{})
+func (k *Kubernetes) ListBackupStorages(ctx context.Context, namespace string, options metav1.ListOptions) (*everestv1alpha1.BackupStorageList, error) {
+	return k.client.ListBackupStorages(ctx, namespace, options)
 }
 
 // GetBackupStorage returns backup storages by provided name.
@@ -55,7 +55,7 @@ func (k *Kubernetes) DeleteBackupStorage(ctx context.Context, namespace, name st
 // This function will wait until all storages are deleted.
 func (k *Kubernetes) DeleteBackupStorages(ctx context.Context, namespace string) error {
 	return wait.PollUntilContextTimeout(ctx, pollInterval, pollTimeout, true, func(ctx context.Context) (bool, error) {
-		list, err := k.ListBackupStorages(ctx, namespace)
+		list, err := k.ListBackupStorages(ctx, namespace, metav1.ListOptions{})
 		if err != nil {
 			return false, err
 		}
@@ -26,24 +26,32 @@ import (
 
 	"golang.org/x/crypto/pbkdf2"
 	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
 
 	"github.com/percona/everest/pkg/accounts"
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes/client"
+	"github.com/percona/everest/pkg/session"
 )
 
 const (
 	usersFile = "users.yaml"
-	// We set this annotation on the secret to indicate which passwords are stored in plain text.
-	insecurePasswordAnnotation = "insecure-password/%s"
-	insecurePasswordValueTrue  = "true"
+	// This annotation on the secret indicates which passwords are stored in plain text,
+	// e.g. the password of the initial admin account, so that it can be retrieved until it is used.
+	// Such a password is hashed, and its annotation removed, once it is verified successfully or changed.
+	insecurePasswordAnnotationPrefix = "insecure-password/"
+	insecurePasswordValueTrue        = "true"
 
+	// Parameters of the PBKDF2 hashes created by previous versions of Everest.
 	keyLength = 32
 	iter      = 4096
 )
 
 type configMapsClient struct {
-	k client.KubeClientConnector
+	k        client.KubeClientConnector
+	denylist *session.DenylistStore
 }
 
 // New returns an implementation of the accounts interface that
@@ -51,7 +59,10 @@ type configMapsClient struct {
 //
 //nolint:ireturn
 func New(k client.KubeClientConnector) accounts.Interface {
-	return &configMapsClient{k: k}
+	return &configMapsClient{
+		k:        k,
+		denylist: session.NewDenylistStore(k),
+	}
 }
 
 // Get returns an account by username.
@@ -81,6 +92,9 @@ func (a *configMapsClient) listAllAccounts(ctx context.Context) (map[string]*acc
 	if err := yaml.Unmarshal(secret.Data[usersFile], result); err != nil {
 		return nil, err
 	}
+	for _, account := range result {
+		account.ResourceVersion = secret.GetResourceVersion()
+	}
 	return result, nil
 }
 
@@ -97,9 +111,16 @@ func (a *configMapsClient) Create(ctx context.Context, username, password string
 	if password == "" {
 		return errors.New("password cannot be empty")
 	}
+	policy, err := a.passwordPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	if err := policy.Validate(password); err != nil {
+		return err
+	}
 
 	// Compute a hash for the password.
-	hash, err := a.computePasswordHash(ctx, password)
+	hash, err := hashPassword(password)
 	if err != nil {
 		return errors.Join(err, errors.New("failed to compute hash"))
 	}
@@ -110,43 +131,98 @@ func (a *configMapsClient) Create(ctx context.Context, username, password string
 		PasswordMtime: time.Now().Format(time.RFC3339),
 		PasswordHash:  hash,
 	}
-	return a.insertOrUpdateAccount(ctx, username, account, true)
+	return a.insertOrUpdateAccount(ctx, username, account)
 }
 
 // SetPassword sets a new password for an existing user account.
-func (a *configMapsClient) SetPassword(ctx context.Context, username, newPassword string, secure bool) error {
+func (a *configMapsClient) SetPassword(ctx context.Context, username, newPassword string) error {
 	user, err := a.Get(ctx, username)
 	if err != nil {
 		return err
 	}
-	user.PasswordHash = newPassword
-	if secure {
-		pwHash, err := a.computePasswordHash(ctx, newPassword)
+	policy, err := a.passwordPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	if err := policy.Validate(newPassword); err != nil {
+		return err
+	}
+
+	oldHash := user.PasswordHash
+	secure, err := a.IsSecure(ctx, username)
+	if err != nil {
+		return err
+	}
+	if !secure {
+		// Plain text passwords are kept in the history as hashes.
+		if oldHash, err = hashPassword(oldHash); err != nil {
+			return errors.Join(err, errors.New("failed to compute hash"))
+		}
+	}
+	history := append([]string{oldHash}, user.PasswordHistory...)
+	history = history[:min(len(history), policy.HistorySize)]
+	for _, hash := range history {
+		reused, err := a.matchesHash(ctx, hash, newPassword)
 		if err != nil {
 			return err
 		}
-		user.PasswordHash = pwHash
+		if reused {
+			return fmt.Errorf("%w: password must not match any of the last %d passwords",
+				accounts.ErrPasswordPolicyViolation, policy.HistorySize)
+		}
+	}
+
+	pwHash, err := hashPassword(newPassword)
+	if err != nil {
+		return errors.Join(err, errors.New("failed to compute hash"))
 	}
+	// Together with the new password, the history holds the last HistorySize passwords.
+	user.PasswordHistory = history[:min(len(history), max(policy.HistorySize-1, 0))]
+	user.PasswordHash = pwHash
 	user.PasswordMtime = time.Now().Format(time.RFC3339)
-	return a.insertOrUpdateAccount(ctx, username, user, secure)
+	if err := a.insertOrUpdateAccount(ctx, username, user); err != nil {
+		return err
+	}
+	// Sessions created with the old password should no longer be valid.
+	if err := a.denylist.RevokeUser(ctx, session.Subject(username, accounts.AccountCapabilityLogin)); err != nil {
+		return errors.Join(err, errors.New("failed to revoke user sessions"))
+	}
+	return nil
 }
 
-func (a *configMapsClient) insertOrUpdateAccount(
-	ctx context.Context,
-	username string,
-	account *accounts.Account,
-	secure bool,
-) error {
+// Update an existing user account.
+// The password of the account is stored as is, so it must already be hashed.
+// If the account was read with Get or List, the update fails with a conflict
+// when the accounts were changed since, so that concurrent changes are not lost.
+func (a *configMapsClient) Update(ctx context.Context, username string, account *accounts.Account) error {
+	if _, err := a.Get(ctx, username); err != nil {
+		return err
+	}
+	return a.insertOrUpdateAccount(ctx, username, account)
+}
+
+// insertOrUpdateAccount stores the account. If its password differs from the stored one,
+// the password is no longer stored in plain text, since new passwords are always hashed.
+func (a *configMapsClient) insertOrUpdateAccount(ctx context.Context, username string, account *accounts.Account) error {
 	secret, err := a.k.GetSecret(ctx, common.SystemNamespace, common.EverestAccountsSecretName)
 	if err != nil {
 		return err
 	}
+	// The Secret is updated with the version read here, so only the changes
+	// made since the account itself was read need to be checked.
+	if account.ResourceVersion != "" && account.ResourceVersion != secret.GetResourceVersion() {
+		return k8serrors.NewConflict(corev1.Resource("secrets"), secret.GetName(),
+			errors.New("the accounts have been modified since the account was read"))
+	}
 
 	accounts := make(map[string]*accounts.Account)
 	if err := yaml.Unmarshal(secret.Data[usersFile], &accounts); err != nil {
 		return err
 	}
 
+	if stored, found := accounts[username]; found && stored.PasswordHash != account.PasswordHash {
+		delete(secret.Annotations, insecurePasswordAnnotationPrefix+username)
+	}
 	accounts[username] = account
 	data, err := yaml.Marshal(accounts)
 	if err != nil {
@@ -157,17 +233,6 @@ func (a *configMapsClient) insertOrUpdateAccount(
 		secret.Data = make(map[string][]byte)
 	}
 	secret.Data[usersFile] = data
-
-	annotations := secret.GetAnnotations()
-	if annotations == nil {
-		annotations = make(map[string]string)
-	}
-	delete(annotations, fmt.Sprintf(insecurePasswordAnnotation, username))
-	if !secure {
-		annotations[fmt.Sprintf(insecurePasswordAnnotation, username)] = insecurePasswordValueTrue
-	}
-	secret.SetAnnotations(annotations)
-
 	if _, err := a.k.UpdateSecret(ctx, secret); err != nil {
 		return err
 	}
@@ -203,12 +268,19 @@ func (a *configMapsClient) Delete(ctx context.Context, username string) error {
 		return err
 	}
 	secret.Data[usersFile] = data
+	delete(secret.Annotations, insecurePasswordAnnotationPrefix+username)
 	if _, err := a.k.UpdateSecret(ctx, secret); err != nil {
 		return err
 	}
+	if err := a.denylist.RevokeUser(ctx, username); err != nil {
+		return errors.Join(err, errors.New("failed to revoke user sessions"))
+	}
 	return nil
 }
 
+// Verify the credentials of an account.
+// Passwords that are stored in plain text, or hashed with a legacy or outdated scheme,
+// are rehashed once they are verified successfully.
 func (a *configMapsClient) Verify(ctx context.Context, username, password string) error {
 	secret, err := a.k.GetSecret(ctx, common.SystemNamespace, common.EverestAccountsSecretName)
 	if err != nil {
@@ -224,46 +296,117 @@ func (a *configMapsClient) Verify(ctx context.Context, username, password string
 		return accounts.ErrAccountNotFound
 	}
 
-	// helper to check if a password should be compared as a hash.
-	shouldCompareAsHash := func() bool {
-		annotations := secret.GetAnnotations()
-		_, found := annotations[fmt.Sprintf(insecurePasswordAnnotation, username)]
-		return !found
+	var match, needsRehash bool
+	if secret.GetAnnotations()[insecurePasswordAnnotationPrefix+username] == insecurePasswordValueTrue {
+		// A password stored in plain text, e.g. the initial admin password, is hashed before
+		// it is verified, so that all the passwords are verified the same way.
+		hash, err := hashPassword(user.PasswordHash)
+		if err != nil {
+			return errors.Join(err, errors.New("failed to compute hash"))
+		}
+		if match, _, err = verifyArgon2Hash(hash, password); err != nil {
+			return err
+		}
+		needsRehash = true
+	} else if isArgon2Hash(user.PasswordHash) {
+		match, needsRehash, err = verifyArgon2Hash(user.PasswordHash, password)
+		if err != nil {
+			return err
+		}
+	} else {
+		match, err = a.matchesLegacyHash(ctx, user.PasswordHash, password)
+		if err != nil {
+			return err
+		}
+		needsRehash = true
+	}
+	if !match {
+		return accounts.ErrIncorrectPassword
+	}
+	if needsRehash {
+		if err := a.rehashPassword(ctx, username, user.PasswordHash, password); err != nil {
+			return errors.Join(err, errors.New("failed to rehash password"))
+		}
 	}
 
-	actual := user.PasswordHash
-	provided := password
+	policy, err := a.passwordPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	if policy.IsExpired(*user, time.Now()) {
+		return accounts.ErrPasswordExpired
+	}
+	return nil
+}
 
-	if shouldCompareAsHash() {
-		computedHash, err := a.computePasswordHash(ctx, password)
+// rehashPassword replaces the given hash of the verified password of the account with one of the current scheme.
+// The hash is only replaced if it is still stored, so that a password changed concurrently is not overwritten.
+// Unlike SetPassword, the password is not changed, so it neither revokes sessions nor updates its modification time.
+func (a *configMapsClient) rehashPassword(ctx context.Context, username, oldHash, password string) error {
+	hash, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		user, err := a.Get(ctx, username)
 		if err != nil {
 			return err
 		}
-		provided = computedHash
+		if user.PasswordHash != oldHash {
+			return nil
+		}
+		user.PasswordHash = hash
+		return a.insertOrUpdateAccount(ctx, username, user)
+	})
+}
+
+// matchesHash returns true if the password matches the given hash, in any of the supported formats.
+func (a *configMapsClient) matchesHash(ctx context.Context, hash, password string) (bool, error) {
+	if isArgon2Hash(hash) {
+		match, _, err := verifyArgon2Hash(hash, password)
+		return match, err
 	}
+	return a.matchesLegacyHash(ctx, hash, password)
+}
 
-	if subtle.ConstantTimeCompare([]byte(actual), []byte(provided)) == 0 {
-		return accounts.ErrIncorrectPassword
+// matchesLegacyHash returns true if the password matches a hash created by previous
+// versions of Everest, using PBKDF2 salted with the UID of the system namespace.
+func (a *configMapsClient) matchesLegacyHash(ctx context.Context, hash, password string) (bool, error) {
+	salt, err := a.salt(ctx)
+	if err != nil {
+		return false, errors.Join(err, errors.New("failed to get salt"))
 	}
-	return nil
+	computed := pbkdf2.Key([]byte(password), salt, iter, keyLength, sha256.New)
+	return subtle.ConstantTimeCompare([]byte(hash), computed) == 1, nil
 }
 
 // IsSecure returns true if the password for the given user is stored as a hash.
+// Only accounts created with a plain text password that was never used are insecure.
 func (a *configMapsClient) IsSecure(ctx context.Context, username string) (bool, error) {
 	secret, err := a.k.GetSecret(ctx, common.SystemNamespace, common.EverestAccountsSecretName)
 	if err != nil {
 		return false, err
 	}
 	annotations := secret.GetAnnotations()
-	isSecure, found := annotations[fmt.Sprintf(insecurePasswordAnnotation, username)]
+	isSecure, found := annotations[insecurePasswordAnnotationPrefix+username]
 	return !found || isSecure != insecurePasswordValueTrue, nil
 }
 
-func (a *configMapsClient) computePasswordHash(ctx context.Context, password string) (string, error) {
-	salt, err := a.salt(ctx)
+// passwordPolicy returns the password policy stored in the Everest settings.
+func (a *configMapsClient) passwordPolicy(ctx context.Context) (accounts.PasswordPolicy, error) {
+	cm, err := a.k.GetConfigMap(ctx, common.SystemNamespace, common.EverestSettingsConfigMapName)
+	if k8serrors.IsNotFound(err) {
+		return accounts.PasswordPolicy{}, nil
+	} else if err != nil {
+		return accounts.PasswordPolicy{}, errors.Join(err, errors.New("failed to get Everest settings"))
+	}
+	settings := common.EverestSettings{}
+	if err := settings.FromMap(cm.Data); err != nil {
+		return accounts.PasswordPolicy{}, errors.Join(err, errors.New("failed to parse Everest settings"))
+	}
+	policy, err := settings.PasswordPolicy()
 	if err != nil {
-		return "", errors.Join(err, errors.New("failed to get salt"))
+		return accounts.PasswordPolicy{}, errors.Join(err, errors.New("failed to parse password policy"))
 	}
-	hash := pbkdf2.Key([]byte(password), salt, iter, keyLength, sha256.New)
-	return string(hash), nil
+	return policy, nil
 }
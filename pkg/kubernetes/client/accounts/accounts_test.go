@@ -2,10 +2,16 @@ package accounts
 
 import (
 	"context"
+	"crypto/sha256"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/pbkdf2"
+	"gopkg.in/yaml.v2"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/percona/everest/pkg/accounts"
@@ -44,3 +50,186 @@ func TestAccounts(t *testing.T) {
 
 	accounts.Tests(t, New(c))
 }
+
+func TestPasswordPolicy(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	c := client.NewFromFakeClient()
+	_, err := c.Clientset().
+		CoreV1().
+		Namespaces().
+		Create(ctx, &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: common.SystemNamespace},
+		}, metav1.CreateOptions{},
+		)
+	require.NoError(t, err)
+	_, err = c.Clientset().
+		CoreV1().
+		Secrets(common.SystemNamespace).
+		Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      common.EverestAccountsSecretName,
+				Namespace: common.SystemNamespace,
+			},
+		}, metav1.CreateOptions{},
+		)
+	require.NoError(t, err)
+	_, err = c.Clientset().
+		CoreV1().
+		ConfigMaps(common.SystemNamespace).
+		Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      common.EverestSettingsConfigMapName,
+				Namespace: common.SystemNamespace,
+			},
+			Data: map[string]string{
+				"passwordPolicy.config": "minLength: 8\nrequireDigit: true\nhistorySize: 2\nmaxAge: 720h\n",
+			},
+		}, metav1.CreateOptions{},
+		)
+	require.NoError(t, err)
+
+	a := New(c)
+	require.ErrorIs(t, a.Create(ctx, "user1", "short1"), accounts.ErrPasswordPolicyViolation)
+	require.ErrorIs(t, a.Create(ctx, "user1", "no-digits"), accounts.ErrPasswordPolicyViolation)
+	require.NoError(t, a.Create(ctx, "user1", "password1"))
+
+	// The current password and the one before it cannot be reused.
+	require.ErrorIs(t, a.SetPassword(ctx, "user1", "password1"), accounts.ErrPasswordPolicyViolation)
+	require.NoError(t, a.SetPassword(ctx, "user1", "password2"))
+	require.ErrorIs(t, a.SetPassword(ctx, "user1", "password1"), accounts.ErrPasswordPolicyViolation)
+	require.NoError(t, a.SetPassword(ctx, "user1", "password3"))
+	require.NoError(t, a.SetPassword(ctx, "user1", "password1"))
+	require.NoError(t, a.Verify(ctx, "user1", "password1"))
+
+	// Passwords older than the maximum age have to be changed.
+	user1, err := a.Get(ctx, "user1")
+	require.NoError(t, err)
+	user1.PasswordMtime = time.Now().Add(-721 * time.Hour).Format(time.RFC3339)
+	require.NoError(t, a.Update(ctx, "user1", user1))
+	require.ErrorIs(t, a.Verify(ctx, "user1", "password1"), accounts.ErrPasswordExpired)
+	require.ErrorIs(t, a.Verify(ctx, "user1", "password2"), accounts.ErrIncorrectPassword)
+}
+
+func TestVerifyRehashesPasswords(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	c := client.NewFromFakeClient()
+	_, err := c.Clientset().
+		CoreV1().
+		Namespaces().
+		Create(ctx, &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: common.SystemNamespace, UID: "namespace-uid"},
+		}, metav1.CreateOptions{},
+		)
+	require.NoError(t, err)
+
+	legacyHash := pbkdf2.Key([]byte("legacy-password"), []byte("namespace-uid"), iter, keyLength, sha256.New)
+	users, err := yaml.Marshal(map[string]*accounts.Account{
+		"legacy": {Enabled: true, PasswordHash: string(legacyHash)},
+		"admin":  {Enabled: true, PasswordHash: "plain-password"},
+	})
+	require.NoError(t, err)
+	_, err = c.Clientset().
+		CoreV1().
+		Secrets(common.SystemNamespace).
+		Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        common.EverestAccountsSecretName,
+				Namespace:   common.SystemNamespace,
+				Annotations: map[string]string{"insecure-password/admin": "true"},
+			},
+			Data: map[string][]byte{usersFile: users},
+		}, metav1.CreateOptions{},
+		)
+	require.NoError(t, err)
+
+	a := New(c)
+	// The initial password can be retrieved until it is used, even if other accounts
+	// are used or the password is guessed wrong.
+	require.NoError(t, a.Verify(ctx, "legacy", "legacy-password"))
+	require.ErrorIs(t, a.Verify(ctx, "admin", "wrong-password"), accounts.ErrIncorrectPassword)
+	secure, err := a.IsSecure(ctx, "admin")
+	require.NoError(t, err)
+	assert.False(t, secure)
+	admin, err := a.Get(ctx, "admin")
+	require.NoError(t, err)
+	assert.Equal(t, "plain-password", admin.PasswordHash)
+
+	testCases := []struct {
+		username string
+		password string
+	}{
+		{username: "legacy", password: "legacy-password"},
+		{username: "admin", password: "plain-password"},
+	}
+	for _, tc := range testCases {
+		require.ErrorIs(t, a.Verify(ctx, tc.username, "wrong-password"), accounts.ErrIncorrectPassword)
+		require.NoError(t, a.Verify(ctx, tc.username, tc.password))
+
+		account, err := a.Get(ctx, tc.username)
+		require.NoError(t, err)
+		assert.True(t, isArgon2Hash(account.PasswordHash))
+		secure, err := a.IsSecure(ctx, tc.username)
+		require.NoError(t, err)
+		assert.True(t, secure)
+		require.NoError(t, a.Verify(ctx, tc.username, tc.password))
+	}
+
+	// A password changed since it was verified is not overwritten by the rehash.
+	account, err := a.Get(ctx, "legacy")
+	require.NoError(t, err)
+	cm, ok := a.(*configMapsClient)
+	require.True(t, ok)
+	require.NoError(t, cm.rehashPassword(ctx, "legacy", string(legacyHash), "legacy-password"))
+	rehashed, err := a.Get(ctx, "legacy")
+	require.NoError(t, err)
+	assert.Equal(t, account.PasswordHash, rehashed.PasswordHash)
+}
+
+func TestUpdateConflict(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	c := client.NewFromFakeClient()
+	_, err := c.Clientset().
+		CoreV1().
+		Namespaces().
+		Create(ctx, &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: common.SystemNamespace},
+		}, metav1.CreateOptions{},
+		)
+	require.NoError(t, err)
+	_, err = c.Clientset().
+		CoreV1().
+		Secrets(common.SystemNamespace).
+		Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            common.EverestAccountsSecretName,
+				Namespace:       common.SystemNamespace,
+				ResourceVersion: "2",
+			},
+		}, metav1.CreateOptions{},
+		)
+	require.NoError(t, err)
+
+	a := New(c)
+	require.NoError(t, a.Create(ctx, "user1", "password1"))
+	account, err := a.Get(ctx, "user1")
+	require.NoError(t, err)
+	assert.NotEmpty(t, account.ResourceVersion)
+
+	stale := *account
+	stale.ResourceVersion = "1"
+	stale.FailedLogins = 1
+	err = a.Update(ctx, "user1", &stale)
+	require.True(t, k8serrors.IsConflict(err))
+
+	account.FailedLogins = 2
+	require.NoError(t, a.Update(ctx, "user1", account))
+	account, err = a.Get(ctx, "user1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, account.FailedLogins)
+}
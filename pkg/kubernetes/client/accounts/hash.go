@@ -0,0 +1,113 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accounts
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	argon2Prefix = "$argon2id$"
+	saltLength   = 16
+)
+
+// argon2Params holds the parameters of the argon2id key derivation function.
+type argon2Params struct {
+	memory    uint32
+	time      uint32
+	threads   uint8
+	keyLength uint32
+}
+
+// defaultArgon2Params follows the second recommended option of RFC 9106.
+//
+//nolint:gochecknoglobals
+var defaultArgon2Params = argon2Params{
+	memory:    64 * 1024, //nolint:mnd
+	time:      3,         //nolint:mnd
+	threads:   4,         //nolint:mnd
+	keyLength: 32,        //nolint:mnd
+}
+
+// hashPassword returns the argon2id hash of the password, derived with a new random salt.
+// The hash is encoded along with the salt and the parameters, in the format
+// `$argon2id$v=19$m=<memory>,t=<time>,p=<threads>$<salt>$<key>`.
+func hashPassword(password string) (string, error) {
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", errors.Join(err, errors.New("failed to generate salt"))
+	}
+	p := defaultArgon2Params
+	key := argon2.IDKey([]byte(password), salt, p.time, p.memory, p.threads, p.keyLength)
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2Prefix, argon2.Version, p.memory, p.time, p.threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// isArgon2Hash returns true if the given hash was created by hashPassword.
+func isArgon2Hash(hash string) bool {
+	return strings.HasPrefix(hash, argon2Prefix)
+}
+
+// verifyArgon2Hash returns true if the password matches the encoded argon2id hash.
+// needsRehash is true if the hash was created with other than the default parameters.
+func verifyArgon2Hash(hash, password string) (bool, bool, error) {
+	p, salt, key, err := decodeArgon2Hash(hash)
+	if err != nil {
+		return false, false, err
+	}
+	computed := argon2.IDKey([]byte(password), salt, p.time, p.memory, p.threads, p.keyLength)
+	match := subtle.ConstantTimeCompare(key, computed) == 1
+	return match, p != defaultArgon2Params, nil
+}
+
+func decodeArgon2Hash(hash string) (argon2Params, []byte, []byte, error) {
+	// ["", "argon2id", "v=19", "m=..,t=..,p=..", "<salt>", "<key>"]
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || !isArgon2Hash(hash) { //nolint:mnd
+		return argon2Params{}, nil, nil, errors.New("invalid argon2id hash format")
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return argon2Params{}, nil, nil, errors.Join(err, errors.New("invalid argon2id version"))
+	}
+	if version != argon2.Version {
+		return argon2Params{}, nil, nil, fmt.Errorf("unsupported argon2id version %d", version)
+	}
+	p := argon2Params{}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.memory, &p.time, &p.threads); err != nil {
+		return argon2Params{}, nil, nil, errors.Join(err, errors.New("invalid argon2id parameters"))
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return argon2Params{}, nil, nil, errors.Join(err, errors.New("invalid argon2id salt"))
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return argon2Params{}, nil, nil, errors.Join(err, errors.New("invalid argon2id key"))
+	}
+	p.keyLength = uint32(len(key)) //nolint:gosec
+	return p, salt, key, nil
+}
@@ -0,0 +1,91 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accounts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArgon2Hash(t *testing.T) {
+	t.Parallel()
+	hash, err := hashPassword("password")
+	require.NoError(t, err)
+	assert.True(t, isArgon2Hash(hash))
+
+	match, needsRehash, err := verifyArgon2Hash(hash, "password")
+	require.NoError(t, err)
+	assert.True(t, match)
+	assert.False(t, needsRehash)
+
+	match, _, err = verifyArgon2Hash(hash, "other-password")
+	require.NoError(t, err)
+	assert.False(t, match)
+
+	// Each hash uses a new random salt.
+	other, err := hashPassword("password")
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, other)
+}
+
+func TestVerifyArgon2Hash(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		description string
+		hash        string
+		match       bool
+		needsRehash bool
+		err         bool
+	}{
+		{
+			description: "weaker parameters",
+			// argon2id of "password" with m=1024,t=1,p=1 and the salt "somesaltsomesalt".
+			hash:        "$argon2id$v=19$m=1024,t=1,p=1$c29tZXNhbHRzb21lc2FsdA$FiU+I6KbINfHoMBfkVDnS6qmzxgy7cg41IT8JQoCvvk",
+			match:       true,
+			needsRehash: true,
+		},
+		{
+			description: "unsupported version",
+			hash:        "$argon2id$v=16$m=1024,t=1,p=1$c29tZXNhbHRzb21lc2FsdA$FiU+I6KbINfHoMBfkVDnS6qmzxgy7cg41IT8JQoCvvk",
+			err:         true,
+		},
+		{
+			description: "invalid format",
+			hash:        "$argon2id$v=19$m=1024,t=1,p=1$c29tZXNhbHRzb21lc2FsdA",
+			err:         true,
+		},
+		{
+			description: "invalid salt",
+			hash:        "$argon2id$v=19$m=1024,t=1,p=1$!!$FiU+I6KbINfHoMBfkVDnS6qmzxgy7cg41IT8JQoCvvk",
+			err:         true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+			match, needsRehash, err := verifyArgon2Hash(tc.hash, "password")
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.match, match)
+			assert.Equal(t, tc.needsRehash, needsRehash)
+		})
+	}
+}
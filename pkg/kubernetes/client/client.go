@@ -72,6 +72,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
 	"github.com/percona/everest/pkg/kubernetes/client/customresources"
+	"github.com/percona/everest/pkg/metrics"
+	"github.com/percona/everest/pkg/tracing"
 )
 
 const (
@@ -237,6 +239,8 @@ func NewInCluster() (*Client, error) {
 	config.QPS = defaultQPSLimit
 	config.Burst = defaultBurstLimit
 	config.Timeout = requestTimeout
+	config.Wrap(metrics.InstrumentKubernetesTransport)
+	config.Wrap(tracing.Transport)
 	clientset, err := kubernetes.NewForConfig(config)
 	if err != nil {
 		return nil, err
@@ -175,7 +175,7 @@ type KubeClientConnector interface {
 	// GetMonitoringConfig returns the monitoringConfig.
 	GetMonitoringConfig(ctx context.Context, namespace, name string) (*everestv1alpha1.MonitoringConfig, error)
 	// ListMonitoringConfigs returns the monitoringConfig.
-	ListMonitoringConfigs(ctx context.Context, namespace string) (*everestv1alpha1.MonitoringConfigList, error)
+	ListMonitoringConfigs(ctx context.Context, namespace string, options metav1.ListOptions) (*everestv1alpha1.MonitoringConfigList, error)
 	// DeleteMonitoringConfig deletes the monitoringConfig.
 	DeleteMonitoringConfig(ctx context.Context, namespace, name string) error
 	// CreateNamespace creates the given namespace.
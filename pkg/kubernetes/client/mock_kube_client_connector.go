@@ -1796,9 +1796,9 @@ func (_m *MockKubeClientConnector) ListInstallPlans(ctx context.Context, namespa
 	return r0, r1
 }
 
-// ListMonitoringConfigs provides a mock function with given fields: ctx, namespace
-func (_m *MockKubeClientConnector) ListMonitoringConfigs(ctx context.Context, namespace string) (*v1alpha1.MonitoringConfigList, error) {
-	ret := _m.Called(ctx, namespace)
+// ListMonitoringConfigs provides a mock function with given fields: ctx, namespace, options
+func (_m *MockKubeClientConnector) ListMonitoringConfigs(ctx context.Context, namespace string, options metav1.ListOptions) (*v1alpha1.MonitoringConfigList, error) {
+	ret := _m.Called(ctx, namespace, options)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListMonitoringConfigs")
@@ -1806,19 +1806,19 @@ func (_m *MockKubeClientConnector) ListMonitoringConfigs(ctx context.Context, na
 
 	var r0 *v1alpha1.MonitoringConfigList
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*v1alpha1.MonitoringConfigList, error)); ok {
-		return rf(ctx, namespace)
+	if rf, ok := ret.Get(0).(func(context.Context, string, metav1.ListOptions) (*v1alpha1.MonitoringConfigList, error)); ok {
+		return rf(ctx, namespace, options)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *v1alpha1.MonitoringConfigList); ok {
-		r0 = rf(ctx, namespace)
+	if rf, ok := ret.Get(0).(func(context.Context, string, metav1.ListOptions) *v1alpha1.MonitoringConfigList); ok {
+		r0 = rf(ctx, namespace, options)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*v1alpha1.MonitoringConfigList)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, namespace)
+	if rf, ok := ret.Get(1).(func(context.Context, string, metav1.ListOptions) error); ok {
+		r1 = rf(ctx, namespace, options)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -42,8 +42,8 @@ func (c *Client) GetMonitoringConfig(ctx context.Context, namespace, name string
 }
 
 // ListMonitoringConfigs returns the monitoringConfig.
-func (c *Client) ListMonitoringConfigs(ctx context.Context, namespace string) (*everestv1alpha1.MonitoringConfigList, error) {
-	return c.customClientSet.MonitoringConfig(namespace).List(ctx, metav1.ListOptions{})
+func (c *Client) ListMonitoringConfigs(ctx context.Context, namespace string, options metav1.ListOptions) (*everestv1alpha1.MonitoringConfigList, error) {
+	return c.customClientSet.MonitoringConfig(namespace).List(ctx, options)
 }
 
 // DeleteMonitoringConfig deletes the monitoringConfig.
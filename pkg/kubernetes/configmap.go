@@ -10,3 +10,13 @@ import (
 func (k *Kubernetes) GetConfigMap(ctx context.Context, namespace, name string) (*corev1.ConfigMap, error) {
 	return k.client.GetConfigMap(ctx, namespace, name)
 }
+
+// CreateConfigMap creates a k8s configmap.
+func (k *Kubernetes) CreateConfigMap(ctx context.Context, configMap *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+	return k.client.CreateConfigMap(ctx, configMap)
+}
+
+// UpdateConfigMap updates a k8s configmap.
+func (k *Kubernetes) UpdateConfigMap(ctx context.Context, configMap *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+	return k.client.UpdateConfigMap(ctx, configMap)
+}
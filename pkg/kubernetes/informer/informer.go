@@ -19,14 +19,36 @@ package informer
 import (
 	"context"
 	"errors"
+	"slices"
+	"sync"
 
 	"go.uber.org/zap"
 	"k8s.io/client-go/rest"
 	toolscache "k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/percona/everest/pkg/metrics"
 )
 
+// syncStatus holds whether the caches of the named Informers that were started are synced.
+//
+//nolint:gochecknoglobals
+var syncStatus sync.Map
+
+// NotSynced returns the names of the started Informers whose caches are not synced.
+func NotSynced() []string {
+	var names []string
+	syncStatus.Range(func(name, synced any) bool {
+		if !synced.(bool) { //nolint:forcetypeassert
+			names = append(names, name.(string)) //nolint:forcetypeassert
+		}
+		return true
+	})
+	slices.Sort(names)
+	return names
+}
+
 // Informer is a generic wrapper around the controller-runtime cache.
 // It provides a minimalist interface for watching Kubernetes objects
 // and triggering callbacks.
@@ -37,6 +59,7 @@ type Informer struct {
 	cfg           *rest.Config
 	eventHandlers toolscache.ResourceEventHandlerFuncs
 	l             *zap.SugaredLogger
+	name          string
 }
 
 // OptionsFunc is a function that sets options for the informer.
@@ -56,6 +79,13 @@ func WithLogger(l *zap.SugaredLogger) OptionsFunc {
 	}
 }
 
+// WithName sets the name the Informer is reported by in the metrics and the readiness checks.
+func WithName(name string) OptionsFunc {
+	return func(i *Informer) {
+		i.name = name
+	}
+}
+
 // Watches sets the Informer to watch the given object.
 // If a namespace is provided, the Informer will only watch the object only in
 // that namespace.
@@ -120,11 +150,23 @@ func (i *Informer) Start(ctx context.Context, obj client.Object) error {
 	if _, err := inf.AddEventHandler(i.eventHandlers); err != nil {
 		return errors.Join(err, errors.New("failed to add event handler"))
 	}
+	i.setSynced(false)
 	// Start the cache in a separate goroutine, since it is a blocking call.
 	go func() {
 		if err := i.cache.Start(ctx); err != nil {
 			i.l.Error("failed to start cache", zap.Error(err))
 		}
+		i.setSynced(false)
+	}()
+	go func() {
+		i.setSynced(i.cache.WaitForCacheSync(ctx))
 	}()
 	return nil
 }
+
+func (i *Informer) setSynced(synced bool) {
+	if i.name != "" {
+		syncStatus.Store(i.name, synced)
+		metrics.SetInformerSynced(i.name, synced)
+	}
+}
@@ -109,3 +109,16 @@ func (k *Kubernetes) CreateRSAKeyPair(ctx context.Context) error {
 	// Restart the deployment to pick up the new secret.
 	return k.RestartDeployment(ctx, common.PerconaEverestDeploymentName, common.SystemNamespace)
 }
+
+// GetJWTSigningKey returns the private key used by Everest for signing JWT tokens.
+func (k *Kubernetes) GetJWTSigningKey(ctx context.Context) (*rsa.PrivateKey, error) {
+	secret, err := k.GetSecret(ctx, common.SystemNamespace, common.EverestJWTSecretName)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(secret.Data[privateKeyFile])
+	if block == nil {
+		return nil, errors.New("failed to decode JWT private key")
+	}
+	return x509.ParsePKCS1PrivateKey(block.Bytes)
+}
@@ -4,6 +4,7 @@ package kubernetes
 
 import (
 	"context"
+	"crypto/rsa"
 
 	goversion "github.com/hashicorp/go-version"
 	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
@@ -28,7 +29,7 @@ type KubernetesConnector interface {
 	//nolint:ireturn,stylecheck
 	Accounts() accounts.Interface
 	// ListBackupStorages returns list of managed backup storages.
-	ListBackupStorages(ctx context.Context, namespace string) (*everestv1alpha1.BackupStorageList, error)
+	ListBackupStorages(ctx context.Context, namespace string, options metav1.ListOptions) (*everestv1alpha1.BackupStorageList, error)
 	// GetBackupStorage returns backup storages by provided name.
 	GetBackupStorage(ctx context.Context, namespace, name string) (*everestv1alpha1.BackupStorage, error)
 	// CreateBackupStorage returns backup storages by provided name.
@@ -113,7 +114,7 @@ type KubernetesConnector interface {
 	// and deletes them from the cluster.
 	DeleteManifestFile(fileBytes []byte, namespace string) error
 	// ListMonitoringConfigs returns list of managed monitoring configs.
-	ListMonitoringConfigs(ctx context.Context, namespace string) (*everestv1alpha1.MonitoringConfigList, error)
+	ListMonitoringConfigs(ctx context.Context, namespace string, options metav1.ListOptions) (*everestv1alpha1.MonitoringConfigList, error)
 	// GetMonitoringConfig returns monitoring configs by provided name.
 	GetMonitoringConfig(ctx context.Context, namespace, name string) (*everestv1alpha1.MonitoringConfig, error)
 	// CreateMonitoringConfig returns monitoring configs by provided name.
@@ -144,6 +145,8 @@ type KubernetesConnector interface {
 	OperatorInstalledVersion(ctx context.Context, namespace, name string) (*goversion.Version, error)
 	// CreateRSAKeyPair creates a new RSA key pair and stores it in a secret.
 	CreateRSAKeyPair(ctx context.Context) error
+	// GetJWTSigningKey returns the private key used by Everest for signing JWT tokens.
+	GetJWTSigningKey(ctx context.Context) (*rsa.PrivateKey, error)
 	// UpdateEverestSettings accepts the full list of Everest settings and updates the settings.
 	UpdateEverestSettings(ctx context.Context, settings common.EverestSettings) error
 	// GetEverestSettings returns Everest settings.
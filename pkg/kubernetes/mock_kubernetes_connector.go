@@ -4,6 +4,7 @@ package kubernetes
 
 import (
 	context "context"
+	rsa "crypto/rsa"
 
 	go_version "github.com/hashicorp/go-version"
 	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
@@ -743,6 +744,36 @@ func (_m *MockKubernetesConnector) GetEverestSettings(ctx context.Context) (comm
 	return r0, r1
 }
 
+// GetJWTSigningKey provides a mock function with given fields: ctx
+func (_m *MockKubernetesConnector) GetJWTSigningKey(ctx context.Context) (*rsa.PrivateKey, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJWTSigningKey")
+	}
+
+	var r0 *rsa.PrivateKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*rsa.PrivateKey, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *rsa.PrivateKey); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rsa.PrivateKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMonitoringConfig provides a mock function with given fields: ctx, namespace, name
 func (_m *MockKubernetesConnector) GetMonitoringConfig(ctx context.Context, namespace string, name string) (*v1alpha1.MonitoringConfig, error) {
 	ret := _m.Called(ctx, namespace, name)
@@ -997,9 +1028,9 @@ func (_m *MockKubernetesConnector) Kubeconfig() string {
 	return r0
 }
 
-// ListBackupStorages provides a mock function with given fields: ctx, namespace
-func (_m *MockKubernetesConnector) ListBackupStorages(ctx context.Context, namespace string) (*v1alpha1.BackupStorageList, error) {
-	ret := _m.Called(ctx, namespace)
+// ListBackupStorages provides a mock function with given fields: ctx, namespace, options
+func (_m *MockKubernetesConnector) ListBackupStorages(ctx context.Context, namespace string, options metav1.ListOptions) (*v1alpha1.BackupStorageList, error) {
+	ret := _m.Called(ctx, namespace, options)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListBackupStorages")
@@ -1007,19 +1038,19 @@ func (_m *MockKubernetesConnector) ListBackupStorages(ctx context.Context, names
 
 	var r0 *v1alpha1.BackupStorageList
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*v1alpha1.BackupStorageList, error)); ok {
-		return rf(ctx, namespace)
+	if rf, ok := ret.Get(0).(func(context.Context, string, metav1.ListOptions) (*v1alpha1.BackupStorageList, error)); ok {
+		return rf(ctx, namespace, options)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *v1alpha1.BackupStorageList); ok {
-		r0 = rf(ctx, namespace)
+	if rf, ok := ret.Get(0).(func(context.Context, string, metav1.ListOptions) *v1alpha1.BackupStorageList); ok {
+		r0 = rf(ctx, namespace, options)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*v1alpha1.BackupStorageList)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, namespace)
+	if rf, ok := ret.Get(1).(func(context.Context, string, metav1.ListOptions) error); ok {
+		r1 = rf(ctx, namespace, options)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1147,9 +1178,9 @@ func (_m *MockKubernetesConnector) ListDeployments(ctx context.Context, namespac
 	return r0, r1
 }
 
-// ListMonitoringConfigs provides a mock function with given fields: ctx, namespace
-func (_m *MockKubernetesConnector) ListMonitoringConfigs(ctx context.Context, namespace string) (*v1alpha1.MonitoringConfigList, error) {
-	ret := _m.Called(ctx, namespace)
+// ListMonitoringConfigs provides a mock function with given fields: ctx, namespace, options
+func (_m *MockKubernetesConnector) ListMonitoringConfigs(ctx context.Context, namespace string, options metav1.ListOptions) (*v1alpha1.MonitoringConfigList, error) {
+	ret := _m.Called(ctx, namespace, options)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListMonitoringConfigs")
@@ -1157,19 +1188,19 @@ func (_m *MockKubernetesConnector) ListMonitoringConfigs(ctx context.Context, na
 
 	var r0 *v1alpha1.MonitoringConfigList
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*v1alpha1.MonitoringConfigList, error)); ok {
-		return rf(ctx, namespace)
+	if rf, ok := ret.Get(0).(func(context.Context, string, metav1.ListOptions) (*v1alpha1.MonitoringConfigList, error)); ok {
+		return rf(ctx, namespace, options)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *v1alpha1.MonitoringConfigList); ok {
-		r0 = rf(ctx, namespace)
+	if rf, ok := ret.Get(0).(func(context.Context, string, metav1.ListOptions) *v1alpha1.MonitoringConfigList); ok {
+		r0 = rf(ctx, namespace, options)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*v1alpha1.MonitoringConfigList)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, namespace)
+	if rf, ok := ret.Get(1).(func(context.Context, string, metav1.ListOptions) error); ok {
+		r1 = rf(ctx, namespace, options)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -31,8 +31,8 @@ const (
 )
 
 // ListMonitoringConfigs returns list of managed monitoring configs.
-func (k *Kubernetes) ListMonitoringConfigs(ctx context.Context, namespace string) (*everestv1alpha1.MonitoringConfigList, error) {
-	return k.client.ListMonitoringConfigs(ctx, namespace)
+func (k *Kubernetes) ListMonitoringConfigs(ctx context.Context, namespace string, options metav1.ListOptions) (*everestv1alpha1.MonitoringConfigList, error) {
+	return k.client.ListMonitoringConfigs(ctx, namespace, options)
 }
 
 // GetMonitoringConfig returns monitoring configs by provided name.
@@ -59,7 +59,7 @@ func (k *Kubernetes) DeleteMonitoringConfig(ctx context.Context, namespace, name
 // This function will wait until all configs are deleted.
 func (k *Kubernetes) DeleteMonitoringConfigs(ctx context.Context, namespace string) error {
 	return wait.PollUntilContextTimeout(ctx, pollInterval, pollTimeout, true, func(ctx context.Context) (bool, error) {
-		list, err := k.ListMonitoringConfigs(ctx, namespace)
+		list, err := k.ListMonitoringConfigs(ctx, namespace, metav1.ListOptions{})
 		if err != nil {
 			return false, err
 		}
@@ -106,7 +106,7 @@ func (k *Kubernetes) IsMonitoringConfigUsed(ctx context.Context, namespace, name
 func (k *Kubernetes) GetMonitoringConfigsBySecretName(
 	ctx context.Context, namespace, secretName string,
 ) ([]*everestv1alpha1.MonitoringConfig, error) {
-	mcs, err := k.client.ListMonitoringConfigs(ctx, namespace)
+	mcs, err := k.client.ListMonitoringConfigs(ctx, namespace, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -10,31 +10,39 @@ import (
 	"github.com/percona/everest/pkg/common"
 )
 
-// UpdateEverestSettings accepts the full list of Everest settings and updates the settings.
+// UpdateEverestSettings updates the Everest settings.
+// The settings that are not set, as well as any other keys of the settings ConfigMap, are kept.
 func (k *Kubernetes) UpdateEverestSettings(ctx context.Context, settings common.EverestSettings) error {
 	configMapData, err := settings.ToMap()
 	if err != nil {
 		return err
 	}
 
-	_, getErr := k.client.GetConfigMap(ctx, common.SystemNamespace, common.EverestSettingsConfigMapName)
+	c, getErr := k.client.GetConfigMap(ctx, common.SystemNamespace, common.EverestSettingsConfigMapName)
 	if getErr != nil && !errors.IsNotFound(getErr) {
 		return getErr
 	}
 
-	c := &v1.ConfigMap{
-		TypeMeta: metav1.TypeMeta{},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      common.EverestSettingsConfigMapName,
-			Namespace: common.SystemNamespace,
-		},
-		Data: configMapData,
-	}
 	if errors.IsNotFound(getErr) {
-		_, err = k.client.CreateConfigMap(ctx, c)
+		_, err = k.client.CreateConfigMap(ctx, &v1.ConfigMap{
+			TypeMeta: metav1.TypeMeta{},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      common.EverestSettingsConfigMapName,
+				Namespace: common.SystemNamespace,
+			},
+			Data: configMapData,
+		})
 		return err
 	}
 
+	if c.Data == nil {
+		c.Data = make(map[string]string, len(configMapData))
+	}
+	for key, value := range configMapData {
+		if value != "" {
+			c.Data[key] = value
+		}
+	}
 	_, err = k.client.UpdateConfigMap(ctx, c)
 	return err
 }
@@ -0,0 +1,56 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes/client"
+)
+
+func TestUpdateEverestSettings(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	c := client.NewFromFakeClient()
+	k := &Kubernetes{client: c}
+
+	// The ConfigMap is created if it does not exist.
+	require.NoError(t, k.UpdateEverestSettings(ctx, common.EverestSettings{
+		PasswordPolicyRaw: "minLength: 12",
+	}))
+	cm, err := c.GetConfigMap(ctx, common.SystemNamespace, common.EverestSettingsConfigMapName)
+	require.NoError(t, err)
+	cm.Data["other.config"] = "other"
+	_, err = c.UpdateConfigMap(ctx, cm)
+	require.NoError(t, err)
+
+	// Updating a setting keeps the others.
+	require.NoError(t, k.UpdateEverestSettings(ctx, common.EverestSettings{
+		OIDCConfigRaw: "issuerUrl: https://example.com",
+	}))
+	cm, err = c.GetConfigMap(ctx, common.SystemNamespace, common.EverestSettingsConfigMapName)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"oidc.config":           "issuerUrl: https://example.com",
+		"passwordPolicy.config": "minLength: 12",
+		"other.config":          "other",
+	}, cm.Data)
+}
@@ -0,0 +1,180 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metrics holds the Prometheus metrics of the Everest API server.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "everest"
+
+//nolint:gochecknoglobals
+var (
+	registry = prometheus.NewRegistry()
+
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Number of API requests handled, partitioned by OpenAPI operation ID, method and status code.",
+	}, []string{"operation", "method", "code"})
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Time taken to handle API requests, partitioned by OpenAPI operation ID and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation", "method"})
+	rateLimitedRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "rate_limited_requests_total",
+		Help:      "Number of API requests rejected by a rate limiter.",
+	}, []string{"limiter"})
+	kubernetesRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "kubernetes",
+		Name:      "request_duration_seconds",
+		Help:      "Time taken by the requests made to the Kubernetes API, partitioned by method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+	sessionFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "session",
+		Name:      "failures_total",
+		Help:      "Number of failed attempts to create or refresh a session, partitioned by reason.",
+	}, []string{"reason"})
+	rbacDenials = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "rbac",
+		Name:      "denials_total",
+		Help:      "Number of requests denied by the RBAC policy, partitioned by resource and action.",
+	}, []string{"resource", "action"})
+	telemetryErrors = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "telemetry",
+		Name:      "errors_total",
+		Help:      "Number of failed telemetry job runs.",
+	})
+	informerSynced = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "informer",
+		Name:      "synced",
+		Help:      "Whether the informer is running and its cache is synced (1) or not (0).",
+	}, []string{"informer"})
+	operatorUpgradeLocked = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "operator_upgrade",
+		Name:      "locked",
+		Help:      "Whether changes to the database clusters of the namespace are locked by an operator upgrade (1) or not (0).",
+	}, []string{"namespace"})
+)
+
+func init() { //nolint:gochecknoinits
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests,
+		httpRequestDuration,
+		rateLimitedRequests,
+		kubernetesRequestDuration,
+		sessionFailures,
+		rbacDenials,
+		telemetryErrors,
+		informerSynced,
+		operatorUpgradeLocked,
+	)
+}
+
+// Handler returns an HTTP handler that serves the metrics in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// ObserveRequest records an API request handled for the given OpenAPI operation.
+func ObserveRequest(operation, method string, code int, duration time.Duration) {
+	httpRequests.WithLabelValues(operation, method, strconv.Itoa(code)).Inc()
+	httpRequestDuration.WithLabelValues(operation, method).Observe(duration.Seconds())
+}
+
+// IncRateLimited records a request rejected by the given rate limiter.
+func IncRateLimited(limiter string) {
+	rateLimitedRequests.WithLabelValues(limiter).Inc()
+}
+
+// IncSessionFailure records a failed attempt to create or refresh a session.
+func IncSessionFailure(reason string) {
+	sessionFailures.WithLabelValues(reason).Inc()
+}
+
+// IncRBACDenial records a request denied by the RBAC policy.
+func IncRBACDenial(resource, action string) {
+	rbacDenials.WithLabelValues(resource, action).Inc()
+}
+
+// IncTelemetryError records a failed telemetry job run.
+func IncTelemetryError() {
+	telemetryErrors.Inc()
+}
+
+// SetInformerSynced records whether the given informer is running and synced.
+func SetInformerSynced(informer string, synced bool) {
+	informerSynced.WithLabelValues(informer).Set(boolToFloat(synced))
+}
+
+// SetOperatorUpgradeLocked records whether the given namespace is locked by an operator upgrade.
+func SetOperatorUpgradeLocked(namespace string, locked bool) {
+	operatorUpgradeLocked.WithLabelValues(namespace).Set(boolToFloat(locked))
+}
+
+// InstrumentKubernetesTransport wraps the transport of a Kubernetes client,
+// so that the latency of the requests made to the Kubernetes API is recorded.
+// Watch requests are not recorded, since they are long-lived by design.
+func InstrumentKubernetesTransport(rt http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("watch") == "true" {
+			return rt.RoundTrip(req)
+		}
+		start := time.Now()
+		resp, err := rt.RoundTrip(req)
+		code := "error"
+		if err == nil {
+			code = strconv.Itoa(resp.StatusCode)
+		}
+		kubernetesRequestDuration.WithLabelValues(req.Method, code).Observe(time.Since(start).Seconds())
+		return resp, err
+	})
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,60 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstrumentKubernetesTransport(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: InstrumentKubernetesTransport(http.DefaultTransport)}
+	for _, url := range []string{srv.URL + "/api/v1/pods", srv.URL + "/api/v1/pods?watch=true"} {
+		resp, err := client.Get(url) //nolint:noctx
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+
+	// Watch requests are not recorded.
+	assert.Equal(t, 1, testutil.CollectAndCount(kubernetesRequestDuration, "everest_kubernetes_request_duration_seconds"))
+}
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+	ObserveRequest("listDatabaseClusters", http.MethodGet, http.StatusOK, time.Second)
+	IncRBACDenial("database-clusters", "delete")
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	body, err := io.ReadAll(rec.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `everest_http_requests_total{code="200",method="GET",operation="listDatabaseClusters"} 1`)
+	assert.Contains(t, string(body), `everest_rbac_denials_total{action="delete",resource="database-clusters"} 1`)
+}
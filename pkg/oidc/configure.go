@@ -22,6 +22,7 @@ import (
 
 	"github.com/AlecAivazis/survey/v2"
 	"go.uber.org/zap"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
@@ -42,6 +43,8 @@ type Config struct {
 	IssuerURL string `mapstructure:"issuer-url"`
 	// ClientID ID of the client OIDC app.
 	ClientID string `mapstructure:"client-id"`
+	// GroupsClaim name of the token claim that holds the groups of the user.
+	GroupsClaim string `mapstructure:"groups-claim"`
 }
 
 // NewOIDC returns a new OIDC struct.
@@ -95,9 +98,25 @@ func (u *OIDC) Run(ctx context.Context) error {
 		return errors.Join(err, errors.New("failed to connect with OIDC provider"))
 	}
 
+	// Keep the settings that cannot be configured using this command.
+	settings, err := u.kubeClient.GetEverestSettings(ctx)
+	if err = client.IgnoreNotFound(err); err != nil {
+		return errors.Join(err, errors.New("failed to get Everest settings"))
+	}
+	current, err := settings.OIDCConfig()
+	if err != nil {
+		return errors.Join(err, errors.New("cannot parse OIDC raw config"))
+	}
+
 	oidcCfg := common.OIDCConfig{
-		IssuerURL: issuerURL,
-		ClientID:  clientID,
+		IssuerURL:         issuerURL,
+		ClientID:          clientID,
+		GroupsClaim:       u.config.GroupsClaim,
+		AdditionalIssuers: current.AdditionalIssuers,
+	}
+	if current.IssuerURL == issuerURL {
+		oidcCfg.UserPrefix = current.UserPrefix
+		oidcCfg.OIDCTokenValidation = current.OIDCTokenValidation
 	}
 
 	oidcRaw, err := oidcCfg.Raw()
@@ -105,19 +124,13 @@ func (u *OIDC) Run(ctx context.Context) error {
 		return err
 	}
 
-	if err := u.kubeClient.UpdateEverestSettings(ctx, common.EverestSettings{
-		OIDCConfigRaw: oidcRaw,
-	}); err != nil {
-		return err
-	}
-
-	u.l.Info("OIDC provider configured, restarting Everest..")
-
-	err = u.kubeClient.RestartDeployment(ctx, common.PerconaEverestDeploymentName, common.SystemNamespace)
-	if err != nil {
+	// Only the OIDC settings are changed, the other settings are kept.
+	settings.OIDCConfigRaw = oidcRaw
+	if err := u.kubeClient.UpdateEverestSettings(ctx, settings); err != nil {
 		return err
 	}
 
+	// The Everest server reloads the OIDC settings as soon as they are updated, so no restart is needed.
 	u.l.Info("OIDC has been configured successfully")
 	return nil
 }
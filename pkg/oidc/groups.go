@@ -0,0 +1,63 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Groups returns the groups found in the given token claims.
+// The groupsClaim holds the name of the claim to read the groups from,
+// and can be a dot-separated path for nested claims, e.g. `realm_access.roles`.
+// The claim can either be a list of strings or a single string.
+func Groups(claims jwt.MapClaims, groupsClaim string) []string {
+	if groupsClaim == "" {
+		return nil
+	}
+	var value interface{} = map[string]interface{}(claims)
+	for _, key := range strings.Split(groupsClaim, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value, ok = m[key]
+		if !ok {
+			return nil
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		if v == "" {
+			return nil
+		}
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		groups := make([]string, 0, len(v))
+		for _, g := range v {
+			if s, ok := g.(string); ok && s != "" {
+				groups = append(groups, s)
+			}
+		}
+		return groups
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,77 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroups(t *testing.T) {
+	t.Parallel()
+	claims := jwt.MapClaims{
+		"sub":    "alice",
+		"groups": []interface{}{"dba", "dev", 42},
+		"role":   "admin",
+		"realm_access": map[string]interface{}{
+			"roles": []interface{}{"realm-dba"},
+		},
+	}
+	testCases := []struct {
+		description string
+		groupsClaim string
+		expected    []string
+	}{
+		{
+			description: "groups claim not configured",
+			groupsClaim: "",
+			expected:    nil,
+		},
+		{
+			description: "list of groups",
+			groupsClaim: "groups",
+			expected:    []string{"dba", "dev"},
+		},
+		{
+			description: "single group",
+			groupsClaim: "role",
+			expected:    []string{"admin"},
+		},
+		{
+			description: "nested claim",
+			groupsClaim: "realm_access.roles",
+			expected:    []string{"realm-dba"},
+		},
+		{
+			description: "missing claim",
+			groupsClaim: "roles",
+			expected:    nil,
+		},
+		{
+			description: "missing nested claim",
+			groupsClaim: "groups.roles",
+			expected:    nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, Groups(claims, tc.groupsClaim))
+		})
+	}
+}
@@ -27,6 +27,8 @@ import (
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/lestrrat-go/jwx/v2/jwk"
+
+	"github.com/percona/everest/pkg/tracing"
 )
 
 // ProviderConfig contains the configuration of an OIDC provider.
@@ -51,7 +53,7 @@ func getProviderConfig(ctx context.Context, issuer string) (ProviderConfig, erro
 	if err != nil {
 		return ProviderConfig{}, err
 	}
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := tracing.Client(http.DefaultTransport).Do(req)
 	if err != nil {
 		return ProviderConfig{}, err
 	}
@@ -84,18 +86,45 @@ func NewKeyFunc(ctx context.Context, issuer string) (jwt.Keyfunc, error) {
 	if err != nil {
 		return nil, errors.Join(err, errors.New("failed to get OIDC config"))
 	}
+	return newJWKSKeyFunc(ctx, cfg.JWKSURL)
+}
+
+// newJWKSKeyFunc returns a new function for getting the public keys
+// from the JWK set at the given URL. The keys are cached.
+func newJWKSKeyFunc(ctx context.Context, jwksURL string) (jwt.Keyfunc, error) {
+	keys, err := newJWKS(ctx, jwksURL)
+	if err != nil {
+		return nil, err
+	}
+	return keys.keyFunc(ctx), nil
+}
 
-	if cfg.JWKSURL == "" {
+// jwks caches the JWK set at a URL.
+type jwks struct {
+	cache *jwk.Cache
+	url   string
+}
+
+func newJWKS(ctx context.Context, jwksURL string) (*jwks, error) {
+	if jwksURL == "" {
 		return nil, errors.New("did not find jwks_uri in oidc config")
 	}
 
 	keyCache := jwk.NewCache(ctx)
-	if err := keyCache.Register(cfg.JWKSURL); err != nil {
+	if err := keyCache.Register(jwksURL, jwk.WithHTTPClient(tracing.Client(http.DefaultTransport))); err != nil {
 		return nil, errors.Join(err, errors.New("failed to register jwk cache"))
 	}
+	return &jwks{cache: keyCache, url: jwksURL}, nil
+}
 
+// get returns the cached JWK set. The set is fetched if it is not cached yet.
+func (k *jwks) get(ctx context.Context) (jwk.Set, error) {
+	return k.cache.Get(ctx, k.url)
+}
+
+func (k *jwks) keyFunc(ctx context.Context) jwt.Keyfunc {
 	return func(token *jwt.Token) (interface{}, error) {
-		keySet, err := keyCache.Get(ctx, cfg.JWKSURL)
+		keySet, err := k.get(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -115,5 +144,5 @@ func NewKeyFunc(ctx context.Context, issuer string) (jwt.Keyfunc, error) {
 			return nil, errors.Join(err, errors.New("failed to get the public key"))
 		}
 		return pubkey, nil
-	}, nil
+	}
 }
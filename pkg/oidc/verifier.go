@@ -0,0 +1,189 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/percona/everest/pkg/common"
+)
+
+// defaultAlgorithm is the signing algorithm that all OIDC providers must support.
+const defaultAlgorithm = "RS256"
+
+// Verifier verifies the tokens issued by any of the trusted OIDC providers.
+type Verifier struct {
+	// issuers maps the issuer URL to the trusted provider.
+	issuers map[string]*issuer
+}
+
+type issuer struct {
+	config  common.OIDCIssuer
+	keys    *jwks
+	keyFunc jwt.Keyfunc
+	parser  *jwt.Parser
+}
+
+// NewVerifier returns a new Verifier for the OIDC providers in the given configuration.
+func NewVerifier(ctx context.Context, cfg common.OIDCConfig) (*Verifier, error) {
+	v := &Verifier{
+		issuers: make(map[string]*issuer),
+	}
+	for _, iss := range cfg.Issuers() {
+		url := normalizeIssuerURL(iss.IssuerURL)
+		if _, found := v.issuers[url]; found {
+			return nil, fmt.Errorf("OIDC issuer %q is configured more than once", iss.IssuerURL)
+		}
+		provider, err := getProviderConfig(ctx, iss.IssuerURL)
+		if err != nil {
+			return nil, errors.Join(err, fmt.Errorf("failed to get config of OIDC issuer %q", iss.IssuerURL))
+		}
+		keys, err := newJWKS(ctx, provider.JWKSURL)
+		if err != nil {
+			return nil, errors.Join(err, fmt.Errorf("failed to get key function for OIDC issuer %q", iss.IssuerURL))
+		}
+		v.issuers[url], err = newIssuer(iss, provider, keys.keyFunc(ctx))
+		if err != nil {
+			return nil, errors.Join(err, fmt.Errorf("invalid configuration of OIDC issuer %q", iss.IssuerURL))
+		}
+		v.issuers[url].keys = keys
+	}
+	return v, nil
+}
+
+// CheckKeys returns an error if the signing keys of any of the trusted OIDC providers are not available.
+// The keys are fetched from the providers only if they are not cached yet.
+func (v *Verifier) CheckKeys(ctx context.Context) error {
+	for url, iss := range v.issuers {
+		if iss.keys == nil {
+			continue
+		}
+		if _, err := iss.keys.get(ctx); err != nil {
+			return errors.Join(err, fmt.Errorf("failed to get the signing keys of OIDC issuer %q", url))
+		}
+	}
+	return nil
+}
+
+func newIssuer(cfg common.OIDCIssuer, provider ProviderConfig, keyFunc jwt.Keyfunc) (*issuer, error) {
+	audience := cfg.Audience
+	if audience == "" {
+		audience = cfg.ClientID
+	}
+	if audience == "" {
+		return nil, errors.New("either audience or client ID must be set")
+	}
+	// Prefer the issuer advertised by the provider, since it must match the "iss" claim exactly.
+	issuerURL := provider.Issuer
+	if issuerURL == "" {
+		issuerURL = cfg.IssuerURL
+	}
+	algorithms := cfg.Algorithms
+	if len(algorithms) == 0 {
+		algorithms = provider.Algorithms
+	}
+	if len(algorithms) == 0 {
+		algorithms = []string{defaultAlgorithm}
+	}
+	return &issuer{
+		config:  cfg,
+		keyFunc: keyFunc,
+		parser: jwt.NewParser(
+			jwt.WithValidMethods(algorithms),
+			jwt.WithAudience(audience),
+			jwt.WithIssuer(issuerURL),
+			jwt.WithLeeway(cfg.ClockSkew),
+			jwt.WithExpirationRequired(),
+			jwt.WithIssuedAt(),
+		),
+	}, nil
+}
+
+// Parse parses the given token and validates it against the rules of the provider that issued it.
+// The provider is selected by the "iss" claim of the token.
+func (v *Verifier) Parse(tokenString string) (*jwt.Token, error) {
+	unverified := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(tokenString, unverified); err != nil {
+		return nil, err
+	}
+	iss, err := v.issuerFor(unverified)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := jwt.MapClaims{}
+	token, err := iss.parser.ParseWithClaims(tokenString, claims, iss.keyFunc)
+	if err != nil {
+		return nil, err
+	}
+	if err := iss.verifyRequiredClaims(claims); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// User returns the RBAC user and the groups for the given token claims.
+// The user and the groups are prefixed with the user prefix of the issuer,
+// so that the users and groups of different issuers do not collide.
+func (v *Verifier) User(claims jwt.MapClaims) (string, []string, error) {
+	iss, err := v.issuerFor(claims)
+	if err != nil {
+		return "", nil, err
+	}
+	subject, err := claims.GetSubject()
+	if err != nil {
+		return "", nil, errors.Join(err, errors.New("failed to get subject from claims"))
+	}
+	var groups []string
+	for _, g := range Groups(claims, iss.config.GroupsClaim) {
+		groups = append(groups, iss.config.UserPrefix+g)
+	}
+	return iss.config.UserPrefix + subject, groups, nil
+}
+
+func (v *Verifier) issuerFor(claims jwt.MapClaims) (*issuer, error) {
+	url, err := claims.GetIssuer()
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to get issuer from claims"))
+	}
+	iss, found := v.issuers[normalizeIssuerURL(url)]
+	if !found {
+		return nil, fmt.Errorf("untrusted token issuer %q", url)
+	}
+	return iss, nil
+}
+
+func (i *issuer) verifyRequiredClaims(claims jwt.MapClaims) error {
+	for name, expected := range i.config.RequiredClaims {
+		value, found := claims[name]
+		if !found {
+			return fmt.Errorf("token is missing required claim %q", name)
+		}
+		if fmt.Sprint(value) != expected {
+			return fmt.Errorf("token claim %q does not have the required value", name)
+		}
+	}
+	return nil
+}
+
+func normalizeIssuerURL(url string) string {
+	return strings.TrimSuffix(url, "/")
+}
@@ -0,0 +1,226 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/everest/pkg/common"
+)
+
+func TestVerifier(t *testing.T) {
+	t.Parallel()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keyFunc := func(_ *jwt.Token) (interface{}, error) {
+		return key.Public(), nil
+	}
+
+	staff, err := newIssuer(common.OIDCIssuer{
+		IssuerURL:   "https://staff.example.com/",
+		ClientID:    "everest",
+		GroupsClaim: "groups",
+		OIDCTokenValidation: common.OIDCTokenValidation{
+			RequiredClaims: map[string]string{"email_verified": "true"},
+		},
+	}, ProviderConfig{
+		Issuer:     "https://staff.example.com",
+		Algorithms: []string{"RS256"},
+	}, keyFunc)
+	require.NoError(t, err)
+	contractors, err := newIssuer(common.OIDCIssuer{
+		IssuerURL:   "https://contractors.example.com",
+		ClientID:    "everest-contractors",
+		GroupsClaim: "groups",
+		UserPrefix:  "contractors:",
+		OIDCTokenValidation: common.OIDCTokenValidation{
+			Audience:  "everest-api",
+			ClockSkew: time.Minute,
+		},
+	}, ProviderConfig{}, keyFunc)
+	require.NoError(t, err)
+	v := &Verifier{
+		issuers: map[string]*issuer{
+			"https://staff.example.com":       staff,
+			"https://contractors.example.com": contractors,
+		},
+	}
+
+	now := time.Now()
+	testCases := []struct {
+		description string
+		method      jwt.SigningMethod
+		claims      jwt.MapClaims
+		user        string
+		groups      []string
+		wantErr     bool
+	}{
+		{
+			description: "token of the first issuer",
+			claims: jwt.MapClaims{
+				"iss":            "https://staff.example.com",
+				"aud":            "everest",
+				"sub":            "alice",
+				"exp":            now.Add(time.Hour).Unix(),
+				"email_verified": true,
+				"groups":         []interface{}{"dba"},
+			},
+			user:   "alice",
+			groups: []string{"dba"},
+		},
+		{
+			description: "token of the second issuer",
+			claims: jwt.MapClaims{
+				"iss": "https://contractors.example.com",
+				"aud": "everest-api",
+				"sub": "alice",
+				"exp": now.Add(time.Hour).Unix(),
+			},
+			user:   "contractors:alice",
+			groups: nil,
+		},
+		{
+			description: "groups of the second issuer",
+			claims: jwt.MapClaims{
+				"iss":    "https://contractors.example.com",
+				"aud":    "everest-api",
+				"sub":    "bob",
+				"exp":    now.Add(time.Hour).Unix(),
+				"groups": []interface{}{"dba"},
+			},
+			user:   "contractors:bob",
+			groups: []string{"contractors:dba"},
+		},
+		{
+			description: "token expired within the clock skew",
+			claims: jwt.MapClaims{
+				"iss": "https://contractors.example.com",
+				"aud": "everest-api",
+				"sub": "alice",
+				"exp": now.Add(-30 * time.Second).Unix(),
+			},
+			user:   "contractors:alice",
+			groups: nil,
+		},
+		{
+			description: "token expired beyond the clock skew",
+			claims: jwt.MapClaims{
+				"iss": "https://contractors.example.com",
+				"aud": "everest-api",
+				"sub": "alice",
+				"exp": now.Add(-2 * time.Minute).Unix(),
+			},
+			wantErr: true,
+		},
+		{
+			description: "token without expiry",
+			claims: jwt.MapClaims{
+				"iss": "https://contractors.example.com",
+				"aud": "everest-api",
+				"sub": "alice",
+			},
+			wantErr: true,
+		},
+		{
+			description: "token issued to another client",
+			claims: jwt.MapClaims{
+				"iss":            "https://staff.example.com",
+				"aud":            "other-app",
+				"sub":            "alice",
+				"exp":            now.Add(time.Hour).Unix(),
+				"email_verified": true,
+			},
+			wantErr: true,
+		},
+		{
+			description: "token signed with an algorithm that is not allowed",
+			method:      jwt.SigningMethodHS256,
+			claims: jwt.MapClaims{
+				"iss":            "https://staff.example.com",
+				"aud":            "everest",
+				"sub":            "alice",
+				"exp":            now.Add(time.Hour).Unix(),
+				"email_verified": true,
+			},
+			wantErr: true,
+		},
+		{
+			description: "token without a required claim",
+			claims: jwt.MapClaims{
+				"iss": "https://staff.example.com",
+				"aud": "everest",
+				"sub": "alice",
+				"exp": now.Add(time.Hour).Unix(),
+			},
+			wantErr: true,
+		},
+		{
+			description: "token with an unexpected value of a required claim",
+			claims: jwt.MapClaims{
+				"iss":            "https://staff.example.com",
+				"aud":            "everest",
+				"sub":            "alice",
+				"exp":            now.Add(time.Hour).Unix(),
+				"email_verified": false,
+			},
+			wantErr: true,
+		},
+		{
+			description: "token of an untrusted issuer",
+			claims: jwt.MapClaims{
+				"iss": "https://unknown.example.com",
+				"aud": "everest",
+				"sub": "alice",
+				"exp": now.Add(time.Hour).Unix(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+			var signed string
+			var err error
+			if tc.method == jwt.SigningMethodHS256 {
+				signed, err = jwt.NewWithClaims(jwt.SigningMethodHS256, tc.claims).SignedString([]byte("secret"))
+			} else {
+				signed, err = jwt.NewWithClaims(jwt.SigningMethodRS256, tc.claims).SignedString(key)
+			}
+			require.NoError(t, err)
+
+			token, err := v.Parse(signed)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			claims, ok := token.Claims.(jwt.MapClaims)
+			require.True(t, ok)
+			user, groups, err := v.User(claims)
+			require.NoError(t, err)
+			assert.Equal(t, tc.user, user)
+			assert.Equal(t, tc.groups, groups)
+		})
+	}
+}
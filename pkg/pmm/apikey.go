@@ -26,16 +26,15 @@ import (
 	"net/http"
 
 	"github.com/percona/everest/cmd/config"
+	"github.com/percona/everest/pkg/tracing"
 )
 
 func newHTTPClient(insecure bool) *http.Client {
-	client := http.DefaultClient
-	client.Transport = &http.Transport{
+	return tracing.Client(&http.Transport{
 		TLSClientConfig: &tls.Config{
 			InsecureSkipVerify: insecure, //nolint:gosec
 		},
-	}
-	return client
+	})
 }
 
 // CreatePMMApiKey creates a new API key in PMM by using the provided username and password.
@@ -0,0 +1,180 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"go.uber.org/zap"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coordinationv1client "k8s.io/client-go/kubernetes/typed/coordination/v1"
+)
+
+const (
+	// leaseDuration is the time after which the Lease of a replica that stopped renewing it is ignored.
+	leaseDuration = 30 * time.Second
+	// leaseRenewInterval is the interval at which every replica renews its Lease.
+	leaseRenewInterval = 10 * time.Second
+	// leaseReleaseTimeout is the time allowed for releasing the Lease on shutdown.
+	leaseReleaseTimeout = 5 * time.Second
+
+	// leaseLabel marks the Leases held by the replicas of the API server.
+	leaseLabel      = "everest.percona.com/rate-limit"
+	leaseNamePrefix = "everest-rate-limit-"
+)
+
+// LeaseStore splits the quotas between the replicas of the API server.
+//
+// Every replica holds a Lease, which it renews periodically, and allows its share of each quota,
+// i.e. the quota divided by the number of replicas holding a valid Lease, without a round trip to
+// the Kubernetes API for every request. The requests are still counted by every replica on its own,
+// so this is an approximation of a shared quota: the requests allowed by all the replicas together
+// stay within the quota only if the load balancer spreads the requests of every key evenly between
+// the replicas. A key whose requests all reach the same replica is allowed only that replica's share.
+type LeaseStore struct {
+	leases   coordinationv1client.LeaseInterface
+	identity string
+	local    *MemoryStore
+	replicas atomic.Int32
+	l        *zap.SugaredLogger
+
+	timeNow func() time.Time
+}
+
+// NewLeaseStore returns a new LeaseStore for the replica with the given identity,
+// e.g. the name of its Pod, that holds its Lease using the given client.
+func NewLeaseStore(leases coordinationv1client.LeaseInterface, identity string, l *zap.SugaredLogger) *LeaseStore {
+	s := &LeaseStore{
+		leases:   leases,
+		identity: identity,
+		local:    NewMemoryStore(),
+		l:        l,
+		timeNow:  time.Now,
+	}
+	s.replicas.Store(1)
+	return s
+}
+
+// Start acquires the Lease of this replica and keeps renewing it in the background.
+// The Lease is released once ctx is done.
+func (s *LeaseStore) Start(ctx context.Context) error {
+	if err := s.sync(ctx); err != nil {
+		return errors.Join(err, errors.New("failed to acquire rate limit Lease"))
+	}
+	go func() {
+		ticker := time.NewTicker(leaseRenewInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				s.release()
+				return
+			case <-ticker.C:
+				if err := s.sync(ctx); err != nil {
+					s.l.Error(errors.Join(err, errors.New("failed to renew rate limit Lease")))
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// Replicas returns the number of replicas the quotas are shared between.
+func (s *LeaseStore) Replicas() int {
+	return int(s.replicas.Load())
+}
+
+// Allow records a request for key and returns true if it is within the share of the quota of this replica.
+func (s *LeaseStore) Allow(ctx context.Context, key string, quota Quota) (bool, error) {
+	n := s.Replicas()
+	return s.local.Allow(ctx, key, Quota{
+		Rate:  quota.Rate / float64(n),
+		Burst: max(quota.burst()/n, 1),
+	})
+}
+
+// sync renews the Lease of this replica and counts the replicas that hold a valid Lease.
+func (s *LeaseStore) sync(ctx context.Context) error {
+	if err := s.renew(ctx); err != nil {
+		return err
+	}
+	list, err := s.leases.List(ctx, metav1.ListOptions{LabelSelector: leaseLabel})
+	if err != nil {
+		return err
+	}
+	now := s.timeNow()
+	n := int32(0)
+	for _, lease := range list.Items {
+		if isHeld(lease, now) {
+			n++
+		}
+	}
+	s.replicas.Store(max(n, 1))
+	return nil
+}
+
+func (s *LeaseStore) renew(ctx context.Context) error {
+	now := metav1.NewMicroTime(s.timeNow())
+	lease, err := s.leases.Get(ctx, s.leaseName(), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		_, err = s.leases.Create(ctx, &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   s.leaseName(),
+				Labels: map[string]string{leaseLabel: "true"},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &s.identity,
+				LeaseDurationSeconds: pointer.ToInt32(int32(leaseDuration.Seconds())),
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		}, metav1.CreateOptions{})
+		return err
+	} else if err != nil {
+		return err
+	}
+	lease.Spec.RenewTime = &now
+	_, err = s.leases.Update(ctx, lease, metav1.UpdateOptions{})
+	return err
+}
+
+// release deletes the Lease of this replica, so that the other replicas take over its share of the quotas.
+func (s *LeaseStore) release() {
+	ctx, cancel := context.WithTimeout(context.Background(), leaseReleaseTimeout)
+	defer cancel()
+	if err := s.leases.Delete(ctx, s.leaseName(), metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+		s.l.Error(errors.Join(err, errors.New("failed to release rate limit Lease")))
+	}
+}
+
+func (s *LeaseStore) leaseName() string {
+	return leaseNamePrefix + s.identity
+}
+
+// isHeld returns true if the Lease was renewed within its duration.
+func isHeld(lease coordinationv1.Lease, now time.Time) bool {
+	if lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return false
+	}
+	expiresAt := lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second)
+	return now.Before(expiresAt)
+}
@@ -0,0 +1,75 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestLeaseStore(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	leases := fake.NewSimpleClientset().CoordinationV1().Leases("everest-system")
+	l := zap.NewNop().Sugar()
+
+	first := NewLeaseStore(leases, "everest-server-a", l)
+	require.NoError(t, first.Start(ctx))
+	assert.Equal(t, 1, first.Replicas())
+
+	second := NewLeaseStore(leases, "everest-server-b", l)
+	require.NoError(t, second.Start(ctx))
+	assert.Equal(t, 2, second.Replicas())
+	require.NoError(t, first.sync(ctx))
+	assert.Equal(t, 2, first.Replicas())
+
+	// Every replica allows its share of the quota.
+	quota := Quota{Rate: 2, Burst: 4}
+	allowed := 0
+	for range 4 {
+		if ok, _ := first.Allow(ctx, "key", quota); ok {
+			allowed++
+		}
+	}
+	assert.Equal(t, 2, allowed)
+
+	// The Lease of a replica that stopped renewing it is ignored once it expires.
+	first.timeNow = func() time.Time { return time.Now().Add(leaseDuration + time.Second) }
+	require.NoError(t, first.sync(ctx))
+	assert.Equal(t, 1, first.Replicas())
+}
+
+func TestLeaseStoreRelease(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	leases := fake.NewSimpleClientset().CoordinationV1().Leases("everest-system")
+
+	s := NewLeaseStore(leases, "everest-server-a", zap.NewNop().Sugar())
+	require.NoError(t, s.Start(ctx))
+	cancel()
+	assert.Eventually(t, func() bool {
+		list, err := leases.List(context.Background(), metav1.ListOptions{})
+		return err == nil && len(list.Items) == 0
+	}, time.Second, 10*time.Millisecond)
+}
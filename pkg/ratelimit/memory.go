@@ -0,0 +1,85 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// memoryStoreExpiration is the time after which the keys that made no requests are forgotten.
+const memoryStoreExpiration = 3 * time.Minute
+
+// MemoryStore counts the requests in the memory of the process,
+// so every replica of the API server allows the whole quota.
+type MemoryStore struct {
+	mu          sync.Mutex
+	visitors    map[string]*visitor
+	lastCleanup time.Time
+
+	timeNow func() time.Time
+}
+
+type visitor struct {
+	limiter  *rate.Limiter
+	quota    Quota
+	lastSeen time.Time
+}
+
+// NewMemoryStore returns a new MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		visitors:    make(map[string]*visitor),
+		lastCleanup: time.Now(),
+		timeNow:     time.Now,
+	}
+}
+
+// Allow records a request for key and returns true if it is within the quota.
+func (s *MemoryStore) Allow(_ context.Context, key string, quota Quota) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.timeNow()
+	v, found := s.visitors[key]
+	if !found {
+		v = &visitor{quota: quota, limiter: rate.NewLimiter(rate.Limit(quota.Rate), quota.burst())}
+		s.visitors[key] = v
+	} else if v.quota != quota {
+		// The quota changes when the number of replicas sharing it changes.
+		v.quota = quota
+		v.limiter.SetLimitAt(now, rate.Limit(quota.Rate))
+		v.limiter.SetBurstAt(now, quota.burst())
+	}
+	v.lastSeen = now
+	if now.Sub(s.lastCleanup) > memoryStoreExpiration {
+		s.cleanupStaleVisitors(now)
+	}
+	return v.limiter.AllowN(now, 1), nil
+}
+
+// cleanupStaleVisitors removes the keys that made no requests during the expiration time.
+func (s *MemoryStore) cleanupStaleVisitors(now time.Time) {
+	for key, v := range s.visitors {
+		if now.Sub(v.lastSeen) > memoryStoreExpiration {
+			delete(s.visitors, key)
+		}
+	}
+	s.lastCleanup = now
+}
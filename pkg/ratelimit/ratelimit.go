@@ -0,0 +1,287 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ratelimit limits the rate of the requests made to the Everest API.
+//
+// The requests are counted in a Store, which can either be local to the process
+// or split the quotas between the replicas of the API server.
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	echomiddleware "github.com/labstack/echo/v4/middleware"
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/pkg/metrics"
+)
+
+// Supported stores.
+const (
+	StoreMemory     = "memory"
+	StorePerReplica = "per-replica"
+)
+
+// Supported keys the requests can be limited by.
+const (
+	// KeyByIP limits the requests by the address of the client that connected to the server.
+	KeyByIP = "ip"
+	// KeyByForwardedFor limits the requests by the client address in the X-Forwarded-For header,
+	// as reported by the trusted proxies.
+	KeyByForwardedFor = "x-forwarded-for"
+	// KeyByUser limits the requests by the authenticated user.
+	// The requests made without authentication are limited by the client address.
+	// All the requests are limited by the client address before authentication as well.
+	KeyByUser = "user"
+)
+
+// Config holds the configuration of the rate limiting.
+type Config struct {
+	// Store is the store of the request counts, either `memory` or `per-replica`.
+	// The `memory` store applies the quotas to every replica of the API server.
+	// The `per-replica` store splits the quotas evenly between the replicas, so the quotas are
+	// only approximated if the load balancer does not spread the requests of a key evenly.
+	Store string `default:"memory" envconfig:"STORE"`
+	// KeyBy selects what the requests are limited by: `ip`, `x-forwarded-for` or `user`.
+	KeyBy string `default:"ip" envconfig:"KEY_BY"`
+	// TrustedProxies are the CIDRs of the proxies that are trusted to set the X-Forwarded-For header.
+	// If empty, the proxies in the loopback, link-local and private networks are trusted.
+	TrustedProxies []string `envconfig:"TRUSTED_PROXIES"`
+	// Routes are the quotas of specific routes, which apply on top of the quota of all the API requests.
+	Routes RouteQuotas `envconfig:"ROUTES"`
+	// IPRate is the number of requests per second allowed for every client address before authentication,
+	// when the requests are limited by user. Defaults to the quota of all the API requests.
+	IPRate float64 `envconfig:"IP_RATE"`
+}
+
+// Quota is the number of requests allowed for a key.
+type Quota struct {
+	// Rate is the number of requests allowed per second.
+	Rate float64
+	// Burst is the number of requests allowed at once.
+	// Defaults to the rate, or to 1 if the rate is lower.
+	Burst int
+}
+
+func (q Quota) burst() int {
+	if q.Burst > 0 {
+		return q.Burst
+	}
+	return max(int(q.Rate), 1)
+}
+
+// RouteQuota is the quota of the requests made to the routes matching a pattern.
+type RouteQuota struct {
+	// Name is the name the route is reported by in the metrics. Defaults to the pattern.
+	Name string
+	// Method is the HTTP method of the requests. Matches any method if empty.
+	Method string
+	// Path is either the exact path of the requests, or a path prefix followed by `*`.
+	Path string
+	Quota
+}
+
+func (r RouteQuota) matches(method, path string) bool {
+	if r.Method != "" && !strings.EqualFold(r.Method, method) {
+		return false
+	}
+	if prefix, ok := strings.CutSuffix(r.Path, "*"); ok {
+		return strings.HasPrefix(path, prefix)
+	}
+	return path == r.Path
+}
+
+func (r RouteQuota) name() string {
+	if r.Name != "" {
+		return r.Name
+	}
+	return strings.TrimSpace(r.Method + " " + r.Path)
+}
+
+// RouteQuotas is a list of route quotas, of which the first one matching a request applies.
+type RouteQuotas []RouteQuota
+
+// Decode parses a comma-separated list of route quotas of the form `[METHOD ]PATH=RATE[:BURST]`,
+// e.g. `POST /v1/session=1,/v1/namespaces/*=50:100`.
+func (q *RouteQuotas) Decode(value string) error {
+	var quotas RouteQuotas
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		route, quota, found := strings.Cut(entry, "=")
+		if !found {
+			return fmt.Errorf("invalid route quota %q: expected [METHOD ]PATH=RATE[:BURST]", entry)
+		}
+		var r RouteQuota
+		fields := strings.Fields(route)
+		switch len(fields) {
+		case 1:
+			r.Path = fields[0]
+		case 2: //nolint:mnd
+			r.Method, r.Path = strings.ToUpper(fields[0]), fields[1]
+		default:
+			return fmt.Errorf("invalid route %q in route quota", route)
+		}
+		rateStr, burstStr, hasBurst := strings.Cut(quota, ":")
+		rate, err := strconv.ParseFloat(strings.TrimSpace(rateStr), 64)
+		if err != nil || rate <= 0 {
+			return fmt.Errorf("invalid rate in route quota %q", entry)
+		}
+		r.Rate = rate
+		if hasBurst {
+			r.Burst, err = strconv.Atoi(strings.TrimSpace(burstStr))
+			if err != nil || r.Burst <= 0 {
+				return fmt.Errorf("invalid burst in route quota %q", entry)
+			}
+		}
+		quotas = append(quotas, r)
+	}
+	*q = quotas
+	return nil
+}
+
+// Store keeps track of the requests made for every key.
+type Store interface {
+	// Allow records a request for key and returns true if it is within the quota.
+	Allow(ctx context.Context, key string, quota Quota) (bool, error)
+}
+
+// KeyFunc returns the key the request is limited by.
+type KeyFunc func(c echo.Context) string
+
+// IPKey returns the address of the client, as reported by the IP extractor of the echo server.
+func IPKey(c echo.Context) string {
+	return c.RealIP()
+}
+
+// IPExtractor returns the function the echo server gets the address of the client with.
+func IPExtractor(cfg Config) (echo.IPExtractor, error) {
+	switch cfg.KeyBy {
+	case KeyByIP:
+		return echo.ExtractIPDirect(), nil
+	case KeyByForwardedFor, KeyByUser:
+		if len(cfg.TrustedProxies) == 0 {
+			return echo.ExtractIPFromXFFHeader(), nil
+		}
+		opts := []echo.TrustOption{
+			echo.TrustLoopback(false),
+			echo.TrustLinkLocal(false),
+			echo.TrustPrivateNet(false),
+		}
+		for _, cidr := range cfg.TrustedProxies {
+			_, ipNet, err := net.ParseCIDR(strings.TrimSpace(cidr))
+			if err != nil {
+				return nil, errors.Join(err, fmt.Errorf("invalid trusted proxy CIDR %q", cidr))
+			}
+			opts = append(opts, echo.TrustIPRange(ipNet))
+		}
+		return echo.ExtractIPFromXFFHeader(opts...), nil
+	default:
+		return nil, fmt.Errorf("unknown rate limit key %q", cfg.KeyBy)
+	}
+}
+
+// Limiter limits the rate of the requests made by every key.
+// Every request counts towards the quota of all the requests and towards
+// the quota of the first route it matches, if any.
+type Limiter struct {
+	store  Store
+	key    KeyFunc
+	quota  Quota
+	routes RouteQuotas
+	l      *zap.SugaredLogger
+}
+
+// OptionsFunc is a function that sets options for the Limiter.
+type OptionsFunc func(*Limiter)
+
+// WithKeyFunc sets the function that returns the key the requests are limited by.
+// The requests are limited by the address of the client by default.
+func WithKeyFunc(key KeyFunc) OptionsFunc {
+	return func(l *Limiter) {
+		l.key = key
+	}
+}
+
+// WithRoutes adds quotas for specific routes.
+// The routes are matched in order, after the routes that were added before.
+func WithRoutes(routes ...RouteQuota) OptionsFunc {
+	return func(l *Limiter) {
+		l.routes = append(l.routes, routes...)
+	}
+}
+
+// NewLimiter returns a new Limiter that counts the requests in store
+// and allows the given quota of requests for every key.
+func NewLimiter(store Store, quota Quota, l *zap.SugaredLogger, opts ...OptionsFunc) *Limiter {
+	limiter := &Limiter{
+		store: store,
+		key:   IPKey,
+		quota: quota,
+		l:     l,
+	}
+	for _, opt := range opts {
+		opt(limiter)
+	}
+	return limiter
+}
+
+// limiterNameAPI is the name the quota of all the requests is reported by in the metrics.
+const limiterNameAPI = "api"
+
+// Middleware returns a middleware that rejects the requests over the quota with 429 Too Many Requests.
+func (l *Limiter) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := c.Request().Context()
+			key := l.key(c)
+			if !l.allow(ctx, limiterNameAPI, key, l.quota) {
+				return echomiddleware.ErrRateLimitExceeded
+			}
+			for _, route := range l.routes {
+				if !route.matches(c.Request().Method, c.Request().URL.Path) {
+					continue
+				}
+				if !l.allow(ctx, route.name(), route.name()+"|"+key, route.Quota) {
+					return echomiddleware.ErrRateLimitExceeded
+				}
+				break
+			}
+			return next(c)
+		}
+	}
+}
+
+// allow returns true if the request is within the quota.
+// The request is allowed if the store fails, so that an unavailable store does not lock the users out.
+func (l *Limiter) allow(ctx context.Context, name, key string, quota Quota) bool {
+	ok, err := l.store.Allow(ctx, key, quota)
+	if err != nil {
+		l.l.Error(errors.Join(err, errors.New("failed to check rate limit")))
+		return true
+	}
+	if !ok {
+		metrics.IncRateLimited(name)
+	}
+	return ok
+}
@@ -0,0 +1,162 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestRouteQuotasDecode(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		value    string
+		expected RouteQuotas
+		err      bool
+	}{
+		{
+			value:    "",
+			expected: nil,
+		},
+		{
+			value: "post /v1/session=1, /v1/namespaces/*=50:100",
+			expected: RouteQuotas{
+				{Method: http.MethodPost, Path: "/v1/session", Quota: Quota{Rate: 1}},
+				{Path: "/v1/namespaces/*", Quota: Quota{Rate: 50, Burst: 100}},
+			},
+		},
+		{value: "/v1/session", err: true},
+		{value: "/v1/session=fast", err: true},
+		{value: "/v1/session=0", err: true},
+		{value: "/v1/session=1:0", err: true},
+		{value: "GET POST /v1/session=1", err: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			t.Parallel()
+			var q RouteQuotas
+			err := q.Decode(tc.value)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, q)
+		})
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	t.Parallel()
+	e := echo.New()
+	e.IPExtractor = echo.ExtractIPDirect()
+	limiter := NewLimiter(NewMemoryStore(), Quota{Rate: 1, Burst: 3}, zap.NewNop().Sugar(),
+		WithRoutes(RouteQuota{Method: http.MethodPost, Path: "/v1/session", Quota: Quota{Rate: 1}}),
+	)
+	e.Use(limiter.Middleware())
+	ok := func(c echo.Context) error { return c.NoContent(http.StatusOK) }
+	e.POST("/v1/session", ok)
+	e.GET("/v1/version", ok)
+
+	do := func(method, target, ip string) int {
+		req := httptest.NewRequest(method, target, nil)
+		req.RemoteAddr = ip + ":1234"
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// The session route allows a single request, on top of the quota of all the requests.
+	assert.Equal(t, http.StatusOK, do(http.MethodPost, "/v1/session", "10.0.0.1"))
+	assert.Equal(t, http.StatusTooManyRequests, do(http.MethodPost, "/v1/session", "10.0.0.1"))
+	// The request rejected by the session quota still counted towards the quota of all the requests.
+	assert.Equal(t, http.StatusOK, do(http.MethodGet, "/v1/version", "10.0.0.1"))
+	assert.Equal(t, http.StatusTooManyRequests, do(http.MethodGet, "/v1/version", "10.0.0.1"))
+	// Other clients have their own quota.
+	assert.Equal(t, http.StatusOK, do(http.MethodPost, "/v1/session", "10.0.0.2"))
+}
+
+func TestLimiterKeyFunc(t *testing.T) {
+	t.Parallel()
+	e := echo.New()
+	limiter := NewLimiter(NewMemoryStore(), Quota{Rate: 1}, zap.NewNop().Sugar(),
+		WithKeyFunc(func(c echo.Context) string { return c.Request().Header.Get("X-User") }),
+	)
+	e.Use(limiter.Middleware())
+	e.GET("/", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+
+	do := func(user string) int {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("X-User", user)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec.Code
+	}
+	assert.Equal(t, http.StatusOK, do("alice"))
+	assert.Equal(t, http.StatusTooManyRequests, do("alice"))
+	assert.Equal(t, http.StatusOK, do("bob"))
+}
+
+func TestIPExtractor(t *testing.T) {
+	t.Parallel()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "192.0.2.10:1234"
+	req.Header.Set(echo.HeaderXForwardedFor, "203.0.113.7, 192.0.2.10")
+
+	direct, err := IPExtractor(Config{KeyBy: KeyByIP})
+	require.NoError(t, err)
+	assert.Equal(t, "192.0.2.10", direct(req))
+
+	trusted, err := IPExtractor(Config{KeyBy: KeyByForwardedFor, TrustedProxies: []string{"192.0.2.0/24"}})
+	require.NoError(t, err)
+	assert.Equal(t, "203.0.113.7", trusted(req))
+
+	untrusted, err := IPExtractor(Config{KeyBy: KeyByForwardedFor, TrustedProxies: []string{"198.51.100.0/24"}})
+	require.NoError(t, err)
+	assert.Equal(t, "192.0.2.10", untrusted(req))
+
+	_, err = IPExtractor(Config{KeyBy: KeyByForwardedFor, TrustedProxies: []string{"not-a-cidr"}})
+	require.Error(t, err)
+	_, err = IPExtractor(Config{KeyBy: "cookie"})
+	require.Error(t, err)
+}
+
+func TestMemoryStoreQuotaChange(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Now()
+	s := NewMemoryStore()
+	s.timeNow = func() time.Time { return now }
+
+	ok, err := s.Allow(ctx, "key", Quota{Rate: 1, Burst: 1})
+	require.NoError(t, err)
+	assert.True(t, ok)
+	now = now.Add(100 * time.Millisecond)
+	ok, _ = s.Allow(ctx, "key", Quota{Rate: 10, Burst: 1})
+	assert.False(t, ok)
+	// The higher rate applies to the existing key from the time it was set.
+	now = now.Add(100 * time.Millisecond)
+	ok, _ = s.Allow(ctx, "key", Quota{Rate: 10, Burst: 1})
+	assert.True(t, ok)
+}
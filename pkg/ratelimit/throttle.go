@@ -0,0 +1,277 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// initialBackoff is the time a key has to wait after its first failure.
+	initialBackoff = 20 * time.Millisecond
+	// maxBackoffShift caps the backoff at initialBackoff * 2^maxBackoffShift, i.e. about 5.8 hours.
+	maxBackoffShift = 20
+	// failuresExpiration is the time after which the failures of a key are forgotten.
+	failuresExpiration = 6 * time.Hour
+	// maxKeys is the maximum number of keys whose failures are kept. Once it is reached,
+	// the failures of the keys that failed the longest time ago are forgotten first.
+	maxKeys = 4096
+	// keyHashLength is the length in bytes of the hashes the keys are stored as.
+	keyHashLength = 16
+
+	failuresFile = "failures.yaml"
+)
+
+// Throttle slows down the repeated failures of a key, e.g. the failed logins from a client address.
+// After every failure, the key has to wait twice as long as before until its next attempt is allowed.
+// The keys are stored as fixed-length hashes and only the failures of the last maxKeys keys are kept,
+// so that the keys chosen by the clients cannot grow the storage without bounds.
+type Throttle interface {
+	// Allow returns true if the key is allowed to make an attempt.
+	Allow(ctx context.Context, key string) (bool, error)
+	// Fail records a failed attempt of the key.
+	Fail(ctx context.Context, key string) error
+	// Reset forgets the failures of the key, e.g. after a successful attempt.
+	Reset(ctx context.Context, key string) error
+}
+
+// failures are the failed attempts of a key.
+type failures struct {
+	Count int       `yaml:"count"`
+	Last  time.Time `yaml:"last"`
+}
+
+func (f failures) allowed(now time.Time) bool {
+	if f.Count == 0 {
+		return true
+	}
+	backoff := initialBackoff << min(f.Count-1, maxBackoffShift)
+	return now.Sub(f.Last) > backoff
+}
+
+func (f failures) expired(now time.Time) bool {
+	return now.Sub(f.Last) > failuresExpiration
+}
+
+// hashKey returns the hash the failures of the key are stored by.
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:keyHashLength])
+}
+
+// pruneFailures removes the expired failures, then the oldest ones until at most maxKeys are left.
+func pruneFailures(all map[string]failures, now time.Time) {
+	for k, f := range all {
+		if f.expired(now) {
+			delete(all, k)
+		}
+	}
+	if len(all) <= maxKeys {
+		return
+	}
+	keys := make([]string, 0, len(all))
+	for k := range all {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, func(a, b string) int {
+		return all[a].Last.Compare(all[b].Last)
+	})
+	for _, k := range keys[:len(keys)-maxKeys] {
+		delete(all, k)
+	}
+}
+
+// MemoryThrottle keeps the failures in the memory of the process.
+type MemoryThrottle struct {
+	mu       sync.Mutex
+	failures map[string]failures
+
+	timeNow func() time.Time
+}
+
+// NewMemoryThrottle returns a new MemoryThrottle.
+func NewMemoryThrottle() *MemoryThrottle {
+	return &MemoryThrottle{
+		failures: make(map[string]failures),
+		timeNow:  time.Now,
+	}
+}
+
+// Allow returns true if the key is allowed to make an attempt.
+func (t *MemoryThrottle) Allow(_ context.Context, key string) (bool, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.failures[hashKey(key)].allowed(t.timeNow()), nil
+}
+
+// Fail records a failed attempt of the key.
+func (t *MemoryThrottle) Fail(_ context.Context, key string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.timeNow()
+	key = hashKey(key)
+	f := t.failures[key]
+	t.failures[key] = failures{Count: f.Count + 1, Last: now}
+	pruneFailures(t.failures, now)
+	return nil
+}
+
+// Reset forgets the failures of the key.
+func (t *MemoryThrottle) Reset(_ context.Context, key string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.failures, hashKey(key))
+	return nil
+}
+
+// configMapClient is the subset of the Kubernetes client used for persisting the failures.
+type configMapClient interface {
+	GetConfigMap(ctx context.Context, namespace, name string) (*corev1.ConfigMap, error)
+	CreateConfigMap(ctx context.Context, configMap *corev1.ConfigMap) (*corev1.ConfigMap, error)
+	UpdateConfigMap(ctx context.Context, configMap *corev1.ConfigMap) (*corev1.ConfigMap, error)
+}
+
+// ConfigMapThrottle keeps the failures in a Kubernetes ConfigMap, so that they are shared between
+// the replicas of the API server. The ConfigMap is only written to when an attempt fails or when
+// the failures of a key are reset, so it is meant for throttling infrequent attempts, such as logins.
+type ConfigMapThrottle struct {
+	k         configMapClient
+	namespace string
+	name      string
+
+	timeNow func() time.Time
+}
+
+// NewConfigMapThrottle returns a new ConfigMapThrottle that keeps the failures in the ConfigMap with the given name.
+func NewConfigMapThrottle(k configMapClient, namespace, name string) *ConfigMapThrottle {
+	return &ConfigMapThrottle{
+		k:         k,
+		namespace: namespace,
+		name:      name,
+		timeNow:   time.Now,
+	}
+}
+
+// Allow returns true if the key is allowed to make an attempt.
+func (t *ConfigMapThrottle) Allow(ctx context.Context, key string) (bool, error) {
+	cm, err := t.k.GetConfigMap(ctx, t.namespace, t.name)
+	if k8serrors.IsNotFound(err) {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+	all, err := failuresFromConfigMap(cm)
+	if err != nil {
+		return false, err
+	}
+	return all[hashKey(key)].allowed(t.timeNow()), nil
+}
+
+// Fail records a failed attempt of the key.
+func (t *ConfigMapThrottle) Fail(ctx context.Context, key string) error {
+	key = hashKey(key)
+	return t.update(ctx, func(all map[string]failures, now time.Time) {
+		f := all[key]
+		all[key] = failures{Count: f.Count + 1, Last: now}
+	})
+}
+
+// Reset forgets the failures of the key.
+func (t *ConfigMapThrottle) Reset(ctx context.Context, key string) error {
+	// Most attempts succeed at first, so avoid writing to the ConfigMap when there is nothing to forget.
+	cm, err := t.k.GetConfigMap(ctx, t.namespace, t.name)
+	if k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	all, err := failuresFromConfigMap(cm)
+	if err != nil {
+		return err
+	}
+	key = hashKey(key)
+	if _, found := all[key]; !found {
+		return nil
+	}
+	return t.update(ctx, func(all map[string]failures, _ time.Time) {
+		delete(all, key)
+	})
+}
+
+// update applies fn to the stored failures and persists the result.
+func (t *ConfigMapThrottle) update(ctx context.Context, fn func(all map[string]failures, now time.Time)) error {
+	shouldRetry := func(err error) bool {
+		return k8serrors.IsConflict(err) || k8serrors.IsAlreadyExists(err)
+	}
+	return retry.OnError(retry.DefaultRetry, shouldRetry, func() error {
+		exists := true
+		cm, err := t.k.GetConfigMap(ctx, t.namespace, t.name)
+		if k8serrors.IsNotFound(err) {
+			exists = false
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      t.name,
+					Namespace: t.namespace,
+				},
+			}
+		} else if err != nil {
+			return err
+		}
+
+		all, err := failuresFromConfigMap(cm)
+		if err != nil {
+			return err
+		}
+		now := t.timeNow()
+		fn(all, now)
+		pruneFailures(all, now)
+
+		data, err := yaml.Marshal(all)
+		if err != nil {
+			return err
+		}
+		if cm.Data == nil {
+			cm.Data = make(map[string]string)
+		}
+		cm.Data[failuresFile] = string(data)
+
+		if !exists {
+			_, err = t.k.CreateConfigMap(ctx, cm)
+		} else {
+			_, err = t.k.UpdateConfigMap(ctx, cm)
+		}
+		return err
+	})
+}
+
+func failuresFromConfigMap(cm *corev1.ConfigMap) (map[string]failures, error) {
+	all := make(map[string]failures)
+	if err := yaml.Unmarshal([]byte(cm.Data[failuresFile]), &all); err != nil {
+		return nil, err
+	}
+	return all, nil
+}
@@ -0,0 +1,103 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/everest/pkg/kubernetes/client"
+)
+
+func TestFailuresAllowed(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	assert.True(t, failures{}.allowed(now))
+	assert.False(t, failures{Count: 1, Last: now}.allowed(now.Add(initialBackoff)))
+	assert.True(t, failures{Count: 1, Last: now}.allowed(now.Add(initialBackoff+time.Millisecond)))
+	assert.False(t, failures{Count: 3, Last: now}.allowed(now.Add(4*initialBackoff)))
+	assert.True(t, failures{Count: 3, Last: now}.allowed(now.Add(4*initialBackoff+time.Millisecond)))
+	assert.False(t, failures{Count: 100, Last: now}.allowed(now.Add(time.Hour)), "the backoff must not overflow")
+}
+
+func TestThrottle(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	memory := NewMemoryThrottle()
+	memory.timeNow = func() time.Time { return now }
+	configMap := NewConfigMapThrottle(client.NewFromFakeClient(), "everest-system", "everest-login-throttle")
+	configMap.timeNow = func() time.Time { return now }
+
+	for name, throttle := range map[string]Throttle{"memory": memory, "configmap": configMap} {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			ok, err := throttle.Allow(ctx, "10.0.0.1")
+			require.NoError(t, err)
+			assert.True(t, ok)
+			require.NoError(t, throttle.Reset(ctx, "10.0.0.1"))
+
+			require.NoError(t, throttle.Fail(ctx, "10.0.0.1"))
+			ok, err = throttle.Allow(ctx, "10.0.0.1")
+			require.NoError(t, err)
+			assert.False(t, ok)
+			ok, err = throttle.Allow(ctx, "10.0.0.2")
+			require.NoError(t, err)
+			assert.True(t, ok)
+
+			require.NoError(t, throttle.Reset(ctx, "10.0.0.1"))
+			ok, err = throttle.Allow(ctx, "10.0.0.1")
+			require.NoError(t, err)
+			assert.True(t, ok)
+		})
+	}
+}
+
+func TestConfigMapThrottleShared(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	k := client.NewFromFakeClient()
+	first := NewConfigMapThrottle(k, "everest-system", "everest-login-throttle")
+	second := NewConfigMapThrottle(k, "everest-system", "everest-login-throttle")
+
+	require.NoError(t, first.Fail(ctx, "10.0.0.1"))
+	ok, err := second.Allow(ctx, "10.0.0.1")
+	require.NoError(t, err)
+	assert.False(t, ok, "the failures must be shared between the replicas")
+}
+
+func TestPruneFailures(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	all := map[string]failures{
+		"expired": {Count: 1, Last: now.Add(-failuresExpiration - time.Second)},
+	}
+	for i := range maxKeys + 1 {
+		all[hashKey(strconv.Itoa(i))] = failures{Count: 1, Last: now.Add(time.Duration(i) * time.Millisecond)}
+	}
+	pruneFailures(all, now)
+	assert.Len(t, all, maxKeys)
+	assert.NotContains(t, all, "expired")
+	// The key that failed the longest time ago is forgotten first.
+	assert.NotContains(t, all, hashKey("0"))
+	assert.Contains(t, all, hashKey(strconv.Itoa(maxKeys)))
+	assert.Len(t, hashKey(strings.Repeat("x", 1<<20)), 2*keyHashLength)
+}
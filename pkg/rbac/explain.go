@@ -0,0 +1,154 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rbac
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/util"
+
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+// Explanation describes why a request was allowed or denied.
+type Explanation struct {
+	// Allowed is true if the request is allowed.
+	Allowed bool
+	// Matches holds the policies that match the request.
+	// A request is allowed if at least one allow policy and no deny policy matches it.
+	Matches []PolicyMatch
+}
+
+// PolicyMatch is a policy that matches a request.
+type PolicyMatch struct {
+	// Policy holds the terms of the policy in the form [subject resource action object effect].
+	Policy []string
+	// Roles is the chain of roles through which the policy applies to the subject of the request.
+	// It is empty if the policy is defined for the subject directly.
+	Roles []string
+}
+
+// Explain checks if a user is allowed to perform an action on a resource
+// and returns the policies that the decision is based on.
+// Input request should be of the form [user action resource object].
+func Explain(ctx context.Context, filePath string, k *kubernetes.Kubernetes, req ...string) (Explanation, error) {
+	user, action, resource, object, err := parseCanRequest(req...)
+	if err != nil {
+		return Explanation{}, err
+	}
+	enforcer, err := newKubeOrFileEnforcer(ctx, k, filePath)
+	if err != nil {
+		return Explanation{}, err
+	}
+	return explain(enforcer, user, resource, action, object)
+}
+
+func explain(enforcer casbin.IEnforcer, subject, resource, action, object string) (Explanation, error) {
+	allowed, err := enforcer.Enforce(subject, resource, action, object)
+	if err != nil {
+		return Explanation{}, err
+	}
+	policies, err := enforcer.GetPolicy()
+	if err != nil {
+		return Explanation{}, err
+	}
+
+	result := Explanation{Allowed: allowed}
+	for _, policy := range policies {
+		roles, ok, err := roleChain(enforcer, subject, policy[0])
+		if err != nil {
+			return Explanation{}, err
+		}
+		if !ok {
+			continue
+		}
+		matches, err := matchesPolicy(policy, resource, action, object)
+		if err != nil {
+			return Explanation{}, err
+		}
+		if matches {
+			result.Matches = append(result.Matches, PolicyMatch{
+				Policy: policy,
+				Roles:  roles,
+			})
+		}
+	}
+	return result, nil
+}
+
+// matchesPolicy returns true if the resource, action and object match the given policy.
+// It mirrors the matcher defined in the RBAC model.
+func matchesPolicy(policy []string, resource, action, object string) (bool, error) {
+	if len(policy) < 4 { //nolint:mnd
+		return false, errors.New("invalid policy")
+	}
+	for _, pair := range [][2]string{
+		{resource, policy[1]},
+		{object, policy[3]},
+	} {
+		ok, err := util.GlobMatch(pair[0], pair[1])
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	// An action also matches its sub-actions, e.g. `update` matches `update:scale`.
+	for _, pattern := range []string{policy[2], policy[2] + subActionSeparator + "*"} {
+		ok, err := util.GlobMatch(action, pattern)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+// roleChain returns the chain of roles that links the subject to the target role.
+// Returns false if the subject does not have the target role.
+func roleChain(enforcer casbin.IEnforcer, subject, target string) ([]string, bool, error) {
+	if subject == target {
+		return nil, true, nil
+	}
+	// Breadth-first search, so that the shortest chain is returned.
+	parents := map[string]string{subject: ""}
+	queue := []string{subject}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		roles, err := enforcer.GetRolesForUser(current)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, role := range roles {
+			if _, visited := parents[role]; visited {
+				continue
+			}
+			parents[role] = current
+			if role != target {
+				queue = append(queue, role)
+				continue
+			}
+			chain := []string{}
+			for r := role; r != subject; r = parents[r] {
+				chain = append(chain, r)
+			}
+			slices.Reverse(chain)
+			return chain, true, nil
+		}
+	}
+	return nil, false, nil
+}
@@ -0,0 +1,186 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplain(t *testing.T) {
+	t.Parallel()
+	enforcer, err := NewEnforcerFromFilePath("./testdata/policy-8-deny.csv")
+	require.NoError(t, err)
+
+	testcases := []struct {
+		description string
+		subject     string
+		action      string
+		object      string
+		expected    Explanation
+	}{
+		{
+			description: "allowed through a role",
+			subject:     "bob",
+			action:      ActionCreate,
+			object:      "dev/cluster-1",
+			expected: Explanation{
+				Allowed: true,
+				Matches: []PolicyMatch{
+					{
+						Policy: []string{"role:devteam", ResourceDatabaseClusters, "*", "*/*", "allow"},
+						Roles:  []string{"role:devteam"},
+					},
+				},
+			},
+		},
+		{
+			description: "denied by a deny rule",
+			subject:     "bob",
+			action:      ActionDelete,
+			object:      "prod/cluster-1",
+			expected: Explanation{
+				Allowed: false,
+				Matches: []PolicyMatch{
+					{
+						Policy: []string{"role:devteam", ResourceDatabaseClusters, "*", "*/*", "allow"},
+						Roles:  []string{"role:devteam"},
+					},
+					{
+						Policy: []string{"role:devteam", ResourceDatabaseClusters, "delete", "prod/*", "deny"},
+						Roles:  []string{"role:devteam"},
+					},
+				},
+			},
+		},
+		{
+			description: "allowed through nested roles",
+			subject:     "carol",
+			action:      ActionRead,
+			object:      "dev/cluster-1",
+			expected: Explanation{
+				Allowed: true,
+				Matches: []PolicyMatch{
+					{
+						Policy: []string{"role:devteam", ResourceDatabaseClusters, "*", "*/*", "allow"},
+						Roles:  []string{"role:qa", "role:devteam"},
+					},
+					{
+						Policy: []string{"role:qa", ResourceDatabaseClusters, "read", "*/*", "allow"},
+						Roles:  []string{"role:qa"},
+					},
+				},
+			},
+		},
+		{
+			description: "sub-action allowed by its parent action",
+			subject:     "carol",
+			action:      ActionUpdateScale,
+			object:      "dev/cluster-1",
+			expected: Explanation{
+				Allowed: true,
+				Matches: []PolicyMatch{
+					{
+						Policy: []string{"role:devteam", ResourceDatabaseClusters, "*", "*/*", "allow"},
+						Roles:  []string{"role:qa", "role:devteam"},
+					},
+				},
+			},
+		},
+		{
+			description: "sub-action denied",
+			subject:     "carol",
+			action:      ActionUpdateExpose,
+			object:      "dev/cluster-1",
+			expected: Explanation{
+				Allowed: false,
+				Matches: []PolicyMatch{
+					{
+						Policy: []string{"role:devteam", ResourceDatabaseClusters, "*", "*/*", "allow"},
+						Roles:  []string{"role:qa", "role:devteam"},
+					},
+					{
+						Policy: []string{"role:qa", ResourceDatabaseClusters, "update:expose", "*/*", "deny"},
+						Roles:  []string{"role:qa"},
+					},
+				},
+			},
+		},
+		{
+			description: "policy defined for the role itself",
+			subject:     "role:qa",
+			action:      ActionRead,
+			object:      "dev/cluster-1",
+			expected: Explanation{
+				Allowed: true,
+				Matches: []PolicyMatch{
+					{
+						Policy: []string{"role:devteam", ResourceDatabaseClusters, "*", "*/*", "allow"},
+						Roles:  []string{"role:devteam"},
+					},
+					{
+						Policy: []string{"role:qa", ResourceDatabaseClusters, "read", "*/*", "allow"},
+					},
+				},
+			},
+		},
+		{
+			description: "no matching policy",
+			subject:     "alice",
+			action:      ActionRead,
+			object:      "dev/cluster-1",
+			expected:    Explanation{Allowed: false},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+			result, err := explain(enforcer, tc.subject, ResourceDatabaseClusters, tc.action, tc.object)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected.Allowed, result.Allowed)
+			assert.ElementsMatch(t, tc.expected.Matches, result.Matches)
+		})
+	}
+}
+
+func TestTestPolicy(t *testing.T) {
+	t.Parallel()
+	tests, err := ReadPolicyTests("./testdata/policy-8-tests.yaml")
+	require.NoError(t, err)
+	require.Len(t, tests.Tests, 4)
+
+	results, err := TestPolicy("./testdata/policy-8-deny.csv", tests)
+	require.NoError(t, err)
+	for _, r := range results {
+		assert.True(t, r.Passed(), r.Test.String())
+	}
+
+	// A failing assertion is reported in the results.
+	failing := PolicyTest{
+		Subject:  "bob",
+		Action:   ActionDelete,
+		Resource: ResourceDatabaseClusters,
+		Object:   "dev/cluster-1",
+		Allowed:  false,
+	}
+	results, err = TestPolicy("./testdata/policy-8-deny.csv", PolicyTests{Tests: []PolicyTest{failing}})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.False(t, results[0].Passed())
+	assert.Equal(t, "bob delete database-clusters dev/cluster-1", results[0].Test.String())
+}
@@ -58,9 +58,14 @@ func New(path string) (*Adapter, error) {
 		return nil, errors.New("unsupported file format")
 	}
 
+	return NewFromContent(policy), nil
+}
+
+// NewFromContent returns a new adapter that reads the given policy in CSV format.
+func NewFromContent(policy string) *Adapter {
 	return &Adapter{
 		content: policy,
-	}, nil
+	}
 }
 
 // LoadPolicy loads all policy rules from the storage.
@@ -0,0 +1,248 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rbac
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/percona/everest/pkg/common"
+)
+
+const (
+	historyFile = "history.yaml"
+
+	versionAnnotation   = "everest.percona.com/rbac-version"
+	updatedByAnnotation = "everest.percona.com/rbac-updated-by"
+	updatedAtAnnotation = "everest.percona.com/rbac-updated-at"
+)
+
+var (
+	// ErrInvalidPolicy is returned when trying to store a policy that does not pass validation.
+	ErrInvalidPolicy = errors.New("invalid RBAC policy")
+	// ErrPolicyVersionConflict is returned when the stored policy was changed since the expected version.
+	ErrPolicyVersionConflict = errors.New("RBAC policy was modified concurrently")
+)
+
+// configMapClient is the subset of the Kubernetes client used for storing the RBAC settings.
+type configMapClient interface {
+	GetConfigMap(ctx context.Context, namespace, name string) (*corev1.ConfigMap, error)
+	CreateConfigMap(ctx context.Context, configMap *corev1.ConfigMap) (*corev1.ConfigMap, error)
+	UpdateConfigMap(ctx context.Context, configMap *corev1.ConfigMap) (*corev1.ConfigMap, error)
+}
+
+// PolicyVersion is a version of the RBAC settings.
+type PolicyVersion struct {
+	// Version is incremented every time the RBAC settings are changed.
+	Version int `yaml:"version"`
+	// Enabled is true if RBAC is enforced.
+	Enabled bool `yaml:"enabled"`
+	// Policy is the RBAC policy in CSV format.
+	Policy string `yaml:"policy"`
+	// UpdatedBy is the user that set this version.
+	UpdatedBy string `yaml:"updatedBy,omitempty"`
+	// UpdatedAt is the time at which this version was set.
+	UpdatedAt time.Time `yaml:"updatedAt,omitempty"`
+}
+
+// PolicyStore reads and updates the RBAC settings stored in the everest-rbac ConfigMap.
+// Every update is validated before it is stored, and the previous versions
+// are kept in a separate ConfigMap so that they can be restored.
+type PolicyStore struct {
+	k           configMapClient
+	historySize int
+}
+
+// NewPolicyStore returns a new PolicyStore that keeps up to historySize previous versions.
+func NewPolicyStore(k configMapClient, historySize int) *PolicyStore {
+	return &PolicyStore{
+		k:           k,
+		historySize: historySize,
+	}
+}
+
+// Get returns the current RBAC settings.
+func (s *PolicyStore) Get(ctx context.Context) (*PolicyVersion, error) {
+	cm, err := s.k.GetConfigMap(ctx, common.SystemNamespace, common.EverestRBACConfigMapName)
+	if err != nil {
+		return nil, err
+	}
+	return policyVersionFromConfigMap(cm)
+}
+
+// Set validates and stores the given RBAC settings on behalf of user.
+// If expectedVersion is not nil, the settings are stored only if the current
+// version matches it, otherwise ErrPolicyVersionConflict is returned.
+// The replaced version is added to the history.
+func (s *PolicyStore) Set(
+	ctx context.Context,
+	enabled bool,
+	policy, user string,
+	expectedVersion *int,
+) (*PolicyVersion, error) {
+	if err := ValidatePolicyContent(policy); err != nil {
+		return nil, errors.Join(ErrInvalidPolicy, err)
+	}
+
+	var result *PolicyVersion
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := s.k.GetConfigMap(ctx, common.SystemNamespace, common.EverestRBACConfigMapName)
+		if err != nil {
+			return err
+		}
+		current, err := policyVersionFromConfigMap(cm)
+		if err != nil {
+			return err
+		}
+		if expectedVersion != nil && *expectedVersion != current.Version {
+			return ErrPolicyVersionConflict
+		}
+		if err := s.addToHistory(ctx, *current); err != nil {
+			return errors.Join(err, errors.New("failed to store RBAC settings history"))
+		}
+
+		result = &PolicyVersion{
+			Version:   current.Version + 1,
+			Enabled:   enabled,
+			Policy:    policy,
+			UpdatedBy: user,
+			UpdatedAt: time.Now().UTC().Truncate(time.Second),
+		}
+		if cm.Data == nil {
+			cm.Data = make(map[string]string)
+		}
+		cm.Data[enabledKey] = strconv.FormatBool(enabled)
+		cm.Data[policyFile] = policy
+		if cm.Annotations == nil {
+			cm.Annotations = make(map[string]string)
+		}
+		cm.Annotations[versionAnnotation] = strconv.Itoa(result.Version)
+		cm.Annotations[updatedByAnnotation] = result.UpdatedBy
+		cm.Annotations[updatedAtAnnotation] = result.UpdatedAt.Format(time.RFC3339)
+		_, err = s.k.UpdateConfigMap(ctx, cm)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// History returns the previous versions of the RBAC settings, newest first.
+func (s *PolicyStore) History(ctx context.Context) ([]PolicyVersion, error) {
+	cm, err := s.k.GetConfigMap(ctx, common.SystemNamespace, common.EverestRBACHistoryConfigMapName)
+	if k8serrors.IsNotFound(err) {
+		return []PolicyVersion{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return historyFromConfigMap(cm)
+}
+
+// addToHistory stores the given version at the top of the history
+// and drops the versions that exceed the history size.
+func (s *PolicyStore) addToHistory(ctx context.Context, version PolicyVersion) error {
+	if s.historySize <= 0 {
+		return nil
+	}
+	shouldRetry := func(err error) bool {
+		return k8serrors.IsConflict(err) || k8serrors.IsAlreadyExists(err)
+	}
+	return retry.OnError(retry.DefaultRetry, shouldRetry, func() error {
+		exists := true
+		cm, err := s.k.GetConfigMap(ctx, common.SystemNamespace, common.EverestRBACHistoryConfigMapName)
+		if k8serrors.IsNotFound(err) {
+			exists = false
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      common.EverestRBACHistoryConfigMapName,
+					Namespace: common.SystemNamespace,
+				},
+			}
+		} else if err != nil {
+			return err
+		}
+
+		history, err := historyFromConfigMap(cm)
+		if err != nil {
+			return err
+		}
+		// A version may already be present if a previous update failed after storing the history.
+		history = slices.DeleteFunc(history, func(v PolicyVersion) bool {
+			return v.Version == version.Version
+		})
+		history = append([]PolicyVersion{version}, history...)
+		if len(history) > s.historySize {
+			history = history[:s.historySize]
+		}
+
+		data, err := yaml.Marshal(history)
+		if err != nil {
+			return err
+		}
+		if cm.Data == nil {
+			cm.Data = make(map[string]string)
+		}
+		cm.Data[historyFile] = string(data)
+
+		if !exists {
+			_, err = s.k.CreateConfigMap(ctx, cm)
+		} else {
+			_, err = s.k.UpdateConfigMap(ctx, cm)
+		}
+		return err
+	})
+}
+
+func policyVersionFromConfigMap(cm *corev1.ConfigMap) (*PolicyVersion, error) {
+	result := &PolicyVersion{
+		Enabled:   IsEnabled(cm),
+		Policy:    cm.Data[policyFile],
+		UpdatedBy: cm.GetAnnotations()[updatedByAnnotation],
+	}
+	if v, ok := cm.GetAnnotations()[versionAnnotation]; ok {
+		version, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed to parse RBAC settings version"))
+		}
+		result.Version = version
+	}
+	if v, ok := cm.GetAnnotations()[updatedAtAnnotation]; ok {
+		updatedAt, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed to parse RBAC settings update time"))
+		}
+		result.UpdatedAt = updatedAt
+	}
+	return result, nil
+}
+
+func historyFromConfigMap(cm *corev1.ConfigMap) ([]PolicyVersion, error) {
+	history := []PolicyVersion{}
+	if err := yaml.Unmarshal([]byte(cm.Data[historyFile]), &history); err != nil {
+		return nil, errors.Join(err, errors.New("failed to parse RBAC settings history"))
+	}
+	return history, nil
+}
@@ -0,0 +1,89 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes/client"
+)
+
+func TestPolicyStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	k := client.NewFromFakeClient()
+	_, err := k.CreateConfigMap(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.EverestRBACConfigMapName,
+			Namespace: common.SystemNamespace,
+		},
+		Data: map[string]string{
+			enabledKey: "false",
+			policyFile: "g, admin, role:admin",
+		},
+	})
+	require.NoError(t, err)
+
+	store := NewPolicyStore(k, 2)
+	current, err := store.Get(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, current.Version)
+	assert.False(t, current.Enabled)
+
+	// Invalid policies are rejected.
+	_, err = store.Set(ctx, true, "p, role:test, unknown-resource, read, */*", "alice", nil)
+	require.ErrorIs(t, err, ErrInvalidPolicy)
+
+	// Updates with an outdated version are rejected.
+	_, err = store.Set(ctx, true, "g, admin, role:admin", "alice", pointer.ToInt(1))
+	require.ErrorIs(t, err, ErrPolicyVersionConflict)
+
+	policies := []string{
+		"p, role:test, database-clusters, read, */*\ng, alice, role:test",
+		"p, role:test, database-clusters, *, */*\ng, alice, role:test",
+		"p, role:test, database-engines, read, */*\ng, alice, role:test",
+	}
+	for i, policy := range policies {
+		v, err := store.Set(ctx, true, policy, "alice", pointer.ToInt(i))
+		require.NoError(t, err)
+		assert.Equal(t, i+1, v.Version)
+	}
+
+	current, err = store.Get(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, current.Version)
+	assert.True(t, current.Enabled)
+	assert.Equal(t, policies[2], current.Policy)
+	assert.Equal(t, "alice", current.UpdatedBy)
+	assert.False(t, current.UpdatedAt.IsZero())
+
+	// Only the last two previous versions are kept, newest first.
+	history, err := store.History(ctx)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, 2, history[0].Version)
+	assert.Equal(t, policies[1], history[0].Policy)
+	assert.Equal(t, 1, history[1].Version)
+	assert.Equal(t, policies[0], history[1].Policy)
+}
@@ -0,0 +1,98 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rbac
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// PolicyTests is a table of assertions that a policy is expected to satisfy.
+type PolicyTests struct {
+	Tests []PolicyTest `yaml:"tests"`
+}
+
+// PolicyTest asserts whether a request is expected to be allowed by a policy.
+type PolicyTest struct {
+	// Description of the test, used for reporting its result.
+	Description string `yaml:"description,omitempty"`
+	Subject     string `yaml:"subject"`
+	Action      string `yaml:"action"`
+	Resource    string `yaml:"resource"`
+	Object      string `yaml:"object"`
+	// Allowed is true if the request is expected to be allowed.
+	Allowed bool `yaml:"allowed"`
+}
+
+// String returns the description of the test, or the request if no description is set.
+func (t PolicyTest) String() string {
+	if t.Description != "" {
+		return t.Description
+	}
+	return fmt.Sprintf("%s %s %s %s", t.Subject, t.Action, t.Resource, t.Object)
+}
+
+// PolicyTestResult holds the outcome of a PolicyTest.
+type PolicyTestResult struct {
+	Test PolicyTest
+	// Allowed is true if the policy allowed the request.
+	Allowed bool
+}
+
+// Passed returns true if the policy decided as expected by the test.
+func (r PolicyTestResult) Passed() bool {
+	return r.Test.Allowed == r.Allowed
+}
+
+// ReadPolicyTests reads the policy tests stored in YAML format at the given path.
+func ReadPolicyTests(path string) (PolicyTests, error) {
+	content, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return PolicyTests{}, err
+	}
+	tests := PolicyTests{}
+	if err := yaml.Unmarshal(content, &tests); err != nil {
+		return PolicyTests{}, fmt.Errorf("failed to unmarshal policy tests: %w", err)
+	}
+	return tests, nil
+}
+
+// TestPolicy runs the given tests against the policy stored at policyFilePath.
+func TestPolicy(policyFilePath string, tests PolicyTests) ([]PolicyTestResult, error) {
+	enforcer, err := NewEnforcerFromFilePath(policyFilePath)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]PolicyTestResult, 0, len(tests.Tests))
+	for i, test := range tests.Tests {
+		_, action, resource, object, err := parseCanRequest(test.Subject, test.Action, test.Resource, test.Object)
+		if err != nil {
+			return nil, err
+		}
+		allowed, err := enforcer.Enforce(test.Subject, resource, action, object)
+		if err != nil {
+			return nil, errors.Join(err, fmt.Errorf("failed to run test %d", i))
+		}
+		results = append(results, PolicyTestResult{
+			Test:    test,
+			Allowed: allowed,
+		})
+	}
+	return results, nil
+}
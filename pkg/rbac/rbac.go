@@ -35,16 +35,20 @@ import (
 
 	everestclient "github.com/percona/everest/client"
 	"github.com/percona/everest/data"
+	"github.com/percona/everest/pkg/accounts"
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
 	"github.com/percona/everest/pkg/kubernetes/informer"
+	"github.com/percona/everest/pkg/metrics"
 	configmapadapter "github.com/percona/everest/pkg/rbac/configmap-adapter"
 	"github.com/percona/everest/pkg/rbac/fileadapter"
+	rbacutils "github.com/percona/everest/pkg/rbac/utils"
 	"github.com/percona/everest/pkg/session"
 )
 
 // Everest API resource names.
 const (
+	ResourceAPIKeys                    = "api-keys"
 	ResourceBackupStorages             = "backup-storages"
 	ResourceDatabaseClusters           = "database-clusters"
 	ResourceDatabaseClusterBackups     = "database-cluster-backups"
@@ -53,6 +57,7 @@ const (
 	ResourceDatabaseEngines            = "database-engines"
 	ResourceMonitoringInstances        = "monitoring-instances"
 	ResourceNamespaces                 = "namespaces"
+	ResourceRBACSettings               = "rbac-settings"
 )
 
 // RBAC actions.
@@ -63,12 +68,48 @@ const (
 	ActionDelete = "delete"
 )
 
+// RBAC sub-actions of ActionUpdate for sensitive changes to database clusters.
+// A policy that allows an action also allows all of its sub-actions, so these
+// are typically used in deny rules for restricting what an update may change.
+const (
+	// ActionUpdateScale is required for changing the replicas or resources of a database cluster.
+	ActionUpdateScale = ActionUpdate + subActionSeparator + "scale"
+	// ActionUpdateExpose is required for changing how a database cluster is exposed.
+	ActionUpdateExpose = ActionUpdate + subActionSeparator + "expose"
+	// ActionUpdateVersion is required for changing the engine version of a database cluster.
+	ActionUpdateVersion = ActionUpdate + subActionSeparator + "version"
+	// ActionUpdateBackup is required for changing the backup schedules or PITR settings of a database cluster.
+	ActionUpdateBackup = ActionUpdate + subActionSeparator + "backup"
+	// ActionUpdateUnsafe is required for toggling the unsafe configuration of a database cluster.
+	ActionUpdateUnsafe = ActionUpdate + subActionSeparator + "unsafe"
+
+	subActionSeparator = ":"
+)
+
 const (
 	rbacEnabledValueTrue = "true"
+	policyFile           = "policy.csv"
+	enabledKey           = "enabled"
+
+	userContextKey = "everest-user"
 )
 
+// User holds the identity of the user that made a request.
+type User struct {
+	// Name of the user.
+	Name string
+	// Groups that the user belongs to, as provided by the identity provider.
+	Groups []string
+}
+
+// Subjects returns the subjects that RBAC policies are evaluated against for the user.
+func (u User) Subjects() []string {
+	return append([]string{u.Name}, u.Groups...)
+}
+
 // Setup a new informer that watches our RBAC ConfigMap.
 // This informer reloads the policy whenever the ConfigMap is updated.
+// If the updated policy is invalid, the enforcer keeps using the last valid policy.
 func refreshEnforcerInBackground(
 	ctx context.Context,
 	kubeClient *kubernetes.Kubernetes,
@@ -78,6 +119,7 @@ func refreshEnforcerInBackground(
 	inf, err := informer.New(
 		informer.WithConfig(kubeClient.Config()),
 		informer.WithLogger(l),
+		informer.WithName("rbac-policy"),
 		informer.Watches(&corev1.ConfigMap{}, kubeClient.Namespace()),
 	)
 	inf.OnUpdate(func(_, newObj interface{}) {
@@ -85,15 +127,18 @@ func refreshEnforcerInBackground(
 		if !ok || cm.GetName() != common.EverestRBACConfigMapName {
 			return
 		}
-		if err := enforcer.LoadPolicy(); err != nil {
-			panic("invalid policy detected - " + err.Error())
+		// Validate the policy before loading it, so that the enforcer is left untouched if it is invalid.
+		if err := ValidatePolicyContent(cm.Data[policyFile]); err != nil {
+			l.Error(errors.Join(err, errors.New("invalid RBAC policy detected, keeping the last valid policy")))
+			return
 		}
-		if err := validatePolicy(enforcer); err != nil {
-			panic("invalid policy detected - " + err.Error())
+		if err := enforcer.LoadPolicy(); err != nil {
+			l.Error(errors.Join(err, errors.New("failed to load RBAC policy, keeping the last valid policy")))
+			return
 		}
 		// Calling LoadPolicy() re-writes the entire model, so we need to add back the admin role.
 		if err := loadAdminPolicy(enforcer); err != nil {
-			panic("failed to load admin policy - " + err.Error())
+			l.Error(errors.Join(err, errors.New("failed to load admin policy")))
 		}
 		enforcer.EnableEnforce(IsEnabled(cm))
 	})
@@ -138,6 +183,11 @@ func NewEnforcerFromFilePath(filePath string) (*casbin.Enforcer, error) {
 	return newEnforcer(adapter, false)
 }
 
+// NewEnforcerFromPolicy creates a new Casbin enforcer with the given policy in CSV format.
+func NewEnforcerFromPolicy(policy string) (*casbin.Enforcer, error) {
+	return newEnforcer(fileadapter.NewFromContent(policy), false)
+}
+
 // NewEnforcer creates a new Casbin enforcer with the RBAC model and ConfigMap adapter.
 func NewEnforcer(ctx context.Context, kubeClient *kubernetes.Kubernetes, l *zap.SugaredLogger) (*casbin.Enforcer, error) {
 	cmReq := types.NamespacedName{
@@ -157,8 +207,35 @@ func NewEnforcer(ctx context.Context, kubeClient *kubernetes.Kubernetes, l *zap.
 	return enforcer, refreshEnforcerInBackground(ctx, kubeClient, enforcer, l)
 }
 
-// GetUser extracts the user from the JWT token in the context.
-func GetUser(c echo.Context) (string, error) {
+// GetUser returns the user that made the request.
+// If the user was not stored in the context using SetUser, it is extracted from the JWT token in the context.
+func GetUser(c echo.Context) (User, error) {
+	if user, ok := c.Get(userContextKey).(User); ok {
+		return user, nil
+	}
+	name, err := userFromToken(c)
+	if err != nil {
+		return User{}, err
+	}
+	return User{Name: name}, nil
+}
+
+// SetUser stores the user that made the request in the context.
+func SetUser(c echo.Context, user User) {
+	c.Set(userContextKey, user)
+}
+
+// GetUsername returns the name of the user that made the request.
+func GetUsername(c echo.Context) (string, error) {
+	user, err := GetUser(c)
+	if err != nil {
+		return "", err
+	}
+	return user.Name, nil
+}
+
+// userFromToken extracts the username from the JWT token in the context.
+func userFromToken(c echo.Context) (string, error) {
 	token, ok := c.Get("user").(*jwt.Token) // by default token is stored under `user` key
 	if !ok {
 		return "", errors.New("failed to get token from context")
@@ -185,6 +262,25 @@ func GetUser(c echo.Context) (string, error) {
 	return subject, nil
 }
 
+// loggedInWithEverest returns true if the JWT token in the context was issued by Everest
+// for a login session of the given user. API key tokens are not login sessions.
+func loggedInWithEverest(c echo.Context, username string) bool {
+	token, ok := c.Get("user").(*jwt.Token)
+	if !ok {
+		return false
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return false
+	}
+	issuer, err := claims.GetIssuer()
+	if err != nil || issuer != session.SessionManagerClaimsIssuer {
+		return false
+	}
+	subject, err := claims.GetSubject()
+	return err == nil && subject == session.Subject(username, accounts.AccountCapabilityLogin)
+}
+
 func loadAdminPolicy(enf casbin.IEnforcer) error {
 	paths, _, err := buildPathResourceMap("") // reads the swagger API definition
 	if err != nil {
@@ -200,7 +296,7 @@ func loadAdminPolicy(enf casbin.IEnforcer) error {
 		if resource == ResourceNamespaces {
 			object = "*"
 		}
-		if _, err := enf.AddPolicy(common.EverestAdminRole, resource, action, object); err != nil {
+		if _, err := enf.AddPolicy(common.EverestAdminRole, resource, action, object, rbacutils.EffectAllow); err != nil {
 			return err
 		}
 	}
@@ -238,38 +334,57 @@ func buildPathResourceMap(basePath string) (map[string]string, []string, error)
 	return resourceMap, skipPaths, nil
 }
 
-// NewEnforceHandler returns a function that checks if a user is allowed to access a resource.
-func NewEnforceHandler(l *zap.SugaredLogger, basePath string, enforcer *casbin.Enforcer) func(c echo.Context, user string) (bool, error) {
+// RequestResolver returns the RBAC resource, action and object a request refers to.
+type RequestResolver func(c echo.Context) (resource, action, object string, err error)
+
+// NewRequestResolver returns a new RequestResolver for the API served at basePath.
+func NewRequestResolver(basePath string) (RequestResolver, error) {
 	pathResourceMap, _, err := buildPathResourceMap(basePath)
 	if err != nil {
-		panic("failed to build path resource map: " + err.Error())
+		return nil, err
 	}
-	return func(c echo.Context, user string) (bool, error) {
-		actionMethodMap := map[string]string{
-			http.MethodGet:    ActionRead,
-			http.MethodPost:   ActionCreate,
-			http.MethodPut:    ActionUpdate,
-			http.MethodPatch:  ActionUpdate,
-			http.MethodDelete: ActionDelete,
-		}
-		var resource string
-		var object string
+	actionMethodMap := map[string]string{
+		http.MethodGet:    ActionRead,
+		http.MethodPost:   ActionCreate,
+		http.MethodPut:    ActionUpdate,
+		http.MethodPatch:  ActionUpdate,
+		http.MethodDelete: ActionDelete,
+	}
+	return func(c echo.Context) (string, string, string, error) {
 		resource, ok := pathResourceMap[c.Path()]
 		if !ok {
-			return false, errors.New("invalid URL")
+			return "", "", "", errors.New("invalid URL")
 		}
 		action, ok := actionMethodMap[c.Request().Method]
 		if !ok {
-			return false, errors.New("invalid method")
+			return "", "", "", errors.New("invalid method")
+		}
+		return resource, action, ObjectName(c.Param("namespace"), c.Param("name")), nil
+	}, nil
+}
+
+// NewEnforceHandler returns a function that checks if a user is allowed to access a resource.
+func NewEnforceHandler(l *zap.SugaredLogger, basePath string, enforcer *casbin.Enforcer) func(c echo.Context, user string) (bool, error) {
+	resolve, err := NewRequestResolver(basePath)
+	if err != nil {
+		panic("failed to build path resource map: " + err.Error())
+	}
+	return func(c echo.Context, username string) (bool, error) {
+		resource, action, object, err := resolve(c)
+		if err != nil {
+			return false, err
 		}
-		namespace := c.Param("namespace")
 		name := c.Param("name")
-		object = namespace + "/" + name
 		// Always allowing listing all namespaces.
 		// The result is filtered based on permission.
 		if resource == ResourceNamespaces {
 			return true, nil
 		}
+		// Everest users can always manage the API keys of their own account from a login session.
+		// Requests made with an API key go through the policies, so a leaked key cannot issue new ones.
+		if resource == ResourceAPIKeys && name == username && loggedInWithEverest(c, username) {
+			return true, nil
+		}
 		// Allow creating a restore without a name.
 		// RBAC is enforced in the individual methods.
 		if resource == ResourceDatabaseClusterRestores && name == "" && action == ActionCreate {
@@ -287,14 +402,39 @@ func NewEnforceHandler(l *zap.SugaredLogger, basePath string, enforcer *casbin.E
 		if slices.Contains(allowedObjectsForListing, resource) && name == "" && action == ActionRead {
 			return true, nil
 		}
-		if ok, err := enforcer.Enforce(user, resource, action, object); err != nil {
+		user, err := GetUser(c)
+		if err != nil {
+			return false, err
+		}
+		if ok, err := Enforce(enforcer, user, resource, action, object); err != nil {
 			return false, errors.Join(err, errors.New("failed to enforce policy"))
 		} else if !ok {
-			l.Warnf("Permission denied: [%s %s %s %s]", user, resource, action, object)
+			l.Warnf("Permission denied: [%s %s %s %s]", username, resource, action, object)
+			metrics.IncRBACDenial(resource, action)
+			return false, nil
+		}
+		return true, nil
+	}
+}
+
+// Enforce checks if the user, or any of the groups the user belongs to,
+// is allowed to perform the action on the given resource object.
+// The user and the groups are evaluated as a single decision, so a deny rule
+// that matches any of them denies the request, even if another one is allowed.
+func Enforce(enforcer casbin.IEnforcer, user User, resource, action, object string) (bool, error) {
+	allowed := false
+	for _, subject := range user.Subjects() {
+		ok, explain, err := enforcer.EnforceEx(subject, resource, action, object)
+		if err != nil {
+			return false, err
+		}
+		// When a request is denied by a deny rule, the explanation holds that rule.
+		if !ok && len(explain) > 0 && explain[len(explain)-1] == rbacutils.EffectDeny {
 			return false, nil
 		}
-		return enforcer.Enforce(user, resource, action, object)
+		allowed = allowed || ok
 	}
+	return allowed, nil
 }
 
 // NewSkipper returns a new function that checks if a given request should be skipped
@@ -312,26 +452,37 @@ func NewSkipper(basePath string) (func(echo.Context) bool, error) {
 // Can checks if a user is allowed to perform an action on a resource.
 // Input request should be of the form [user action resource object].
 func Can(ctx context.Context, filePath string, k *kubernetes.Kubernetes, req ...string) (bool, error) {
+	user, action, resource, object, err := parseCanRequest(req...)
+	if err != nil {
+		return false, err
+	}
+	enforcer, err := newKubeOrFileEnforcer(ctx, k, filePath)
+	if err != nil {
+		return false, err
+	}
+	return enforcer.Enforce(user, resource, action, object)
+}
+
+// parseCanRequest parses a request of the form [user action resource object].
+//
+//nolint:nonamedreturns
+func parseCanRequest(req ...string) (user, action, resource, object string, err error) {
 	if len(req) != 4 { //nolint:mnd
-		return false, errors.New("expected input of the form [user action resource object]")
+		return "", "", "", "", errors.New("expected input of the form [user action resource object]")
 	}
-	user, action, resource, object := req[0], req[1], req[2], req[3]
+	user, action, resource, object = req[0], req[1], req[2], req[3]
 	if object == "*" || object == "all" {
 		object = "/"
 		if resource == ResourceNamespaces {
 			object = ""
 		}
 	}
-	enforcer, err := newKubeOrFileEnforcer(ctx, k, filePath)
-	if err != nil {
-		return false, err
-	}
-	return enforcer.Enforce(user, resource, action, object)
+	return user, action, resource, object, nil
 }
 
 // IsEnabled returns true if enabled == 'true' in the given ConfigMap.
 func IsEnabled(cm *corev1.ConfigMap) bool {
-	return cm.Data["enabled"] == rbacEnabledValueTrue
+	return cm.Data[enabledKey] == rbacEnabledValueTrue
 }
 
 // ObjectName returns the a string that represents the name of an object in RBAC format.
@@ -0,0 +1,173 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rbac
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/everest/pkg/session"
+)
+
+func TestEnforce(t *testing.T) {
+	t.Parallel()
+	enforcer, err := NewEnforcerFromFilePath("./testdata/policy-1-good.csv")
+	require.NoError(t, err)
+
+	testcases := []struct {
+		description string
+		user        User
+		object      string
+		allowed     bool
+	}{
+		{
+			description: "user without groups",
+			user:        User{Name: "bob"},
+			object:      "dev/cluster-1",
+			allowed:     true,
+		},
+		{
+			description: "user allowed by a group",
+			user:        User{Name: "carol", Groups: []string{"qa-group", "dev-group"}},
+			object:      "dev/cluster-1",
+			allowed:     true,
+		},
+		{
+			description: "user not allowed by any group",
+			user:        User{Name: "carol", Groups: []string{"dev-group"}},
+			object:      "prod/cluster-1",
+			allowed:     false,
+		},
+		{
+			description: "user not in any group",
+			user:        User{Name: "carol"},
+			object:      "dev/cluster-1",
+			allowed:     false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.description, func(t *testing.T) {
+			allowed, err := Enforce(enforcer, tc.user, ResourceDatabaseClusters, ActionUpdate, tc.object)
+			require.NoError(t, err)
+			assert.Equal(t, tc.allowed, allowed)
+		})
+	}
+}
+
+func TestEnforceDeny(t *testing.T) {
+	t.Parallel()
+	enforcer, err := NewEnforcerFromPolicy(`
+p, role:devteam, database-clusters, *, dev/*
+p, role:qa, database-clusters, *, */*
+p, role:qa, database-clusters, delete, dev/*, deny
+p, carol, database-clusters, update, dev/cluster-1, deny
+p, dave, database-clusters, *, dev/*
+
+g, dev-group, role:devteam
+g, qa-group, role:qa
+`)
+	require.NoError(t, err)
+
+	testcases := []struct {
+		description string
+		user        User
+		action      string
+		allowed     bool
+	}{
+		{
+			description: "user deny overrides group allow",
+			user:        User{Name: "carol", Groups: []string{"dev-group"}},
+			action:      ActionUpdate,
+			allowed:     false,
+		},
+		{
+			description: "group deny overrides user allow",
+			user:        User{Name: "dave", Groups: []string{"qa-group"}},
+			action:      ActionDelete,
+			allowed:     false,
+		},
+		{
+			description: "group deny overrides another group allow",
+			user:        User{Name: "erin", Groups: []string{"dev-group", "qa-group"}},
+			action:      ActionDelete,
+			allowed:     false,
+		},
+		{
+			description: "allowed by a group without deny",
+			user:        User{Name: "erin", Groups: []string{"dev-group", "qa-group"}},
+			action:      ActionUpdate,
+			allowed:     true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+			allowed, err := Enforce(enforcer, tc.user, ResourceDatabaseClusters, tc.action, "dev/cluster-1")
+			require.NoError(t, err)
+			assert.Equal(t, tc.allowed, allowed)
+		})
+	}
+}
+
+func TestLoggedInWithEverest(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		description string
+		issuer      string
+		subject     string
+		expected    bool
+	}{
+		{
+			description: "login session",
+			issuer:      session.SessionManagerClaimsIssuer,
+			subject:     "ci:login",
+			expected:    true,
+		},
+		{
+			description: "API key",
+			issuer:      session.SessionManagerClaimsIssuer,
+			subject:     "ci:apiKey",
+			expected:    false,
+		},
+		{
+			description: "login session of another user",
+			issuer:      session.SessionManagerClaimsIssuer,
+			subject:     "admin:login",
+			expected:    false,
+		},
+		{
+			description: "external identity provider",
+			issuer:      "https://idp.example.com",
+			subject:     "ci:login",
+			expected:    false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+			c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+			c.Set("user", &jwt.Token{Claims: jwt.MapClaims{"iss": tc.issuer, "sub": tc.subject}})
+			assert.Equal(t, tc.expected, loggedInWithEverest(c, "ci"))
+		})
+	}
+}
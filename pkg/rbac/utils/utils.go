@@ -12,6 +12,13 @@ import (
 
 const (
 	numFieldsPolicyLine = 2
+	// numFieldsPolicyLineWithoutEffect is the number of fields of a `p` line that does not specify an effect.
+	numFieldsPolicyLineWithoutEffect = 5
+
+	// EffectAllow is the effect of a policy that allows a request.
+	EffectAllow = "allow"
+	// EffectDeny is the effect of a policy that denies a request, even if it is allowed by other policies.
+	EffectDeny = "deny"
 )
 
 // LoadPolicyLine loads a text line as a policy rule to model.
@@ -39,6 +46,10 @@ func LoadPolicyLine(line string, m model.Model) error {
 	if tokens[0] != "p" && tokens[0] != "g" {
 		return fmt.Errorf("invalid policy line '%s'", line)
 	}
+	// The effect is optional, policies that do not specify it allow the request.
+	if tokens[0] == "p" && len(tokens) == numFieldsPolicyLineWithoutEffect {
+		tokens = append(tokens, EffectAllow)
+	}
 
 	return persist.LoadPolicyArray(tokens, m)
 }
@@ -13,6 +13,7 @@ import (
 
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
+	rbacutils "github.com/percona/everest/pkg/rbac/utils"
 )
 
 // ErrPolicySyntax is returned when a policy has a syntax error.
@@ -30,6 +31,11 @@ func validatePolicy(enforcer *casbin.Enforcer) error {
 		}
 	}
 
+	// ensure that only known effects are used.
+	if err := checkEffects(policy); err != nil {
+		return errors.Join(errPolicySyntax, err)
+	}
+
 	// ensure that non-existent roles are not used.
 	roles, err := enforcer.GetAllRoles()
 	if err != nil {
@@ -59,6 +65,22 @@ func ValidatePolicy(
 	return validatePolicy(enforcer)
 }
 
+// ValidatePolicyContent validates the given policy in CSV format.
+//
+//nolint:nonamedreturns
+func ValidatePolicyContent(policy string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Join(errPolicySyntax, fmt.Errorf("cannot create enforcer: %v", r))
+		}
+	}()
+	enforcer, err := NewEnforcerFromPolicy(policy)
+	if err != nil {
+		return errors.Join(errPolicySyntax, err)
+	}
+	return validatePolicy(enforcer)
+}
+
 func checkResourceNames(policies [][]string) error {
 	resourcePathMap, _, err := buildPathResourceMap("")
 	if err != nil {
@@ -97,6 +119,16 @@ func checkRoles(roles []string, policies [][]string) error {
 	return nil
 }
 
+func checkEffects(policies [][]string) error {
+	for _, policy := range policies {
+		effect := policy[len(policy)-1]
+		if effect != rbacutils.EffectAllow && effect != rbacutils.EffectDeny {
+			return fmt.Errorf("invalid policy effect '%s'", effect)
+		}
+	}
+	return nil
+}
+
 func validateTerms(terms []string) error {
 	pattern := `^[/*-_:a-zA-Z0-9]+$`
 	compiled := regexp.MustCompile(pattern)
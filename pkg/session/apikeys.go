@@ -0,0 +1,117 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/percona/everest/pkg/accounts"
+)
+
+// CreateAPIKey issues a new API key for the given user and stores a record of it in the user's account.
+// Passing a value of `0` for secondsBeforeExpiry creates a key that never expires.
+// Returns the signed key along with the stored record.
+func (mgr *Manager) CreateAPIKey(
+	ctx context.Context,
+	username, description string,
+	secondsBeforeExpiry int64,
+) (string, *accounts.APIKey, error) {
+	account, err := mgr.accountManager.Get(ctx, username)
+	if err != nil {
+		return "", nil, err
+	}
+	if !account.Enabled {
+		return "", nil, accounts.ErrAccountDisabled
+	}
+	if !account.HasCapability(accounts.AccountCapabilityAPIKey) {
+		return "", nil, errors.Join(accounts.ErrInsufficientCapabilities, errors.New("user does not have capability to hold API keys"))
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return "", nil, err
+	}
+	token, err := mgr.Create(Subject(username, accounts.AccountCapabilityAPIKey), secondsBeforeExpiry, id.String())
+	if err != nil {
+		return "", nil, err
+	}
+
+	now := time.Now().UTC()
+	key := accounts.APIKey{
+		ID:          id.String(),
+		Description: description,
+		IssuedAt:    now.Format(time.RFC3339),
+	}
+	if secondsBeforeExpiry > 0 {
+		key.ExpiresAt = now.Add(time.Duration(secondsBeforeExpiry) * time.Second).Format(time.RFC3339)
+	}
+	err = mgr.updateAccount(ctx, username, func(a *accounts.Account) {
+		a.APIKeys = append(a.APIKeys, key)
+	})
+	if err != nil {
+		return "", nil, errors.Join(err, errors.New("failed to store API key"))
+	}
+	return token, &key, nil
+}
+
+// ListAPIKeys returns the API keys issued for the given user.
+func (mgr *Manager) ListAPIKeys(ctx context.Context, username string) ([]accounts.APIKey, error) {
+	account, err := mgr.accountManager.Get(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	return account.APIKeys, nil
+}
+
+// RevokeAPIKey revokes the API key with the given ID and removes its record from the user's account.
+func (mgr *Manager) RevokeAPIKey(ctx context.Context, username, id string) error {
+	if mgr.denylist == nil {
+		return errors.New("session revocation is not configured")
+	}
+	account, err := mgr.accountManager.Get(ctx, username)
+	if err != nil {
+		return err
+	}
+	idx := slices.IndexFunc(account.APIKeys, func(k accounts.APIKey) bool {
+		return k.ID == id
+	})
+	if idx < 0 {
+		return accounts.ErrAPIKeyNotFound
+	}
+
+	var expiresAt time.Time
+	if exp := account.APIKeys[idx].ExpiresAt; exp != "" {
+		expiresAt, err = time.Parse(time.RFC3339, exp)
+		if err != nil {
+			return errors.Join(err, errors.New("failed to parse API key expiry time"))
+		}
+	}
+	// Revoke the key before removing its record, so that a failure
+	// never leaves behind a valid key that cannot be listed.
+	if err := mgr.denylist.RevokeToken(ctx, id, expiresAt); err != nil {
+		return err
+	}
+	return mgr.updateAccount(ctx, username, func(a *accounts.Account) {
+		a.APIKeys = slices.DeleteFunc(a.APIKeys, func(k accounts.APIKey) bool {
+			return k.ID == id
+		})
+	})
+}
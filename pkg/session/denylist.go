@@ -0,0 +1,340 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes/informer"
+)
+
+const (
+	denylistFile = "denylist.yaml"
+)
+
+// secretClient is the subset of the Kubernetes client used for persisting the denylist.
+type secretClient interface {
+	GetSecret(ctx context.Context, namespace, name string) (*corev1.Secret, error)
+	CreateSecret(ctx context.Context, secret *corev1.Secret) (*corev1.Secret, error)
+	UpdateSecret(ctx context.Context, secret *corev1.Secret) (*corev1.Secret, error)
+}
+
+// Denylist holds the sessions that were revoked before they expired,
+// along with the refresh tokens that can still be exchanged.
+type Denylist struct {
+	// Tokens maps the ID (jti) of a revoked token to the time it expires at.
+	// A zero expiry time means that the token never expires.
+	Tokens map[string]time.Time `yaml:"tokens,omitempty"`
+	// Users maps a username, or a token subject of the form `username:capability`,
+	// to the time at which the matching sessions were revoked, in seconds.
+	// Any matching token issued until this time is considered revoked. Tokens record the time
+	// they are issued at in seconds, so the tokens issued within the same second are revoked as well.
+	Users map[string]time.Time `yaml:"users,omitempty"`
+	// RefreshTokens maps the family of a refreshable session to its current refresh token,
+	// which is the only one of the session that can be exchanged.
+	RefreshTokens map[string]RefreshToken `yaml:"refreshTokens,omitempty"`
+}
+
+// RefreshToken identifies the current refresh token of a session.
+type RefreshToken struct {
+	// ID is the ID (jti) of the refresh token.
+	ID string `yaml:"id"`
+	// ExpiresAt is the time the refresh token expires at.
+	ExpiresAt time.Time `yaml:"expiresAt"`
+}
+
+// IsRevoked returns true if the token with the given ID, issued for subject at issuedAt, was revoked.
+func (d *Denylist) IsRevoked(subject, id string, issuedAt time.Time) bool {
+	if _, found := d.Tokens[id]; found && id != "" {
+		return true
+	}
+	username := strings.Split(subject, ":")[0]
+	for _, key := range []string{username, subject} {
+		if revokedAt, found := d.Users[key]; found && !issuedAt.After(revokedAt) {
+			return true
+		}
+	}
+	return false
+}
+
+// prune removes the tokens and the refresh tokens that have already expired, since they can no longer be used anyway.
+// The revocations of the sessions of a capability are removed as well, once the sessions of
+// the whole user were revoked at the same time or later.
+// The other revocations of user sessions are kept, since API keys may never expire.
+func (d *Denylist) prune(now time.Time) {
+	for id, expiresAt := range d.Tokens {
+		if !expiresAt.IsZero() && expiresAt.Before(now) {
+			delete(d.Tokens, id)
+		}
+	}
+	for family, token := range d.RefreshTokens {
+		if token.ExpiresAt.Before(now) {
+			delete(d.RefreshTokens, family)
+		}
+	}
+	for subject, revokedAt := range d.Users {
+		username, _, found := strings.Cut(subject, ":")
+		if !found {
+			continue
+		}
+		if userRevokedAt, ok := d.Users[username]; ok && !userRevokedAt.Before(revokedAt) {
+			delete(d.Users, subject)
+		}
+	}
+}
+
+func denylistFromSecret(secret *corev1.Secret) (*Denylist, error) {
+	d := &Denylist{}
+	if err := yaml.Unmarshal(secret.Data[denylistFile], d); err != nil {
+		return nil, err
+	}
+	if d.Tokens == nil {
+		d.Tokens = make(map[string]time.Time)
+	}
+	if d.Users == nil {
+		d.Users = make(map[string]time.Time)
+	}
+	if d.RefreshTokens == nil {
+		d.RefreshTokens = make(map[string]RefreshToken)
+	}
+	return d, nil
+}
+
+// DenylistStore persists revoked sessions in a Kubernetes Secret.
+// It keeps an in-memory copy of the denylist so that checking a token
+// does not require a round trip to the Kubernetes API.
+type DenylistStore struct {
+	k        secretClient
+	mu       sync.RWMutex
+	denylist *Denylist
+}
+
+// NewDenylistStore returns a new DenylistStore that uses the given client for persisting the denylist.
+func NewDenylistStore(k secretClient) *DenylistStore {
+	return &DenylistStore{
+		k: k,
+		denylist: &Denylist{
+			Tokens: make(map[string]time.Time),
+			Users:  make(map[string]time.Time),
+		},
+	}
+}
+
+// IsRevoked returns true if the token with the given ID, issued for subject at issuedAt, was revoked.
+func (s *DenylistStore) IsRevoked(subject, id string, issuedAt time.Time) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.denylist.IsRevoked(subject, id, issuedAt)
+}
+
+// RevokeToken revokes the token with the given ID.
+// expiresAt is used for removing the token from the denylist once it has expired.
+func (s *DenylistStore) RevokeToken(ctx context.Context, id string, expiresAt time.Time) error {
+	if id == "" {
+		return errors.New("token ID cannot be empty")
+	}
+	return s.update(ctx, func(d *Denylist) {
+		d.Tokens[id] = expiresAt.UTC()
+	})
+}
+
+// RevokeTokenOnce revokes the token with the given ID, unless it is already revoked.
+// Returns false if the token had already been revoked.
+// The check and the revocation are done atomically, so the token can be used for
+// making sure that an action is performed at most once.
+func (s *DenylistStore) RevokeTokenOnce(ctx context.Context, id string, expiresAt time.Time) (bool, error) {
+	if id == "" {
+		return false, errors.New("token ID cannot be empty")
+	}
+	var revoked bool
+	err := s.update(ctx, func(d *Denylist) {
+		_, found := d.Tokens[id]
+		revoked = !found
+		d.Tokens[id] = expiresAt.UTC()
+	})
+	return revoked, err
+}
+
+// RotateRefreshToken replaces the current refresh token of the session identified by family
+// with the refresh token identified by nextID, which expires at expiresAt.
+// Returns false, without replacing it, if id is not the current refresh token of the session,
+// i.e. if the refresh token was already exchanged. The first refresh token of a session is current
+// until it is exchanged. The check and the replacement are done atomically.
+func (s *DenylistStore) RotateRefreshToken(
+	ctx context.Context,
+	family, id, nextID string,
+	expiresAt time.Time,
+) (bool, error) {
+	if family == "" || id == "" || nextID == "" {
+		return false, errors.New("session family and token IDs cannot be empty")
+	}
+	var rotated bool
+	err := s.update(ctx, func(d *Denylist) {
+		current, found := d.RefreshTokens[family]
+		rotated = !found || current.ID == id
+		if rotated {
+			d.RefreshTokens[family] = RefreshToken{ID: nextID, ExpiresAt: expiresAt.UTC()}
+		}
+	})
+	return rotated, err
+}
+
+// RevokeUser revokes all sessions issued until now for the given user.
+// If a subject of the form `username:capability` is passed, only the sessions
+// issued for that capability are revoked.
+func (s *DenylistStore) RevokeUser(ctx context.Context, subject string) error {
+	now := time.Now().UTC().Truncate(time.Second)
+	return s.update(ctx, func(d *Denylist) {
+		d.Users[subject] = now
+	})
+}
+
+// Load reads the denylist from the Kubernetes Secret.
+func (s *DenylistStore) Load(ctx context.Context) error {
+	secret, err := s.k.GetSecret(ctx, common.SystemNamespace, common.EverestSessionDenylistSecretName)
+	if k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return s.set(secret)
+}
+
+// Watch loads the denylist and starts an informer that keeps the
+// in-memory copy of the denylist in sync with the Kubernetes Secret.
+func (s *DenylistStore) Watch(ctx context.Context, cfg *rest.Config, l *zap.SugaredLogger) error {
+	if err := s.Load(ctx); err != nil {
+		return errors.Join(err, errors.New("failed to load session denylist"))
+	}
+	inf, err := informer.New(
+		informer.WithConfig(cfg),
+		informer.WithLogger(l),
+		informer.WithName("session-denylist"),
+		informer.Watches(&corev1.Secret{}, common.SystemNamespace),
+	)
+	if err != nil {
+		return errors.Join(err, errors.New("failed to create session denylist informer"))
+	}
+	onChange := func(obj interface{}) {
+		secret, ok := obj.(*corev1.Secret)
+		if !ok || secret.GetName() != common.EverestSessionDenylistSecretName {
+			return
+		}
+		if err := s.set(secret); err != nil {
+			l.Error(errors.Join(err, errors.New("failed to refresh session denylist")))
+		}
+	}
+	inf.OnAdd(onChange)
+	inf.OnUpdate(func(_, newObj interface{}) {
+		onChange(newObj)
+	})
+	inf.OnDelete(func(obj interface{}) {
+		secret, ok := obj.(*corev1.Secret)
+		if !ok || secret.GetName() != common.EverestSessionDenylistSecretName {
+			return
+		}
+		s.reset()
+	})
+	if err := inf.Start(ctx, &corev1.Secret{}); err != nil {
+		return errors.Join(err, errors.New("failed to watch session denylist Secret"))
+	}
+	return nil
+}
+
+// reset empties the in-memory copy of the denylist, once the Kubernetes Secret is deleted.
+func (s *DenylistStore) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.denylist = &Denylist{
+		Tokens: make(map[string]time.Time),
+		Users:  make(map[string]time.Time),
+	}
+}
+
+func (s *DenylistStore) set(secret *corev1.Secret) error {
+	d, err := denylistFromSecret(secret)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.denylist = d
+	return nil
+}
+
+// update applies fn to the stored denylist and persists the result.
+func (s *DenylistStore) update(ctx context.Context, fn func(d *Denylist)) error {
+	shouldRetry := func(err error) bool {
+		return k8serrors.IsConflict(err) || k8serrors.IsAlreadyExists(err)
+	}
+	return retry.OnError(retry.DefaultRetry, shouldRetry, func() error {
+		exists := true
+		secret, err := s.k.GetSecret(ctx, common.SystemNamespace, common.EverestSessionDenylistSecretName)
+		if k8serrors.IsNotFound(err) {
+			exists = false
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      common.EverestSessionDenylistSecretName,
+					Namespace: common.SystemNamespace,
+				},
+			}
+		} else if err != nil {
+			return err
+		}
+
+		d, err := denylistFromSecret(secret)
+		if err != nil {
+			return err
+		}
+		fn(d)
+		d.prune(time.Now())
+
+		data, err := yaml.Marshal(d)
+		if err != nil {
+			return err
+		}
+		if secret.Data == nil {
+			secret.Data = make(map[string][]byte)
+		}
+		secret.Data[denylistFile] = data
+
+		if !exists {
+			_, err = s.k.CreateSecret(ctx, secret)
+		} else {
+			_, err = s.k.UpdateSecret(ctx, secret)
+		}
+		if err != nil {
+			return err
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.denylist = d
+		return nil
+	})
+}
@@ -0,0 +1,191 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/everest/pkg/kubernetes/client"
+)
+
+func TestDenylistIsRevoked(t *testing.T) {
+	t.Parallel()
+	now := time.Now().UTC().Truncate(time.Second)
+	d := &Denylist{
+		Tokens: map[string]time.Time{"revoked-id": now.Add(time.Hour)},
+		Users: map[string]time.Time{
+			"bob":         now,
+			"carol:login": now,
+		},
+	}
+	testCases := []struct {
+		description string
+		subject     string
+		id          string
+		issuedAt    time.Time
+		revoked     bool
+	}{
+		{
+			description: "token not revoked",
+			subject:     "alice:login",
+			id:          "valid-id",
+			issuedAt:    now,
+			revoked:     false,
+		},
+		{
+			description: "token revoked by ID",
+			subject:     "alice:login",
+			id:          "revoked-id",
+			issuedAt:    now,
+			revoked:     true,
+		},
+		{
+			description: "token issued before user sessions were revoked",
+			subject:     "bob:login",
+			id:          "valid-id",
+			issuedAt:    now.Add(-time.Minute),
+			revoked:     true,
+		},
+		{
+			description: "token issued after user sessions were revoked",
+			subject:     "bob:login",
+			id:          "valid-id",
+			issuedAt:    now.Add(time.Second),
+			revoked:     false,
+		},
+		{
+			description: "token issued in the same second as user sessions were revoked",
+			subject:     "bob:login",
+			id:          "valid-id",
+			issuedAt:    now,
+			revoked:     true,
+		},
+		{
+			description: "token issued for a revoked capability",
+			subject:     "carol:login",
+			id:          "valid-id",
+			issuedAt:    now.Add(-time.Minute),
+			revoked:     true,
+		},
+		{
+			description: "token issued for another capability",
+			subject:     "carol:apiKey",
+			id:          "valid-id",
+			issuedAt:    now,
+			revoked:     false,
+		},
+		{
+			description: "token without ID",
+			subject:     "alice:login",
+			id:          "",
+			issuedAt:    now,
+			revoked:     false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.revoked, d.IsRevoked(tc.subject, tc.id, tc.issuedAt))
+		})
+	}
+}
+
+func TestDenylistPrune(t *testing.T) {
+	t.Parallel()
+	now := time.Now().UTC()
+	d := &Denylist{
+		Tokens: map[string]time.Time{
+			"expired":       now.Add(-time.Minute),
+			"not-expired":   now.Add(time.Minute),
+			"never-expires": {},
+		},
+		Users: map[string]time.Time{
+			"alice":        now,
+			"alice:login":  now.Add(-time.Minute),
+			"alice:apiKey": now.Add(time.Minute),
+			"bob:login":    now.Add(-time.Hour),
+		},
+		RefreshTokens: map[string]RefreshToken{
+			"expired-family": {ID: "expired", ExpiresAt: now.Add(-time.Minute)},
+			"family":         {ID: "not-expired", ExpiresAt: now.Add(time.Minute)},
+		},
+	}
+	d.prune(now)
+	assert.Equal(t, map[string]time.Time{
+		"not-expired":   now.Add(time.Minute),
+		"never-expires": {},
+	}, d.Tokens)
+	// Capability revocations superseded by a revocation of the whole user are removed.
+	assert.Equal(t, map[string]time.Time{
+		"alice":        now,
+		"alice:apiKey": now.Add(time.Minute),
+		"bob:login":    now.Add(-time.Hour),
+	}, d.Users)
+	assert.Equal(t, map[string]RefreshToken{
+		"family": {ID: "not-expired", ExpiresAt: now.Add(time.Minute)},
+	}, d.RefreshTokens)
+}
+
+func TestDenylistRotateRefreshToken(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	store := NewDenylistStore(client.NewFromFakeClient())
+	expiresAt := time.Now().Add(time.Hour)
+
+	// The first refresh token of a session is current until it is exchanged.
+	rotated, err := store.RotateRefreshToken(ctx, "family", "first", "second", expiresAt)
+	require.NoError(t, err)
+	assert.True(t, rotated)
+	rotated, err = store.RotateRefreshToken(ctx, "family", "first", "other", expiresAt)
+	require.NoError(t, err)
+	assert.False(t, rotated)
+	rotated, err = store.RotateRefreshToken(ctx, "family", "second", "third", expiresAt)
+	require.NoError(t, err)
+	assert.True(t, rotated)
+
+	// Only the current refresh token of the session is stored.
+	require.NoError(t, store.Load(ctx))
+	assert.Empty(t, store.denylist.Tokens)
+	assert.Equal(t, map[string]RefreshToken{
+		"family": {ID: "third", ExpiresAt: expiresAt.UTC()},
+	}, store.denylist.RefreshTokens)
+}
+
+func TestDenylistStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	k := client.NewFromFakeClient()
+
+	store := NewDenylistStore(k)
+	issuedAt := time.Now().UTC().Add(-time.Minute)
+	require.NoError(t, store.RevokeToken(ctx, "token-id", time.Now().Add(time.Hour)))
+	require.NoError(t, store.RevokeUser(ctx, "bob"))
+	assert.True(t, store.IsRevoked("alice", "token-id", issuedAt))
+	assert.True(t, store.IsRevoked("bob", "other-id", issuedAt))
+	assert.False(t, store.IsRevoked("alice", "other-id", issuedAt))
+
+	// A new store should see the revocations persisted in the Secret.
+	other := NewDenylistStore(k)
+	require.NoError(t, other.Load(ctx))
+	assert.True(t, other.IsRevoked("alice", "token-id", issuedAt))
+	assert.True(t, other.IsRevoked("bob", "other-id", issuedAt))
+	assert.False(t, other.IsRevoked("alice", "other-id", issuedAt))
+}
@@ -0,0 +1,70 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"k8s.io/client-go/util/retry"
+
+	"github.com/percona/everest/pkg/accounts"
+)
+
+// WithLockoutPolicy sets the policy for locking accounts after failed login attempts.
+func WithLockoutPolicy(p accounts.LockoutPolicy) Option {
+	return func(m *Manager) {
+		m.lockoutPolicy = p
+	}
+}
+
+// recordFailedLogin counts a failed login attempt of the given user,
+// locking the account once the lockout threshold is reached.
+func (mgr *Manager) recordFailedLogin(ctx context.Context, username string) error {
+	err := mgr.updateAccount(ctx, username, func(a *accounts.Account) {
+		a.RecordFailedLogin(mgr.lockoutPolicy, time.Now())
+	})
+	if err != nil {
+		return errors.Join(err, errors.New("failed to update failed login attempts"))
+	}
+	return nil
+}
+
+// resetFailedLogins resets the failed login attempts of the given user after a successful login.
+func (mgr *Manager) resetFailedLogins(ctx context.Context, username string) error {
+	err := mgr.updateAccount(ctx, username, func(a *accounts.Account) {
+		a.FailedLogins = 0
+	})
+	if err != nil {
+		return errors.Join(err, errors.New("failed to update failed login attempts"))
+	}
+	return nil
+}
+
+// updateAccount applies fn to the account of the given user and stores the result.
+// The account is stored only if it was not changed since it was read, otherwise fn
+// is applied again to the current account, so that concurrent changes are not lost.
+func (mgr *Manager) updateAccount(ctx context.Context, username string, fn func(a *accounts.Account)) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		account, err := mgr.accountManager.Get(ctx, username)
+		if err != nil {
+			return err
+		}
+		fn(account)
+		return mgr.accountManager.Update(ctx, username, account)
+	})
+}
@@ -0,0 +1,110 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/percona/everest/pkg/accounts"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes/client"
+	accountsclient "github.com/percona/everest/pkg/kubernetes/client/accounts"
+	"github.com/percona/everest/pkg/session"
+)
+
+func TestAuthenticateLockout(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	k := client.NewFromFakeClient()
+
+	_, err := k.Clientset().
+		CoreV1().
+		Secrets(common.SystemNamespace).
+		Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      common.EverestAccountsSecretName,
+				Namespace: common.SystemNamespace,
+			},
+		}, metav1.CreateOptions{},
+		)
+	require.NoError(t, err)
+	accts := accountsclient.New(k)
+	require.NoError(t, accts.Create(ctx, "alice", "password"))
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	mgr, err := session.New(
+		session.WithAccountManager(accts),
+		session.WithSigningKey(key),
+		session.WithLockoutPolicy(accounts.LockoutPolicy{
+			Threshold: 3,
+			Duration:  time.Hour,
+		}),
+	)
+	require.NoError(t, err)
+
+	failedLogins := func() int {
+		account, err := accts.Get(ctx, "alice")
+		require.NoError(t, err)
+		return account.FailedLogins
+	}
+
+	t.Run("successful login resets failed attempts", func(t *testing.T) {
+		for range 2 {
+			require.ErrorIs(t, mgr.Authenticate(ctx, "alice", "wrong"), accounts.ErrIncorrectPassword)
+		}
+		assert.Equal(t, 2, failedLogins())
+
+		require.NoError(t, mgr.Authenticate(ctx, "alice", "password"))
+		assert.Equal(t, 0, failedLogins())
+	})
+
+	t.Run("account is locked once the threshold is reached", func(t *testing.T) {
+		for range 3 {
+			require.ErrorIs(t, mgr.Authenticate(ctx, "alice", "wrong"), accounts.ErrIncorrectPassword)
+		}
+		// Even the correct password is rejected while the account is locked.
+		require.ErrorIs(t, mgr.Authenticate(ctx, "alice", "password"), accounts.ErrAccountLocked)
+		require.ErrorIs(t, mgr.Authenticate(ctx, "alice", "wrong"), accounts.ErrAccountLocked)
+
+		account, err := accts.Get(ctx, "alice")
+		require.NoError(t, err)
+		assert.True(t, account.IsLocked(time.Now()))
+		assert.False(t, account.IsLocked(time.Now().Add(time.Hour+time.Minute)))
+
+		account.Unlock()
+		require.NoError(t, accts.Update(ctx, "alice", account))
+		require.NoError(t, mgr.Authenticate(ctx, "alice", "password"))
+	})
+
+	t.Run("account is unlocked once the lockout expires", func(t *testing.T) {
+		account, err := accts.Get(ctx, "alice")
+		require.NoError(t, err)
+		account.LockedUntil = time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+		require.NoError(t, accts.Update(ctx, "alice", account))
+
+		require.NoError(t, mgr.Authenticate(ctx, "alice", "password"))
+	})
+}
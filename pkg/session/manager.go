@@ -35,12 +35,16 @@ import (
 const (
 	// SessionManagerClaimsIssuer fills the "iss" field of the token.
 	SessionManagerClaimsIssuer = "everest"
+
+	subjectTmpl = "%s:%s" // username:capability
 )
 
 // Manager provides functionality for creating and managing JWT tokens.
 type Manager struct {
 	accountManager accounts.Interface
+	denylist       *DenylistStore
 	signingKey     *rsa.PrivateKey
+	lockoutPolicy  accounts.LockoutPolicy
 }
 
 // Option is a function that modifies a SessionManager.
@@ -52,6 +56,9 @@ func New(options ...Option) (*Manager, error) {
 	for _, opt := range options {
 		opt(m)
 	}
+	if m.signingKey != nil {
+		return m, nil
+	}
 	privKey, err := getPrivateKey()
 	if err != nil {
 		return nil, errors.Join(err, errors.New("failed to get private key"))
@@ -67,13 +74,37 @@ func WithAccountManager(i accounts.Interface) Option {
 	}
 }
 
+// WithSigningKey sets the key used for signing the JWT tokens.
+// If not set, the key is read from the file mounted into the Everest server.
+func WithSigningKey(key *rsa.PrivateKey) Option {
+	return func(m *Manager) {
+		m.signingKey = key
+	}
+}
+
+// WithDenylist sets the store used for revoking sessions.
+func WithDenylist(d *DenylistStore) Option {
+	return func(m *Manager) {
+		m.denylist = d
+	}
+}
+
+// Subject returns the subject of a token issued for the given user and capability.
+func Subject(username string, capability accounts.AccountCapability) string {
+	return fmt.Sprintf(subjectTmpl, username, capability)
+}
+
 // Create creates a new token for a given subject (user) and returns it as a string.
 // Passing a value of `0` for secondsBeforeExpiry creates a token that never expires.
 // The id parameter holds an optional unique JWT token identifier and stored as a standard claim "jti" in the JWT token.
 func (mgr *Manager) Create(subject string, secondsBeforeExpiry int64, id string) (string, error) {
 	// Create a new token object, specifying signing method and the claims
 	// you would like it to contain.
-	now := time.Now().UTC()
+	claims := newRegisteredClaims(subject, id, time.Now().UTC(), secondsBeforeExpiry)
+	return mgr.signClaims(claims)
+}
+
+func newRegisteredClaims(subject, id string, now time.Time, secondsBeforeExpiry int64) jwt.RegisteredClaims {
 	claims := jwt.RegisteredClaims{
 		IssuedAt:  jwt.NewNumericDate(now),
 		Issuer:    SessionManagerClaimsIssuer,
@@ -85,8 +116,7 @@ func (mgr *Manager) Create(subject string, secondsBeforeExpiry int64, id string)
 		expires := now.Add(time.Duration(secondsBeforeExpiry) * time.Second)
 		claims.ExpiresAt = jwt.NewNumericDate(expires)
 	}
-
-	return mgr.signClaims(claims)
+	return claims
 }
 
 func (mgr *Manager) signClaims(claims jwt.Claims) (string, error) {
@@ -95,20 +125,78 @@ func (mgr *Manager) signClaims(claims jwt.Claims) (string, error) {
 }
 
 // Authenticate verifies the given username and password.
+// Failed attempts are counted against the account, which is locked according to the lockout policy.
+// Returns accounts.ErrPasswordExpired if the credentials are correct but the password must be changed.
 func (mgr *Manager) Authenticate(ctx context.Context, username string, password string) error {
-	if password == "" {
-		return fmt.Errorf("blank passwords are not allowed")
+	account, err := mgr.verifyCredentials(ctx, username, password)
+	// An expired password is reported only to users that could otherwise log in.
+	if err != nil && !errors.Is(err, accounts.ErrPasswordExpired) {
+		return err
+	}
+	if cErr := checkCanLogin(account); cErr != nil {
+		return cErr
 	}
+	return err
+}
 
-	if err := mgr.accountManager.Verify(ctx, username, password); err != nil {
+// ChangePassword sets a new password for the given user after verifying the current one.
+// If MFA is enabled for the user, mfaCode must hold a valid TOTP or recovery code as well.
+// Expired passwords can be changed, so that users can renew them before logging in.
+func (mgr *Manager) ChangePassword(ctx context.Context, username, password, mfaCode, newPassword string) error {
+	account, err := mgr.verifyCredentials(ctx, username, password)
+	if err != nil && !errors.Is(err, accounts.ErrPasswordExpired) {
 		return err
 	}
+	if err := checkCanLogin(account); err != nil {
+		return err
+	}
+	if password == newPassword {
+		return fmt.Errorf("%w: password must differ from the current password", accounts.ErrPasswordPolicyViolation)
+	}
+	if account.MFAEnabled() {
+		if _, err := mgr.verifyMFACode(ctx, username, mfaCode); err != nil {
+			return err
+		}
+	}
+	return mgr.accountManager.SetPassword(ctx, username, newPassword)
+}
+
+// verifyCredentials verifies the given username and password, applying the lockout policy.
+// The account is also returned along with accounts.ErrPasswordExpired.
+func (mgr *Manager) verifyCredentials(ctx context.Context, username, password string) (*accounts.Account, error) {
+	if password == "" {
+		return nil, fmt.Errorf("blank passwords are not allowed")
+	}
 
 	account, err := mgr.accountManager.Get(ctx, username)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	// Locked accounts are rejected without checking the password,
+	// so that guessing can not continue while the account is locked.
+	if account.IsLocked(time.Now()) {
+		return nil, accounts.ErrAccountLocked
+	}
+
+	verifyErr := mgr.accountManager.Verify(ctx, username, password)
+	if errors.Is(verifyErr, accounts.ErrIncorrectPassword) {
+		if err := mgr.recordFailedLogin(ctx, username); err != nil {
+			return nil, errors.Join(verifyErr, err)
+		}
+		return nil, verifyErr
+	} else if verifyErr != nil && !errors.Is(verifyErr, accounts.ErrPasswordExpired) {
+		return nil, verifyErr
+	}
+	if account.FailedLogins > 0 {
+		if err := mgr.resetFailedLogins(ctx, username); err != nil {
+			return nil, err
+		}
+	}
+	return account, verifyErr
+}
+
+func checkCanLogin(account *accounts.Account) error {
 	if !account.Enabled {
 		return accounts.ErrAccountDisabled
 	}
@@ -119,6 +207,52 @@ func (mgr *Manager) Authenticate(ctx context.Context, username string, password
 	return nil
 }
 
+// Revoke revokes the session identified by the given token claims.
+func (mgr *Manager) Revoke(ctx context.Context, claims jwt.MapClaims) error {
+	if mgr.denylist == nil {
+		return errors.New("session revocation is not configured")
+	}
+	id, ok := claims["jti"].(string)
+	if !ok || id == "" {
+		return errors.New("token does not have an ID")
+	}
+	expiresAt, err := claims.GetExpirationTime()
+	if err != nil {
+		return errors.Join(err, errors.New("failed to get expiration time from claims"))
+	}
+	var exp time.Time
+	if expiresAt != nil {
+		exp = expiresAt.Time
+	}
+	if err := mgr.denylist.RevokeToken(ctx, id, exp); err != nil {
+		return err
+	}
+	// Revoke the refresh tokens issued along with the token as well.
+	if family, ok := claims["fam"].(string); ok && family != "" {
+		return mgr.revokeFamily(ctx, family)
+	}
+	return nil
+}
+
+// IsRevoked returns true if the session identified by the given token claims has been revoked.
+func (mgr *Manager) IsRevoked(claims jwt.MapClaims) bool {
+	if mgr.denylist == nil {
+		return false
+	}
+	subject, err := claims.GetSubject()
+	if err != nil {
+		return true
+	}
+	var issuedAt time.Time
+	if iat, err := claims.GetIssuedAt(); err == nil && iat != nil {
+		issuedAt = iat.Time
+	}
+	id, _ := claims["jti"].(string)
+	family, _ := claims["fam"].(string)
+	return mgr.denylist.IsRevoked(subject, id, issuedAt) ||
+		(family != "" && mgr.denylist.IsRevoked(subject, family, issuedAt))
+}
+
 func getPrivateKey() (*rsa.PrivateKey, error) {
 	pemString, err := os.ReadFile(common.EverestJWTPrivateKeyFile)
 	if err != nil {
@@ -0,0 +1,136 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/percona/everest/pkg/accounts"
+)
+
+const (
+	// MFAChallengeExpiry is the time a user has for providing the second factor after entering the password.
+	MFAChallengeExpiry = 5 * time.Minute
+
+	tokenUseMFA = "mfa"
+)
+
+var (
+	// ErrInvalidMFAChallenge is returned when an MFA challenge is invalid, expired or already used.
+	ErrInvalidMFAChallenge = errors.New("invalid MFA challenge")
+	// ErrInvalidMFACode is returned when an MFA code is neither a valid TOTP code nor an unused recovery code.
+	ErrInvalidMFACode = errors.New("invalid MFA code")
+)
+
+// MFAChallenge returns a challenge if the given user must provide a second factor for logging in.
+// The challenge must be passed to VerifyMFA along with the code. Returns an empty string if MFA is
+// not enabled for the user. Must only be called after the password of the user was verified.
+func (mgr *Manager) MFAChallenge(ctx context.Context, username string) (string, error) {
+	account, err := mgr.accountManager.Get(ctx, username)
+	if err != nil {
+		return "", err
+	}
+	if !account.MFAEnabled() {
+		return "", nil
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return "", err
+	}
+	subject := Subject(username, accounts.AccountCapabilityLogin)
+	return mgr.signClaims(sessionClaims{
+		RegisteredClaims: newRegisteredClaims(subject, id.String(), time.Now().UTC(), int64(MFAChallengeExpiry.Seconds())),
+		TokenUse:         tokenUseMFA,
+	})
+}
+
+// VerifyMFA verifies the code provided for the given challenge and returns the subject
+// for which the session should be created. Each challenge can be completed only once.
+// Invalid codes are counted as failed login attempts.
+func (mgr *Manager) VerifyMFA(ctx context.Context, challenge, code string) (string, error) {
+	claims := &sessionClaims{}
+	if _, err := jwt.ParseWithClaims(challenge, claims, mgr.KeyFunc(),
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}),
+		jwt.WithIssuer(SessionManagerClaimsIssuer),
+		jwt.WithExpirationRequired(),
+	); err != nil {
+		return "", errors.Join(ErrInvalidMFAChallenge, err)
+	}
+	if claims.TokenUse != tokenUseMFA || claims.ID == "" {
+		return "", ErrInvalidMFAChallenge
+	}
+	username := strings.Split(claims.Subject, ":")[0]
+	account, err := mgr.verifyMFACode(ctx, username, code)
+	if err != nil {
+		return "", err
+	}
+
+	if mgr.denylist != nil {
+		firstUse, err := mgr.denylist.RevokeTokenOnce(ctx, claims.ID, claims.ExpiresAt.Time)
+		if err != nil {
+			return "", errors.Join(err, errors.New("failed to revoke MFA challenge"))
+		}
+		if !firstUse {
+			return "", ErrInvalidMFAChallenge
+		}
+	}
+	if err := checkCanLogin(account); err != nil {
+		return "", err
+	}
+	return claims.Subject, nil
+}
+
+// verifyMFACode verifies the TOTP or recovery code provided by the given user and returns the account.
+// Invalid codes are counted as failed login attempts.
+func (mgr *Manager) verifyMFACode(ctx context.Context, username, code string) (*accounts.Account, error) {
+	var account *accounts.Account
+	var verified bool
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var err error
+		account, err = mgr.accountManager.Get(ctx, username)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		if account.IsLocked(now) {
+			return accounts.ErrAccountLocked
+		}
+		verified, err = account.VerifyMFACode(code, now)
+		if err != nil {
+			return err
+		}
+		if verified {
+			account.FailedLogins = 0
+		} else {
+			account.RecordFailedLogin(mgr.lockoutPolicy, now)
+		}
+		return mgr.accountManager.Update(ctx, username, account)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !verified {
+		return nil, ErrInvalidMFACode
+	}
+	return account, nil
+}
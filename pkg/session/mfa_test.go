@@ -0,0 +1,125 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes/client"
+	accountsclient "github.com/percona/everest/pkg/kubernetes/client/accounts"
+	"github.com/percona/everest/pkg/session"
+	"github.com/percona/everest/pkg/totp"
+)
+
+func TestMFA(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	k := client.NewFromFakeClient()
+
+	_, err := k.Clientset().
+		CoreV1().
+		Secrets(common.SystemNamespace).
+		Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      common.EverestAccountsSecretName,
+				Namespace: common.SystemNamespace,
+			},
+		}, metav1.CreateOptions{},
+		)
+	require.NoError(t, err)
+	accts := accountsclient.New(k)
+	require.NoError(t, accts.Create(ctx, "alice", "password"))
+	require.NoError(t, accts.Create(ctx, "bob", "password"))
+
+	account, err := accts.Get(ctx, "alice")
+	require.NoError(t, err)
+	secret, recoveryCodes, err := account.EnableMFA()
+	require.NoError(t, err)
+	require.NoError(t, accts.Update(ctx, "alice", account))
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	mgr, err := session.New(
+		session.WithAccountManager(accts),
+		session.WithSigningKey(key),
+		session.WithDenylist(session.NewDenylistStore(k)),
+	)
+	require.NoError(t, err)
+
+	t.Run("no challenge without MFA", func(t *testing.T) {
+		challenge, err := mgr.MFAChallenge(ctx, "bob")
+		require.NoError(t, err)
+		assert.Empty(t, challenge)
+	})
+
+	t.Run("challenge is not an access token", func(t *testing.T) {
+		challenge, err := mgr.MFAChallenge(ctx, "alice")
+		require.NoError(t, err)
+		require.NotEmpty(t, challenge)
+
+		claims := jwt.MapClaims{}
+		_, err = jwt.ParseWithClaims(challenge, claims, mgr.KeyFunc())
+		require.NoError(t, err)
+		assert.False(t, session.IsAccessToken(claims))
+	})
+
+	t.Run("invalid code", func(t *testing.T) {
+		challenge, err := mgr.MFAChallenge(ctx, "alice")
+		require.NoError(t, err)
+		_, err = mgr.VerifyMFA(ctx, challenge, "000000x")
+		require.ErrorIs(t, err, session.ErrInvalidMFACode)
+	})
+
+	t.Run("invalid challenge", func(t *testing.T) {
+		_, err := mgr.VerifyMFA(ctx, "not-a-challenge", recoveryCodes[1])
+		require.ErrorIs(t, err, session.ErrInvalidMFAChallenge)
+	})
+
+	t.Run("challenge can be completed once", func(t *testing.T) {
+		challenge, err := mgr.MFAChallenge(ctx, "alice")
+		require.NoError(t, err)
+		code, err := totp.Code(secret, totp.Step(time.Now()))
+		require.NoError(t, err)
+		subject, err := mgr.VerifyMFA(ctx, challenge, code)
+		require.NoError(t, err)
+		assert.Equal(t, "alice:login", subject)
+
+		_, err = mgr.VerifyMFA(ctx, challenge, recoveryCodes[0])
+		require.ErrorIs(t, err, session.ErrInvalidMFAChallenge)
+	})
+
+	t.Run("password change requires the second factor", func(t *testing.T) {
+		err := mgr.ChangePassword(ctx, "alice", "password", "", "new-password")
+		require.ErrorIs(t, err, session.ErrInvalidMFACode)
+		require.NoError(t, accts.Verify(ctx, "alice", "password"))
+
+		require.NoError(t, mgr.ChangePassword(ctx, "alice", "password", recoveryCodes[2], "new-password"))
+		require.NoError(t, accts.Verify(ctx, "alice", "new-password"))
+		// Users without MFA only need their password.
+		require.NoError(t, mgr.ChangePassword(ctx, "bob", "password", "", "new-password"))
+	})
+}
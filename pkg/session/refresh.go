@@ -0,0 +1,179 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/percona/everest/pkg/accounts"
+)
+
+const (
+	// RefreshTokenExpiry is the duration for which a refresh token is valid.
+	RefreshTokenExpiry = time.Hour * 24 * 7
+
+	tokenUseRefresh = "refresh"
+)
+
+var (
+	// ErrInvalidRefreshToken is returned when a refresh token is invalid, expired or revoked.
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	// ErrRefreshTokenReused is returned when a refresh token that was already exchanged is used again.
+	ErrRefreshTokenReused = errors.New("refresh token has already been used")
+)
+
+// sessionClaims are the claims of the tokens issued for a refreshable session.
+type sessionClaims struct {
+	jwt.RegisteredClaims
+	// TokenUse is set to `refresh` for refresh tokens, to `mfa` for MFA challenges, and is empty for access tokens.
+	TokenUse string `json:"token_use,omitempty"`
+	// Family identifies the session that the token belongs to.
+	// All the tokens obtained by refreshing a session share the same family.
+	Family string `json:"fam,omitempty"`
+}
+
+// CreateRefreshable creates a new access token for the given subject, along with a refresh token
+// that can be exchanged for a new pair of tokens using Refresh.
+// Passing a value of `0` for secondsBeforeExpiry creates an access token that never expires.
+func (mgr *Manager) CreateRefreshable(subject string, secondsBeforeExpiry int64) (string, string, error) {
+	family, err := uuid.NewRandom()
+	if err != nil {
+		return "", "", err
+	}
+	refreshID, err := uuid.NewRandom()
+	if err != nil {
+		return "", "", err
+	}
+	return mgr.createPair(subject, secondsBeforeExpiry, family.String(), refreshID.String())
+}
+
+// Refresh exchanges the given refresh token for a new access token and a new refresh token.
+// Only the last refresh token issued for a session can be exchanged, so a refresh token can be exchanged
+// only once. If an already exchanged refresh token is used again, all the tokens of the session are revoked
+// and ErrRefreshTokenReused is returned.
+func (mgr *Manager) Refresh(ctx context.Context, refreshToken string, secondsBeforeExpiry int64) (string, string, error) {
+	if mgr.denylist == nil {
+		return "", "", errors.New("session revocation is not configured")
+	}
+
+	claims := &sessionClaims{}
+	if _, err := jwt.ParseWithClaims(refreshToken, claims, mgr.KeyFunc(),
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}),
+		jwt.WithIssuer(SessionManagerClaimsIssuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	); err != nil {
+		return "", "", errors.Join(ErrInvalidRefreshToken, err)
+	}
+	if claims.TokenUse != tokenUseRefresh || claims.ID == "" || claims.Family == "" || claims.IssuedAt == nil {
+		return "", "", ErrInvalidRefreshToken
+	}
+	if mgr.denylist.IsRevoked(claims.Subject, claims.Family, claims.IssuedAt.Time) {
+		return "", "", ErrInvalidRefreshToken
+	}
+
+	// The account may have changed since the session was created. It is checked before the refresh
+	// token is rotated, so that the refreshes of disabled or deleted accounts do not write to the denylist.
+	username := strings.Split(claims.Subject, ":")[0]
+	account, err := mgr.accountManager.Get(ctx, username)
+	if err != nil {
+		return "", "", err
+	}
+	if !account.Enabled {
+		return "", "", accounts.ErrAccountDisabled
+	}
+	if !account.HasCapability(accounts.AccountCapabilityLogin) {
+		return "", "", errors.Join(accounts.ErrInsufficientCapabilities, errors.New("user does not have capability to login"))
+	}
+
+	// Exchanging a refresh token replaces it as the current one of the session, so that it cannot be used again.
+	// Only the current refresh token of every session is stored, rather than every exchanged one.
+	refreshID, err := uuid.NewRandom()
+	if err != nil {
+		return "", "", err
+	}
+	current, err := mgr.denylist.RotateRefreshToken(ctx, claims.Family, claims.ID, refreshID.String(),
+		time.Now().Add(RefreshTokenExpiry))
+	if err != nil {
+		return "", "", errors.Join(err, errors.New("failed to rotate refresh token"))
+	}
+	if !current {
+		// A refresh token used more than once may have been stolen,
+		// so we revoke the whole session to lock out both parties.
+		if err := mgr.revokeFamily(ctx, claims.Family); err != nil {
+			return "", "", err
+		}
+		return "", "", ErrRefreshTokenReused
+	}
+
+	return mgr.createPair(claims.Subject, secondsBeforeExpiry, claims.Family, refreshID.String())
+}
+
+// IsAccessToken returns true if the given token claims belong to a token that can be used for authentication.
+// Refresh tokens and MFA challenges are signed with the same key, but cannot be used for authentication.
+func IsAccessToken(claims jwt.MapClaims) bool {
+	tokenUse, _ := claims["token_use"].(string)
+	return tokenUse == ""
+}
+
+// IsRefreshToken returns true if the given token claims belong to a refresh token.
+// Refresh tokens can only be exchanged for new tokens, and must not be used for authentication.
+func IsRefreshToken(claims jwt.MapClaims) bool {
+	tokenUse, _ := claims["token_use"].(string)
+	return tokenUse == tokenUseRefresh
+}
+
+func (mgr *Manager) createPair(subject string, secondsBeforeExpiry int64, family, refreshID string) (string, string, error) {
+	accessID, err := uuid.NewRandom()
+	if err != nil {
+		return "", "", err
+	}
+
+	now := time.Now().UTC()
+	accessToken, err := mgr.signClaims(sessionClaims{
+		RegisteredClaims: newRegisteredClaims(subject, accessID.String(), now, secondsBeforeExpiry),
+		Family:           family,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	refreshToken, err := mgr.signClaims(sessionClaims{
+		RegisteredClaims: newRegisteredClaims(subject, refreshID, now, int64(RefreshTokenExpiry.Seconds())),
+		TokenUse:         tokenUseRefresh,
+		Family:           family,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	return accessToken, refreshToken, nil
+}
+
+// revokeFamily revokes all the tokens of the session identified by family.
+func (mgr *Manager) revokeFamily(ctx context.Context, family string) error {
+	// Refresh tokens are rotated, so the tokens of a session stop being issued at most
+	// RefreshTokenExpiry after they were revoked.
+	expiresAt := time.Now().Add(RefreshTokenExpiry)
+	if err := mgr.denylist.RevokeToken(ctx, family, expiresAt); err != nil {
+		return errors.Join(err, errors.New("failed to revoke session"))
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/percona/everest/pkg/accounts"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes/client"
+	accountsclient "github.com/percona/everest/pkg/kubernetes/client/accounts"
+	"github.com/percona/everest/pkg/session"
+)
+
+func TestRefresh(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	k := client.NewFromFakeClient()
+
+	_, err := k.Clientset().
+		CoreV1().
+		Secrets(common.SystemNamespace).
+		Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      common.EverestAccountsSecretName,
+				Namespace: common.SystemNamespace,
+			},
+		}, metav1.CreateOptions{},
+		)
+	require.NoError(t, err)
+	accts := accountsclient.New(k)
+	require.NoError(t, accts.Create(ctx, "alice", "password"))
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	mgr, err := session.New(
+		session.WithAccountManager(accts),
+		session.WithSigningKey(key),
+		session.WithDenylist(session.NewDenylistStore(k)),
+	)
+	require.NoError(t, err)
+
+	subject := session.Subject("alice", accounts.AccountCapabilityLogin)
+	accessToken, refreshToken, err := mgr.CreateRefreshable(subject, 60)
+	require.NoError(t, err)
+
+	t.Run("access token cannot be used for refreshing", func(t *testing.T) {
+		_, _, err := mgr.Refresh(ctx, accessToken, 60)
+		require.ErrorIs(t, err, session.ErrInvalidRefreshToken)
+	})
+
+	t.Run("refresh token cannot be used for authentication", func(t *testing.T) {
+		assert.True(t, session.IsRefreshToken(parseClaims(t, mgr, refreshToken)))
+		assert.False(t, session.IsRefreshToken(parseClaims(t, mgr, accessToken)))
+	})
+
+	t.Run("refresh tokens are rotated and invalidated on reuse", func(t *testing.T) {
+		subject := session.Subject("bob", accounts.AccountCapabilityLogin)
+		require.NoError(t, accts.Create(ctx, "bob", "password"))
+		accessToken, refreshToken, err := mgr.CreateRefreshable(subject, 60)
+		require.NoError(t, err)
+
+		newAccessToken, newRefreshToken, err := mgr.Refresh(ctx, refreshToken, 60)
+		require.NoError(t, err)
+		assert.NotEqual(t, refreshToken, newRefreshToken)
+		assert.False(t, mgr.IsRevoked(parseClaims(t, mgr, newAccessToken)))
+
+		// Reusing the exchanged refresh token revokes the whole session.
+		_, _, err = mgr.Refresh(ctx, refreshToken, 60)
+		require.ErrorIs(t, err, session.ErrRefreshTokenReused)
+		_, _, err = mgr.Refresh(ctx, newRefreshToken, 60)
+		require.ErrorIs(t, err, session.ErrInvalidRefreshToken)
+		assert.True(t, mgr.IsRevoked(parseClaims(t, mgr, accessToken)))
+		assert.True(t, mgr.IsRevoked(parseClaims(t, mgr, newAccessToken)))
+	})
+
+	t.Run("refresh tokens of disabled accounts are not exchanged", func(t *testing.T) {
+		subject := session.Subject("carol", accounts.AccountCapabilityLogin)
+		require.NoError(t, accts.Create(ctx, "carol", "password"))
+		_, refreshToken, err := mgr.CreateRefreshable(subject, 60)
+		require.NoError(t, err)
+		setEnabled := func(enabled bool) {
+			account, err := accts.Get(ctx, "carol")
+			require.NoError(t, err)
+			account.Enabled = enabled
+			require.NoError(t, accts.Update(ctx, "carol", account))
+		}
+
+		setEnabled(false)
+		_, _, err = mgr.Refresh(ctx, refreshToken, 60)
+		require.ErrorIs(t, err, accounts.ErrAccountDisabled)
+		// The rejected refresh did not rotate the refresh token.
+		setEnabled(true)
+		_, _, err = mgr.Refresh(ctx, refreshToken, 60)
+		require.NoError(t, err)
+	})
+}
+
+func parseClaims(t *testing.T, mgr *session.Manager, token string) jwt.MapClaims {
+	t.Helper()
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, mgr.KeyFunc())
+	require.NoError(t, err)
+	return claims
+}
+
+func TestRevokeUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	k := client.NewFromFakeClient()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	denylist := session.NewDenylistStore(k)
+	mgr, err := session.New(
+		session.WithSigningKey(key),
+		session.WithDenylist(denylist),
+	)
+	require.NoError(t, err)
+
+	subject := session.Subject("alice", accounts.AccountCapabilityLogin)
+	before, err := mgr.Create(subject, 60, "before")
+	require.NoError(t, err)
+	// E.g. on a password change.
+	require.NoError(t, denylist.RevokeUser(ctx, subject))
+	// Tokens record the time they are issued at in seconds, so the tokens issued
+	// within the same second as the revocation are revoked as well.
+	same, err := mgr.Create(subject, 60, "same")
+	require.NoError(t, err)
+	time.Sleep(time.Until(time.Now().Truncate(time.Second).Add(time.Second)))
+	after, err := mgr.Create(subject, 60, "after")
+	require.NoError(t, err)
+
+	assert.True(t, mgr.IsRevoked(parseClaims(t, mgr, before)))
+	assert.True(t, mgr.IsRevoked(parseClaims(t, mgr, same)))
+	assert.False(t, mgr.IsRevoked(parseClaims(t, mgr, after)))
+}
@@ -0,0 +1,155 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tlsconfig provides the TLS configuration of the Everest API server.
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
+)
+
+// Config holds the TLS configuration of the server.
+type Config struct {
+	// Enabled serves the API over HTTPS.
+	Enabled bool `default:"false" envconfig:"ENABLED"`
+	// Port is the port the HTTPS listener binds to.
+	Port int `default:"8443" envconfig:"PORT"`
+	// CertFile and KeyFile are the paths of the PEM-encoded server certificate and key,
+	// e.g. mounted from a cert-manager Secret. They are reloaded when they change.
+	CertFile string `default:"/etc/everest/tls/tls.crt" envconfig:"CERT_FILE"`
+	KeyFile  string `default:"/etc/everest/tls/tls.key" envconfig:"KEY_FILE"`
+	// ClientCAFile is the path of the PEM-encoded CA bundle the client certificates are verified with.
+	// Client certificate authentication is disabled if not set.
+	ClientCAFile string `envconfig:"CLIENT_CA_FILE"`
+	// RequireClientCert rejects the connections that do not present a valid client certificate.
+	RequireClientCert bool `default:"false" envconfig:"REQUIRE_CLIENT_CERT"`
+	// ClientCertUserPrefix is prepended to the common name and to the organizations of a client
+	// certificate to form the RBAC user and groups, so that they do not clash with other users.
+	ClientCertUserPrefix string `default:"cert:" envconfig:"CLIENT_CERT_USER_PREFIX"`
+	// RedirectHTTP keeps listening on the HTTP port and redirects the requests to HTTPS.
+	RedirectHTTP bool `default:"false" envconfig:"REDIRECT_HTTP"`
+}
+
+// New returns the TLS configuration of the server.
+// The server certificate and the client CA bundle are reloaded when the files change,
+// e.g. when cert-manager renews the Secret they are mounted from.
+// The certificate files are watched until ctx is done.
+func New(ctx context.Context, cfg Config, l *zap.SugaredLogger) (*tls.Config, error) {
+	if cfg.RequireClientCert && cfg.ClientCAFile == "" {
+		return nil, errors.New("client CA file must be set for requiring client certificates")
+	}
+	watcher, err := certwatcher.New(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to load TLS certificate"))
+	}
+	go func() {
+		if err := watcher.Start(ctx); err != nil {
+			l.Error(errors.Join(err, errors.New("failed to watch TLS certificate")))
+		}
+	}()
+
+	base := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: watcher.GetCertificate,
+	}
+	if cfg.ClientCAFile == "" {
+		return base, nil
+	}
+
+	clientCAs := &caBundle{path: cfg.ClientCAFile}
+	if _, err := clientCAs.get(); err != nil {
+		return nil, err
+	}
+	clientAuth := tls.VerifyClientCertIfGiven
+	if cfg.RequireClientCert {
+		clientAuth = tls.RequireAndVerifyClientCert
+	}
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			pool, err := clientCAs.get()
+			if err != nil {
+				l.Error(errors.Join(err, errors.New("failed to reload client CA bundle")))
+			}
+			c := base.Clone()
+			c.ClientCAs = pool
+			c.ClientAuth = clientAuth
+			return c, nil
+		},
+	}, nil
+}
+
+// caBundle is a CA bundle that is read again from its file when the file changes.
+type caBundle struct {
+	path string
+
+	mu      sync.Mutex
+	modTime time.Time
+	pool    *x509.CertPool
+}
+
+// get returns the CA bundle, reading it again if its file changed.
+// If the changed file cannot be read, the last bundle that was read is returned along with the error.
+func (b *caBundle) get() (*x509.CertPool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	info, err := os.Stat(b.path)
+	if err != nil {
+		return b.pool, errors.Join(err, errors.New("failed to read client CA bundle"))
+	}
+	if b.pool != nil && info.ModTime().Equal(b.modTime) {
+		return b.pool, nil
+	}
+	data, err := os.ReadFile(b.path)
+	if err != nil {
+		return b.pool, errors.Join(err, errors.New("failed to read client CA bundle"))
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return b.pool, fmt.Errorf("no certificates found in client CA bundle %s", b.path)
+	}
+	b.pool = pool
+	b.modTime = info.ModTime()
+	return b.pool, nil
+}
+
+// ClientCertUser returns the RBAC user and groups of the verified client certificate of the connection.
+// The user is the common name of the certificate and the groups are its organizations, all prefixed with prefix.
+// Returns false if the client did not present a verified certificate.
+func ClientCertUser(state *tls.ConnectionState, prefix string) (string, []string, bool) {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return "", nil, false
+	}
+	leaf := state.VerifiedChains[0][0]
+	if leaf.Subject.CommonName == "" {
+		return "", nil, false
+	}
+	groups := make([]string, 0, len(leaf.Subject.Organization))
+	for _, o := range leaf.Subject.Organization {
+		groups = append(groups, prefix+o)
+	}
+	return prefix + leaf.Subject.CommonName, groups, true
+}
@@ -0,0 +1,244 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsconfig
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// newTestCert returns a certificate with the given subject signed by parent, or a self-signed CA if parent is nil.
+func newTestCert(t *testing.T, subject pkix.Name, parent *testCert) *testCert {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      subject,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	signer, signerKey := tmpl, key
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		tmpl.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCert{cert: cert, key: key}
+}
+
+func (c *testCert) certPEM() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.cert.Raw})
+}
+
+func (c *testCert) write(t *testing.T, certFile, keyFile string) {
+	t.Helper()
+	der, err := x509.MarshalECPrivateKey(c.key)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(certFile, c.certPEM(), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0o600))
+}
+
+func (c *testCert) tlsCertificate() tls.Certificate {
+	return tls.Certificate{Certificate: [][]byte{c.cert.Raw}, PrivateKey: c.key, Leaf: c.cert}
+}
+
+// handshake connects a client to a server and returns the state of the connection on both ends.
+func handshake(t *testing.T, server *tls.Config, client *tls.Config) (tls.ConnectionState, tls.ConnectionState, error) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close() //nolint:errcheck
+
+	type result struct {
+		state tls.ConnectionState
+		err   error
+	}
+	serverResult := make(chan result, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			serverResult <- result{err: err}
+			return
+		}
+		tlsConn := tls.Server(conn, server)
+		defer tlsConn.Close() //nolint:errcheck
+		err = tlsConn.Handshake()
+		serverResult <- result{state: tlsConn.ConnectionState(), err: err}
+	}()
+
+	conn, err := tls.Dial("tcp", ln.Addr().String(), client)
+	if err != nil {
+		<-serverResult
+		return tls.ConnectionState{}, tls.ConnectionState{}, err
+	}
+	defer conn.Close() //nolint:errcheck
+	clientState := conn.ConnectionState()
+	r := <-serverResult
+	return r.state, clientState, r.err
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dir := t.TempDir()
+	cfg := Config{
+		CertFile:             filepath.Join(dir, "tls.crt"),
+		KeyFile:              filepath.Join(dir, "tls.key"),
+		ClientCAFile:         filepath.Join(dir, "ca.crt"),
+		ClientCertUserPrefix: "cert:",
+	}
+
+	ca := newTestCert(t, pkix.Name{CommonName: "everest-ca"}, nil)
+	ca.write(t, cfg.ClientCAFile, filepath.Join(dir, "ca.key"))
+	server := newTestCert(t, pkix.Name{CommonName: "everest"}, ca)
+	server.write(t, cfg.CertFile, cfg.KeyFile)
+	client := newTestCert(t, pkix.Name{CommonName: "ci", Organization: []string{"admins"}}, ca)
+
+	serverConfig, err := New(ctx, cfg, zap.NewNop().Sugar())
+	require.NoError(t, err)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+
+	// Client certificates are optional.
+	serverState, _, err := handshake(t, serverConfig, &tls.Config{RootCAs: roots}) //nolint:gosec
+	require.NoError(t, err)
+	_, _, ok := ClientCertUser(&serverState, cfg.ClientCertUserPrefix)
+	assert.False(t, ok)
+
+	// Client certificates are mapped to an RBAC user.
+	serverState, _, err = handshake(t, serverConfig, &tls.Config{ //nolint:gosec
+		RootCAs:      roots,
+		Certificates: []tls.Certificate{client.tlsCertificate()},
+	})
+	require.NoError(t, err)
+	user, groups, ok := ClientCertUser(&serverState, cfg.ClientCertUserPrefix)
+	require.True(t, ok)
+	assert.Equal(t, "cert:ci", user)
+	assert.Equal(t, []string{"cert:admins"}, groups)
+
+	// Client certificates signed by an unknown CA are rejected.
+	other := newTestCert(t, pkix.Name{CommonName: "other-ca"}, nil)
+	untrusted := newTestCert(t, pkix.Name{CommonName: "ci"}, other).tlsCertificate()
+	_, _, err = handshake(t, serverConfig, &tls.Config{ //nolint:gosec
+		RootCAs: roots,
+		// Send the certificate even though it is not signed by any of the CAs requested by the server.
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return &untrusted, nil
+		},
+	})
+	require.Error(t, err)
+
+	// The rotated server certificate is served without restarting.
+	rotated := newTestCert(t, pkix.Name{CommonName: "everest"}, ca)
+	rotated.write(t, cfg.CertFile, cfg.KeyFile)
+	assert.Eventually(t, func() bool {
+		_, clientState, err := handshake(t, serverConfig, &tls.Config{RootCAs: roots}) //nolint:gosec
+		return err == nil && clientState.PeerCertificates[0].SerialNumber.Cmp(rotated.cert.SerialNumber) == 0
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestNewRequireClientCert(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dir := t.TempDir()
+	cfg := Config{
+		CertFile:          filepath.Join(dir, "tls.crt"),
+		KeyFile:           filepath.Join(dir, "tls.key"),
+		RequireClientCert: true,
+	}
+	ca := newTestCert(t, pkix.Name{CommonName: "everest-ca"}, nil)
+	ca.write(t, filepath.Join(dir, "ca.crt"), filepath.Join(dir, "ca.key"))
+	newTestCert(t, pkix.Name{CommonName: "everest"}, ca).write(t, cfg.CertFile, cfg.KeyFile)
+
+	_, err := New(ctx, cfg, zap.NewNop().Sugar())
+	require.Error(t, err, "requiring client certificates needs a client CA")
+
+	cfg.ClientCAFile = filepath.Join(dir, "ca.crt")
+	serverConfig, err := New(ctx, cfg, zap.NewNop().Sugar())
+	require.NoError(t, err)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	_, _, err = handshake(t, serverConfig, &tls.Config{RootCAs: roots}) //nolint:gosec
+	require.Error(t, err)
+}
+
+func TestCABundle(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "ca.crt")
+	first := newTestCert(t, pkix.Name{CommonName: "first"}, nil)
+	require.NoError(t, os.WriteFile(path, first.certPEM(), 0o600))
+
+	b := &caBundle{path: path}
+	pool, err := b.get()
+	require.NoError(t, err)
+	assert.True(t, pool.Equal(certPool(first.cert)))
+
+	// An invalid bundle keeps the last valid one.
+	require.NoError(t, os.WriteFile(path, []byte("not a certificate"), 0o600))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+	pool, err = b.get()
+	require.Error(t, err)
+	assert.True(t, pool.Equal(certPool(first.cert)))
+
+	second := newTestCert(t, pkix.Name{CommonName: "second"}, nil)
+	require.NoError(t, os.WriteFile(path, second.certPEM(), 0o600))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(2*time.Minute)))
+	pool, err = b.get()
+	require.NoError(t, err)
+	assert.True(t, pool.Equal(certPool(second.cert)))
+}
+
+func certPool(certs ...*x509.Certificate) *x509.CertPool {
+	pool := x509.NewCertPool()
+	for _, c := range certs {
+		pool.AddCert(c)
+	}
+	return pool
+}
@@ -0,0 +1,110 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package totp implements time-based one-time passwords as specified in RFC 6238.
+// The codes are compatible with common authenticator apps: HMAC-SHA1, 6 digits and a period of 30 seconds.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// Period is the time for which a code is valid.
+	Period = 30 * time.Second
+	// Digits is the number of digits of a code.
+	Digits = 6
+
+	secretLength = 20
+)
+
+//nolint:gochecknoglobals
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a new random secret, encoded in base32.
+func GenerateSecret() (string, error) {
+	b := make([]byte, secretLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return encoding.EncodeToString(b), nil
+}
+
+// URI returns the `otpauth://` URI that is used for adding the secret to an authenticator app.
+func URI(issuer, account, secret string) string {
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(Digits))
+	v.Set("period", fmt.Sprint(int(Period.Seconds())))
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: v.Encode(),
+	}
+	return u.String()
+}
+
+// Step returns the time step that the given time belongs to.
+func Step(t time.Time) int64 {
+	return t.Unix() / int64(Period.Seconds())
+}
+
+// Code returns the code of the given secret for the given time step.
+func Code(secret string, step int64) (string, error) {
+	key, err := encoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", errors.Join(err, errors.New("invalid TOTP secret"))
+	}
+	msg := make([]byte, 8)                        //nolint:mnd
+	binary.BigEndian.PutUint64(msg, uint64(step)) //nolint:gosec
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation, see RFC 4226 section 5.3.
+	offset := sum[len(sum)-1] & 0x0f                                    //nolint:mnd
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff //nolint:mnd
+	return fmt.Sprintf("%0*d", Digits, value%1_000_000), nil
+}
+
+// Validate checks the code against the secret at the given time.
+// The codes of the adjacent time steps are accepted as well, to tolerate clock drift.
+// Returns the time step the code belongs to, or false if the code is invalid.
+func Validate(secret, code string, t time.Time) (int64, bool, error) {
+	current := Step(t)
+	for _, step := range []int64{current, current - 1, current + 1} {
+		expected, err := Code(secret, step)
+		if err != nil {
+			return 0, false, err
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true, nil
+		}
+	}
+	return 0, false, nil
+}
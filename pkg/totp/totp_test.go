@@ -0,0 +1,81 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package totp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rfcSecret is the SHA1 secret `12345678901234567890` of the RFC 6238 test vectors, encoded in base32.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode(t *testing.T) {
+	t.Parallel()
+	// Test vectors from RFC 6238 appendix B, truncated to 6 digits.
+	testCases := []struct {
+		time int64
+		code string
+	}{
+		{time: 59, code: "287082"},
+		{time: 1111111109, code: "081804"},
+		{time: 1111111111, code: "050471"},
+		{time: 1234567890, code: "005924"},
+		{time: 2000000000, code: "279037"},
+	}
+	for _, tc := range testCases {
+		code, err := Code(rfcSecret, Step(time.Unix(tc.time, 0)))
+		require.NoError(t, err)
+		assert.Equal(t, tc.code, code)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+	now := time.Now()
+
+	code, err := Code(secret, Step(now))
+	require.NoError(t, err)
+	step, ok, err := Validate(secret, code, now)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, Step(now), step)
+
+	// Codes of the adjacent time steps are accepted.
+	_, ok, err = Validate(secret, code, now.Add(Period))
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	_, ok, err = Validate(secret, code, now.Add(3*Period))
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, _, err = Validate("not base32!", code, now)
+	require.Error(t, err)
+}
+
+func TestURI(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t,
+		"otpauth://totp/Everest:alice?algorithm=SHA1&digits=6&issuer=Everest&period=30&secret=ABC",
+		URI("Everest", "alice", "ABC"),
+	)
+}
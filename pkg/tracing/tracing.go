@@ -0,0 +1,125 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tracing provides OpenTelemetry tracing for the Everest API server.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// TracerName is the name of the tracer used for the spans created by Everest.
+	TracerName = "github.com/percona/everest"
+
+	serviceNameKey = attribute.Key("service.name")
+)
+
+// Config holds the configuration of the tracing.
+type Config struct {
+	// Enabled enables exporting the traces.
+	Enabled bool `default:"false" envconfig:"ENABLED"`
+	// Endpoint is the address of the OTLP gRPC collector the traces are exported to, e.g. `otel-collector:4317`.
+	Endpoint string `envconfig:"ENDPOINT"`
+	// Insecure disables TLS for the connection to the collector.
+	Insecure bool `default:"false" envconfig:"INSECURE"`
+	// SampleRatio is the ratio of the traces that are sampled, from 0 to 1.
+	// The sampling decision of the caller is respected if the request carries a trace context.
+	SampleRatio float64 `default:"1" envconfig:"SAMPLE_RATIO"`
+	// ServiceName is the name the traces are reported for.
+	ServiceName string `default:"everest-server" envconfig:"SERVICE_NAME"`
+}
+
+// Setup configures the global tracer provider and the W3C trace-context propagator.
+// If tracing is disabled, only the propagator is configured, so that the trace context
+// of incoming requests is still passed on to the outbound calls.
+// The returned function flushes the pending spans and stops the tracer provider.
+func Setup(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+	if cfg.Endpoint == "" {
+		return nil, errors.New("tracing endpoint is not set")
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to create OTLP trace exporter"))
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(serviceNameKey.String(cfg.ServiceName))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(tp)
+	return tp.Shutdown, nil
+}
+
+// Start starts a new span as a child of the span in ctx.
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(TracerName).Start(ctx, name, opts...)
+}
+
+// End records err in span, if not nil, and ends span.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Trace runs fn in a new span, which records the error returned by fn.
+func Trace(ctx context.Context, name string, fn func(ctx context.Context) error) error {
+	ctx, span := Start(ctx, name)
+	err := fn(ctx)
+	End(span, err)
+	return err
+}
+
+// Transport wraps rt, so that a span is created for every outbound request
+// and the trace context is propagated to the remote service.
+// Watch requests to the Kubernetes API are not traced, since they are long-lived by design.
+func Transport(rt http.RoundTripper) http.RoundTripper {
+	return otelhttp.NewTransport(rt, otelhttp.WithFilter(func(req *http.Request) bool {
+		return req.URL.Query().Get("watch") != "true"
+	}))
+}
+
+// Client returns a new HTTP client that traces the outbound requests made using rt.
+func Client(rt http.RoundTripper) *http.Client {
+	return &http.Client{Transport: Transport(rt)}
+}
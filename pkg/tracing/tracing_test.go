@@ -0,0 +1,111 @@
+// everest
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/grpc"
+)
+
+// collector is an in-process OTLP collector that records the names of the received spans.
+type collector struct {
+	collectortrace.UnimplementedTraceServiceServer
+
+	mu    sync.Mutex
+	spans []string
+}
+
+func (c *collector) Export(
+	_ context.Context,
+	req *collectortrace.ExportTraceServiceRequest,
+) (*collectortrace.ExportTraceServiceResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, rs := range req.GetResourceSpans() {
+		for _, ss := range rs.GetScopeSpans() {
+			for _, s := range ss.GetSpans() {
+				c.spans = append(c.spans, s.GetName())
+			}
+		}
+	}
+	return &collectortrace.ExportTraceServiceResponse{}, nil
+}
+
+func (c *collector) spanNames() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.spans
+}
+
+func startCollector(t *testing.T) (*collector, string) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	c := &collector{}
+	srv := grpc.NewServer()
+	collectortrace.RegisterTraceServiceServer(srv, c)
+	go srv.Serve(lis) //nolint:errcheck
+	t.Cleanup(srv.Stop)
+	return c, lis.Addr().String()
+}
+
+//nolint:paralleltest
+func TestSetup(t *testing.T) {
+	c, endpoint := startCollector(t)
+	ctx := context.Background()
+	shutdown, err := Setup(ctx, Config{
+		Enabled:     true,
+		Endpoint:    endpoint,
+		Insecure:    true,
+		SampleRatio: 1,
+		ServiceName: "everest-test",
+	})
+	require.NoError(t, err)
+
+	// The trace context is propagated to the outbound requests.
+	var traceparent string
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+	}))
+	defer srv.Close()
+
+	err = Trace(ctx, "validate", func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := Client(http.DefaultTransport).Do(req)
+		if err != nil {
+			return err
+		}
+		return errors.Join(resp.Body.Close(), errors.New("validation failed"))
+	})
+	require.Error(t, err)
+	assert.NotEmpty(t, traceparent)
+
+	require.NoError(t, shutdown(ctx))
+	assert.ElementsMatch(t, []string{"validate", "HTTP GET"}, c.spanNames())
+}
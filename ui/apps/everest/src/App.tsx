@@ -13,6 +13,7 @@ import { useEffect, useState } from 'react';
 import { EverestConfig } from 'shared-types/configs.types';
 import { getEverestConfigs } from 'api/everestConfigs';
 import LoadingPageSkeleton from 'components/loading-page-skeleton/LoadingPageSkeleton';
+import { BASE_PATH } from 'utils/base-path';
 
 const queryClient = new QueryClient({
   defaultOptions: {
@@ -34,7 +35,7 @@ const App = () => {
           oidc: {
             authority: oidcConfig.issuerURL,
             clientId: oidcConfig.clientId,
-            redirectUri: `${window.location.protocol}//${window.location.host}/`,
+            redirectUri: `${window.location.protocol}//${window.location.host}${BASE_PATH}/`,
           },
         });
       } catch (error) {
@@ -71,7 +72,7 @@ const App = () => {
             <AuthProvider
               oidcConfig={{
                 ...configs?.oidc,
-                redirectUri: `${window.location.protocol}//${window.location.host}/login-callback`,
+                redirectUri: `${window.location.protocol}//${window.location.host}${BASE_PATH}/login-callback`,
                 scope: 'openid profile email',
                 responseType: 'code',
                 autoSignIn: false,
@@ -14,8 +14,9 @@
 // limitations under the License.
 import axios from 'axios';
 import { enqueueSnackbar } from 'notistack';
+import { BASE_PATH } from 'utils/base-path';
 
-const BASE_URL = '/v1/';
+const BASE_URL = `${BASE_PATH}/v1/`;
 const DEFAULT_ERROR_MESSAGE = 'Something went wrong';
 const MAX_ERROR_MESSAGE_LENGTH = 120;
 let errorInterceptor: number | null = null;
@@ -1,5 +1,6 @@
 import { useEffect } from 'react';
 import { useAuth } from 'oidc-react';
+import { BASE_PATH } from 'utils/base-path';
 
 const LoginCallback = () => {
   const { userManager } = useAuth();
@@ -10,7 +11,7 @@ const LoginCallback = () => {
 
         if (user) {
           localStorage.setItem('everestToken', user.id_token || '');
-          window.location.href = '/';
+          window.location.href = `${BASE_PATH}/`;
         }
       } catch (error) {
         return;
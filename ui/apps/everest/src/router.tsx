@@ -1,4 +1,4 @@
-import { Navigate, createBrowserRouter } from 'react-router-dom';
+import { Navigate, RouteObject, createBrowserRouter } from 'react-router-dom';
 import { Login } from 'pages/login';
 import ProtectedRoute from 'components/protected-route/ProtectedRoute';
 import { Main } from 'components/main/Main';
@@ -19,8 +19,9 @@ import Restores from 'pages/db-cluster-details/restores';
 import Components from './pages/db-cluster-details/components';
 import LoginCallback from 'components/login-callback/LoginCallback';
 import { DbClusterContextProvider } from 'pages/db-cluster-details/dbCluster.context';
+import { BASE_PATH } from 'utils/base-path';
 
-const router = createBrowserRouter([
+const routes: RouteObject[] = [
   {
     path: 'login',
     element: <Login />,
@@ -112,6 +113,8 @@ const router = createBrowserRouter([
       },
     ],
   },
-]);
+];
+
+const router = createBrowserRouter(routes, { basename: BASE_PATH || '/' });
 
 export default router;
@@ -0,0 +1,6 @@
+// The path the UI is served under, e.g. "/everest", without a trailing slash.
+// The server passes it in a meta tag when Everest is not served at the root.
+export const BASE_PATH =
+  document
+    .querySelector('meta[name="everest-base-path"]')
+    ?.getAttribute('content') ?? '';